@@ -101,6 +101,10 @@ type App struct {
 
 	DnsblockchainKeeper dnsblockchainmodulekeeper.Keeper
 	DaoKeeper           daomodulekeeper.Keeper
+
+	// nftSendRouter routes the messages executed on behalf of others, transferring the domain of
+	// each domain NFT they send.
+	nftSendRouter dnsblockchainmodulekeeper.NFTSendRouter
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -129,6 +133,12 @@ func AppConfig() depinject.Config {
 				genutiltypes.ModuleName: genutil.NewAppModuleBasic(genutiltypes.DefaultMessageValidator),
 			},
 		),
+		// modules executing messages on behalf of others (authz, group, gov) route them through
+		// the dnsblockchain router so that x/nft sends of domain NFTs also transfer the domains.
+		depinject.BindInterface(
+			"github.com/cosmos/cosmos-sdk/baseapp/baseapp.MessageRouter",
+			"dnsblockchain/x/dnsblockchain/keeper/keeper.NFTSendRouter",
+		),
 	)
 }
 
@@ -182,6 +192,7 @@ func New(
 		&app.ParamsKeeper,
 		&app.DnsblockchainKeeper,
 		&app.DaoKeeper,
+		&app.nftSendRouter,
 	); err != nil {
 		panic(err)
 	}
@@ -219,6 +230,12 @@ func New(
 		return app.App.InitChainer(ctx, req)
 	})
 
+	// top-level x/nft sends of domain NFTs transfer the domains they represent; nested ones are
+	// handled by app.nftSendRouter.
+	app.SetPostHandler(dnsblockchainmodulekeeper.NewNFTPostHandler(app.DnsblockchainKeeper))
+
+	app.registerUpgradeHandlers()
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
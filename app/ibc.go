@@ -82,7 +82,7 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
 		app.IBCKeeper.ChannelKeeper,
 		app.AuthKeeper,
-		app.MsgServiceRouter(),
+		app.nftSendRouter,
 		app.GRPCQueryRouter(),
 		govModuleAddr,
 	)
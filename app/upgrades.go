@@ -0,0 +1,22 @@
+package app
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// DomainNFTsUpgradeName is the upgrade that represents the registered domains as x/nft tokens.
+const DomainNFTsUpgradeName = "domain-nfts"
+
+// registerUpgradeHandlers registers the handlers of the chain's software upgrades, which run the
+// in-place store migrations of the modules whose consensus version changed.
+func (app *App) registerUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		DomainNFTsUpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+}
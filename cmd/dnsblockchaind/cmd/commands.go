@@ -26,6 +26,7 @@ import (
 
 	"dnsblockchain/app"
 	daocli "dnsblockchain/x/dao/client/cli" // Asegúrate que esta ruta es correcta y existe el paquete
+	dnscli "dnsblockchain/x/dnsblockchain/client/cli"
 )
 
 func initRootCmd(
@@ -54,6 +55,9 @@ func initRootCmd(
 		queryCommand(),
 		txCommand(), // Llama a la función txCommand() que definimos abajo
 		keys.Commands(),
+		dnscli.GetDNSCmd(),
+		dnscli.GetResolverCmd(),
+		dnscli.GetZoneCmd(),
 	)
 }
 
@@ -114,6 +118,7 @@ func txCommand() *cobra.Command {
 	// Aquí añadimos explícitamente los comandos de transacción para el módulo DAO
 	// ya que estamos usando un constructor personalizado.
 	cmd.AddCommand(daocli.GetTxCmd()) // Esto llama a la función GetTxCmd del paquete daocli
+	cmd.AddCommand(dnscli.GetTxCmd())
 
 	return cmd
 }
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const FlagDeposit = "deposit"
+
+// NewPlaceBidCmd returns a command placing a sealed bid in an auction, computing the bid hash
+// locally so the amount stays secret until it is revealed.
+func NewPlaceBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-bid [name] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Place a sealed bid in the auction of a name",
+		Long: `Send a MsgPlaceBid for a name being auctioned. Only the hash of the name, the bidder, the amount
+and a salt is sent, together with a deposit that must cover the amount. Depositing more than the
+bid (see --deposit) hides how much it is; the excess is refunded at settlement. Once bidding
+closes, open the bid with reveal-bid, giving the same amount and salt and the name servers to
+register the name with if the bid wins: bids not revealed in time forfeit part of their deposit.
+
+Without --salt a random salt is generated and printed: keep it, it is needed for the reveal.`,
+		Example: `dnsblockchaind tx dnsblockchain place-bid example.web3 25000000udns --deposit 40000000udns --from alice
+dnsblockchaind tx dnsblockchain reveal-bid example.web3 25000000udns <salt> --ns-records '{"name":"ns1.example.web3","ipv4_addresses":["1.2.3.4"]}' --from alice`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			deposit := sdk.NewCoins(amount)
+			if s, err := cmd.Flags().GetString(FlagDeposit); err != nil {
+				return err
+			} else if s != "" {
+				if deposit, err = sdk.ParseCoinsNormalized(s); err != nil {
+					return err
+				}
+			}
+
+			salt, err := cmd.Flags().GetString(FlagSalt)
+			if err != nil {
+				return err
+			}
+			if salt == "" {
+				bz := make([]byte, 32)
+				if _, err := rand.Read(bz); err != nil {
+					return err
+				}
+				salt = hex.EncodeToString(bz)
+			}
+
+			bidder := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgPlaceBid(bidder, args[0], types.BidHash(args[0], bidder, amount.String(), salt), deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			cmd.PrintErrf("Salt: %s\n", salt)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSalt, "", "Secret salt of the bid (random when empty)")
+	cmd.Flags().String(FlagDeposit, "", "Deposit escrowed with the bid, at least the amount (defaults to the amount)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const FlagSalt = "salt"
+
+// NewCommitDomainCmd returns a command sending the commitment of a commit-reveal registration,
+// computing its hash locally so the name never appears in the transaction.
+func NewCommitDomainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-domain [name] [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Commit to registering a name without revealing it",
+		Long: `Send a MsgCommitDomain for a name, the first step of a registration that cannot be front-run.
+Only the hash of the name, the owner, the signer and a salt is sent, together with the commitment
+deposit. Once the commitment is old enough (see the commit_min_blocks and commit_max_blocks
+params), register the name with reveal-domain, giving the same name, owner and salt.
+
+Without --salt a random salt is generated and printed: keep it, it is needed for the reveal.`,
+		Example: `dnsblockchaind tx dnsblockchain commit-domain example.web3 cosmos1... --from alice
+dnsblockchaind tx dnsblockchain reveal-domain example.web3 cosmos1... <salt> --ns-records '{"name":"ns1.example.web3","ipv4_addresses":["192.0.2.1"]}' --from alice`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt, err := cmd.Flags().GetString(FlagSalt)
+			if err != nil {
+				return err
+			}
+			if salt == "" {
+				bz := make([]byte, 32)
+				if _, err := rand.Read(bz); err != nil {
+					return err
+				}
+				salt = hex.EncodeToString(bz)
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgCommitDomain(creator, types.CommitmentHash(args[0], args[1], creator, salt))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			cmd.PrintErrf("Salt: %s\n", salt)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSalt, "", "Secret salt of the commitment (random when empty)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
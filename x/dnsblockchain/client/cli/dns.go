@@ -0,0 +1,301 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/miekg/dns"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"dnsblockchain/x/dnsblockchain/dnsserver"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const (
+	FlagListen        = "listen"
+	FlagNameserver    = "nameserver"
+	FlagHostmaster    = "hostmaster"
+	FlagNegativeTTL   = "negative-ttl"
+	FlagQueryTimeout  = "query-timeout"
+	FlagDoHListen     = "doh-listen"
+	FlagDoTListen     = "dot-listen"
+	FlagTLSCert       = "tls-cert"
+	FlagTLSKey        = "tls-key"
+	FlagTransferAllow = "transfer-allow"
+	FlagTSIGKey       = "tsig-key"
+	FlagNotify        = "notify"
+	FlagNotifyTSIGKey = "notify-tsig-key"
+)
+
+// GetDNSCmd returns the commands that serve the on-chain registry over the DNS protocol.
+func GetDNSCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "dns",
+		Short:                      "Serve the on-chain domain registry over DNS",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewDNSServeCmd())
+	return cmd
+}
+
+// NewDNSServeCmd returns a command running an authoritative DNS server for the permitted TLDs,
+// answering from the registry of the node given by --node.
+func NewDNSServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Args:  cobra.NoArgs,
+		Short: "Run an authoritative DNS server for the permitted TLDs",
+		Long: `Run an authoritative DNS server answering UDP and TCP queries for the permitted TLDs from the
+on-chain registry. Delegated domains get a referral to their NS records with glue and DS records,
+domains without a delegation are answered from their on-chain records, unknown and expired names
+get NXDOMAIN and names under ICANN-reserved TLDs are refused.
+
+With --doh-listen and --dot-listen the same answers are also served over DNS-over-HTTPS (RFC 8484,
+at /dns-query) and DNS-over-TLS (RFC 7858), using the certificate in --tls-cert and --tls-key.
+
+Zone transfers (AXFR, and IXFR between block heights) are served to the networks in
+--transfer-allow and to requests signed with a --tsig-key. The SOA serial of every zone is the
+block height it was read at, and the secondaries in --notify get a DNS NOTIFY whenever a block
+changes a domain under the TLD. IXFR needs a node that still has the state of the secondary's
+height, so secondaries lagging beyond the node's pruning window get a full transfer instead.`,
+		Example: `dnsblockchaind dns serve --listen 127.0.0.1:5353 --node tcp://localhost:26657
+dnsblockchaind dns serve --dot-listen :853 --doh-listen :443 --tls-cert cert.pem --tls-key key.pem
+dnsblockchaind dns serve --transfer-allow 10.0.0.0/8 --tsig-key xfr-key:c2VjcmV0 --notify 10.0.0.2:53 --notify-tsig-key xfr-key
+dig @127.0.0.1 -p 5353 example.web3 A`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := dnsServerConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			if cfg.Transfer, err = transferConfigFromFlags(cmd); err != nil {
+				return err
+			}
+			if cfg.Transfer != nil {
+				cfg.Transfer.Zones = queryClient
+				if clientCtx.Client != nil {
+					cfg.Transfer.Changes = dnsserver.NewCometChangeFeed(clientCtx.Client)
+				}
+			}
+
+			logger := server.GetServerContextFromCmd(cmd).Logger.With("module", "dns")
+			srv := dnsserver.NewServer(queryClient, cfg, logger)
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			if err := srv.Sync(ctx); err != nil {
+				return fmt.Errorf("failed to read the chain height: %w", err)
+			}
+
+			if cfg.Transfer != nil {
+				logger.Info("Serving zone transfers", "allow", len(cfg.Transfer.AllowedNetworks), "tsig_keys", len(cfg.Transfer.TSIGKeys), "secondaries", cfg.Transfer.Secondaries)
+			}
+			go func() {
+				if err := srv.WatchChanges(ctx, dnsserver.DefaultNotifyPollInterval); err != nil {
+					logger.Error("Stopped watching the chain for changes", "error", err)
+				}
+			}()
+
+			logger.Info("Serving DNS", "nameserver", cfg.Nameserver)
+			return serveFrontends(ctx, cmd, srv, logger, srv.ServeOptions()...)
+		},
+	}
+
+	addFrontendFlags(cmd, "127.0.0.1:5353")
+	addDNSServerFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// addDNSServerFlags adds the flags configuring the authoritative answers.
+func addDNSServerFlags(cmd *cobra.Command) {
+	defaults := dnsserver.DefaultConfig()
+	cmd.Flags().String(FlagNameserver, defaults.Nameserver, "Host name of this server, published in each TLD's SOA and NS records")
+	cmd.Flags().String(FlagHostmaster, "", "SOA responsible mailbox in domain form (default hostmaster.<tld>.)")
+	cmd.Flags().Uint32(FlagNegativeTTL, defaults.NegativeTTL, "Seconds resolvers may cache NXDOMAIN and NODATA answers")
+	cmd.Flags().Duration(FlagQueryTimeout, defaults.QueryTimeout, "Timeout of each registry lookup")
+	cmd.Flags().StringSlice(FlagTransferAllow, nil, "IP addresses and CIDR networks allowed to transfer zones without TSIG")
+	cmd.Flags().StringArray(FlagTSIGKey, nil, "TSIG key allowed to transfer zones, as [algorithm:]name:base64-secret (repeatable)")
+	cmd.Flags().StringArray(FlagNotify, nil, "Secondary host:port to send NOTIFY to when a block changes a zone (repeatable)")
+	cmd.Flags().String(FlagNotifyTSIGKey, "", "Name of the --tsig-key signing NOTIFY messages (unsigned when empty)")
+}
+
+func dnsServerConfigFromFlags(cmd *cobra.Command) (dnsserver.Config, error) {
+	var (
+		cfg     dnsserver.Config
+		err     error
+		timeout time.Duration
+	)
+	if cfg.Nameserver, err = cmd.Flags().GetString(FlagNameserver); err != nil {
+		return cfg, err
+	}
+	if cfg.Hostmaster, err = cmd.Flags().GetString(FlagHostmaster); err != nil {
+		return cfg, err
+	}
+	if cfg.NegativeTTL, err = cmd.Flags().GetUint32(FlagNegativeTTL); err != nil {
+		return cfg, err
+	}
+	if timeout, err = cmd.Flags().GetDuration(FlagQueryTimeout); err != nil {
+		return cfg, err
+	}
+	cfg.QueryTimeout = timeout
+	return cfg, nil
+}
+
+// transferConfigFromFlags returns the zone transfer settings, or nil when none of the transfer
+// flags is set.
+func transferConfigFromFlags(cmd *cobra.Command) (*dnsserver.TransferConfig, error) {
+	allow, err := cmd.Flags().GetStringSlice(FlagTransferAllow)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := cmd.Flags().GetStringArray(FlagTSIGKey)
+	if err != nil {
+		return nil, err
+	}
+	secondaries, err := cmd.Flags().GetStringArray(FlagNotify)
+	if err != nil {
+		return nil, err
+	}
+	notifyKey, err := cmd.Flags().GetString(FlagNotifyTSIGKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(allow) == 0 && len(keys) == 0 && len(secondaries) == 0 {
+		if notifyKey != "" {
+			return nil, fmt.Errorf("--%s requires --%s", FlagNotifyTSIGKey, FlagNotify)
+		}
+		return nil, nil
+	}
+
+	cfg := &dnsserver.TransferConfig{Secondaries: secondaries}
+	if cfg.AllowedNetworks, err = dnsserver.ParseNetworks(allow); err != nil {
+		return nil, err
+	}
+	for _, s := range keys {
+		key, err := dnsserver.ParseTSIGKey(s)
+		if err != nil {
+			return nil, err
+		}
+		cfg.TSIGKeys = append(cfg.TSIGKeys, key)
+	}
+	for _, secondary := range secondaries {
+		if _, _, err := net.SplitHostPort(secondary); err != nil {
+			return nil, fmt.Errorf("invalid --%s address %q: %w", FlagNotify, secondary, err)
+		}
+	}
+	if notifyKey != "" {
+		cfg.NotifyKey = dns.Fqdn(strings.ToLower(notifyKey))
+		if !slices.ContainsFunc(cfg.TSIGKeys, func(key dnsserver.TSIGKey) bool { return key.Name == cfg.NotifyKey }) {
+			return nil, fmt.Errorf("--%s %s is not one of the --%s keys", FlagNotifyTSIGKey, notifyKey, FlagTSIGKey)
+		}
+	}
+	return cfg, nil
+}
+
+// addFrontendFlags adds the listen addresses of the plain, DoH and DoT front-ends and the TLS
+// certificate they share.
+func addFrontendFlags(cmd *cobra.Command, defaultListen string) {
+	cmd.Flags().String(FlagListen, defaultListen, "UDP and TCP address to answer DNS queries on (empty disables plain DNS)")
+	cmd.Flags().String(FlagDoHListen, "", "Address to answer DNS-over-HTTPS queries on (disabled when empty)")
+	cmd.Flags().String(FlagDoTListen, "", "Address to answer DNS-over-TLS queries on (disabled when empty)")
+	cmd.Flags().String(FlagTLSCert, "", "PEM certificate chain file for DoH and DoT")
+	cmd.Flags().String(FlagTLSKey, "", "PEM private key file for DoH and DoT")
+}
+
+// serveFrontends answers queries with answerer on every enabled front-end until ctx is cancelled
+// or one of them fails, which stops the others. opts apply to the plain DNS and DoT servers.
+func serveFrontends(ctx context.Context, cmd *cobra.Command, answerer dnsserver.Answerer, logger log.Logger, opts ...dnsserver.ServeOption) error {
+	listen, err := cmd.Flags().GetString(FlagListen)
+	if err != nil {
+		return err
+	}
+	dohListen, err := cmd.Flags().GetString(FlagDoHListen)
+	if err != nil {
+		return err
+	}
+	dotListen, err := cmd.Flags().GetString(FlagDoTListen)
+	if err != nil {
+		return err
+	}
+
+	var frontends []func(context.Context) error
+	if dohListen != "" || dotListen != "" {
+		certFile, err := cmd.Flags().GetString(FlagTLSCert)
+		if err != nil {
+			return err
+		}
+		keyFile, err := cmd.Flags().GetString(FlagTLSKey)
+		if err != nil {
+			return err
+		}
+		tlsConfig, err := dnsserver.LoadTLSConfig(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+
+		if dohListen != "" {
+			logger.Info("Serving DNS-over-HTTPS", "listen", dohListen, "path", dnsserver.DoHPath)
+			frontends = append(frontends, func(ctx context.Context) error {
+				return dnsserver.ListenAndServeDoH(ctx, dnsserver.NewDoHHandler(answerer, logger), dohListen, tlsConfig)
+			})
+		}
+		if dotListen != "" {
+			logger.Info("Serving DNS-over-TLS", "listen", dotListen)
+			frontends = append(frontends, func(ctx context.Context) error {
+				return dnsserver.ListenAndServeDoT(ctx, dnsserver.NewHandler(answerer, logger), dotListen, tlsConfig, opts...)
+			})
+		}
+	}
+	if listen != "" {
+		logger.Info("Serving plain DNS", "listen", listen)
+		frontends = append(frontends, func(ctx context.Context) error {
+			return dnsserver.ListenAndServe(ctx, dnsserver.NewHandler(answerer, logger), listen, opts...)
+		})
+	}
+	if len(frontends) == 0 {
+		return errors.New("no front-end enabled: set --listen, --doh-listen or --dot-listen")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errCh := make(chan error, len(frontends))
+	for _, serve := range frontends {
+		go func(serve func(context.Context) error) {
+			err := serve(ctx)
+			cancel()
+			errCh <- err
+		}(serve)
+	}
+
+	var errs []error
+	for range frontends {
+		if err := <-errCh; err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
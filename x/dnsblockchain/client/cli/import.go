@@ -0,0 +1,353 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const (
+	FlagFormat         = "format"
+	FlagOrigin         = "origin"
+	FlagOwner          = "owner"
+	FlagYears          = "years"
+	FlagBatchSize      = "batch-size"
+	FlagMaxBatchGas    = "max-batch-gas"
+	FlagStateFile      = "state-file"
+	FlagConfirmTimeout = "confirm-timeout"
+
+	// DefaultImportBatchSize is the most CreateDomain messages sent in one transaction.
+	DefaultImportBatchSize = 25
+	// DefaultImportMaxBatchGas is the most gas one import transaction may use.
+	DefaultImportMaxBatchGas uint64 = 3_000_000
+	// DefaultImportConfirmTimeout is how long the import waits for each transaction to be included.
+	DefaultImportConfirmTimeout = time.Minute
+)
+
+// NewImportCmd returns a command registering the domains of a zone file or CSV in batches.
+func NewImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Register the domains of a zone file or CSV in batched transactions",
+		Long: `Register every domain listed in a zone file or CSV, as one CreateDomain message per name signed by
+--from. Each name is first checked with the same rules as CreateDomain; names that fail are
+reported and left out. The remaining names are sent in multi-message transactions of at most
+--batch-size messages, split further when their simulated gas exceeds --max-batch-gas, and each
+transaction is confirmed before the next is sent.
+
+A zone file (the default unless the file ends in .csv) provides the delegations: every name with
+NS records below a zone apex, with the A and AAAA records of its name servers as glue. A CSV
+provides one name server per row as "name,owner,ns,ipv4,ipv6,years"; rows of the same name are
+merged, multiple addresses are separated by spaces or semicolons, and empty owner and years use
+--owner and --years.
+
+The outcome of each name is recorded in --state-file. Running the same command again after a
+failure skips names already registered and resumes with the rest. With --dry-run the names are
+only checked and reported.`,
+		Example: `dnsblockchaind tx dnsblockchain import web3.zone --origin web3 --from alice
+dnsblockchaind tx dnsblockchain import names.csv --owner cosmos1... --years 2 --from alice --gas-adjustment 1.5
+CSV example:
+name,owner,ns,ipv4,ipv6,years
+example.web3,,ns1.example.web3,192.0.2.1,2001:db8::1,1
+example.web3,,ns2.provider.org,,,`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, _ := cmd.Flags().GetString(FlagFormat)
+			origin, _ := cmd.Flags().GetString(FlagOrigin)
+			entries, err := parseImportFile(args[0], format, origin)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", args[0], err)
+			}
+			if len(entries) == 0 {
+				return fmt.Errorf("no domains found in %s", args[0])
+			}
+			sortParentsFirst(entries)
+
+			owner, _ := cmd.Flags().GetString(FlagOwner)
+			if owner == "" {
+				owner = clientCtx.GetFromAddress().String()
+			}
+			years, _ := cmd.Flags().GetUint64(FlagYears)
+			for i := range entries {
+				if entries[i].Owner == "" {
+					entries[i].Owner = owner
+				}
+				if entries[i].Years == 0 {
+					entries[i].Years = years
+				}
+			}
+
+			statePath, _ := cmd.Flags().GetString(FlagStateFile)
+			if statePath == "" {
+				statePath = args[0] + ".import.json"
+			}
+			state, err := loadImportState(statePath)
+			if err != nil {
+				return err
+			}
+
+			imp := &importer{clientCtx: clientCtx, queryClient: types.NewQueryClient(clientCtx), state: state}
+			imp.batchSize, _ = cmd.Flags().GetInt(FlagBatchSize)
+			imp.maxBatchGas, _ = cmd.Flags().GetUint64(FlagMaxBatchGas)
+			imp.confirmTimeout, _ = cmd.Flags().GetDuration(FlagConfirmTimeout)
+			if clientCtx.GenerateOnly {
+				return errors.New("import broadcasts its batches as it goes and cannot be used with --generate-only")
+			}
+
+			runErr := imp.run(cmd, entries, clientCtx.Simulate)
+			if err := writeImportReport(cmd.OutOrStdout(), entries, state); err != nil {
+				return err
+			}
+			if runErr != nil {
+				return fmt.Errorf("%w; the progress is saved in %s, rerun the same command to resume", runErr, statePath)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, "", "Import file format, zone or csv (default inferred from the file extension)")
+	cmd.Flags().String(FlagOrigin, "", "Origin of relative names in a zone file without $ORIGIN")
+	cmd.Flags().String(FlagOwner, "", "Owner of names without one in the file (default the --from address)")
+	cmd.Flags().Uint64(FlagYears, 1, "Registration years of names without them in the file")
+	cmd.Flags().Int(FlagBatchSize, DefaultImportBatchSize, "Most domains registered per transaction")
+	cmd.Flags().Uint64(FlagMaxBatchGas, DefaultImportMaxBatchGas, "Most gas one transaction may use; larger batches are split")
+	cmd.Flags().String(FlagStateFile, "", "File recording the outcome of each name for resuming (default <file>.import.json)")
+	cmd.Flags().Duration(FlagConfirmTimeout, DefaultImportConfirmTimeout, "How long to wait for each transaction to be included in a block")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// importer registers entries in batches, recording each outcome in its state.
+type importer struct {
+	clientCtx      client.Context
+	queryClient    types.QueryClient
+	state          *importState
+	txf            tx.Factory
+	batchSize      int
+	maxBatchGas    uint64
+	confirmTimeout time.Duration
+}
+
+func (imp *importer) run(cmd *cobra.Command, entries []importEntry, dryRun bool) error {
+	ctx := cmd.Context()
+	pending, err := imp.check(ctx, entries)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		for _, msg := range pending {
+			imp.state.set(msg.Name, importResult{Status: importStatusReady})
+		}
+		return nil
+	}
+	if err := imp.state.save(); err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if imp.txf, err = tx.NewFactoryCLI(imp.clientCtx, cmd.Flags()); err != nil {
+		return err
+	}
+	if imp.txf, err = imp.txf.Prepare(imp.clientCtx); err != nil {
+		return err
+	}
+
+	batchSize := max(imp.batchSize, 1)
+	for start := 0; start < len(pending); start += batchSize {
+		batch := pending[start:min(start+batchSize, len(pending))]
+		if err := imp.send(ctx, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check applies the CreateDomain rules to every entry not yet imported and returns the messages of
+// the entries to register. Entries left pending by an interrupted run count as imported once the
+// registry has them. It stops at the first name the chain requires a commitment for, as the
+// importer only registers names with MsgCreateDomain.
+func (imp *importer) check(ctx context.Context, entries []importEntry) ([]*types.MsgCreateDomain, error) {
+	creator := imp.clientCtx.GetFromAddress().String()
+	queued := map[string]bool{}
+
+	var msgs []*types.MsgCreateDomain
+	for _, entry := range entries {
+		previous, seen := imp.state.Results[entry.Name]
+		if seen && (previous.Status == importStatusCreated || previous.Status == importStatusSkipped) {
+			continue
+		}
+
+		msg := types.NewMsgCreateDomain(creator, entry.Name, entry.Owner, entry.NsRecords, entry.Years)
+		if err := msg.ValidateBasic(); err != nil {
+			imp.state.set(entry.Name, importResult{Status: importStatusFailed, Detail: err.Error()})
+			continue
+		}
+
+		res, err := imp.queryClient.CheckAvailability(ctx, &types.QueryCheckAvailabilityRequest{Name: entry.Name, Years: entry.Years})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			imp.state.set(entry.Name, importResult{Status: importStatusFailed, Detail: err.Error()})
+			continue
+		}
+		switch {
+		case res.Available:
+		case res.Reason == types.Availability_AVAILABILITY_COMMITMENT_REQUIRED:
+			// Every other name directly under a TLD would be refused the same way.
+			return nil, fmt.Errorf("cannot import %s: %s", entry.Name, res.Message)
+		case res.Reason == types.Availability_AVAILABILITY_REGISTERED && seen && previous.Status == importStatusPending:
+			imp.state.set(entry.Name, importResult{Status: importStatusCreated, TxHash: previous.TxHash})
+			continue
+		case res.Reason == types.Availability_AVAILABILITY_REGISTERED:
+			imp.state.set(entry.Name, importResult{Status: importStatusSkipped, Detail: "already registered"})
+			continue
+		case res.Reason == types.Availability_AVAILABILITY_PARENT_NOT_REGISTERED && queued[types.ParentName(entry.Name)]:
+			// The parent is registered earlier in this import.
+		default:
+			imp.state.set(entry.Name, importResult{Status: importStatusFailed, Detail: res.Message})
+			continue
+		}
+
+		queued[entry.Name] = true
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// send registers a batch in one transaction, splitting it while its simulation fails or needs more
+// than the gas limit. A batch of one message that cannot be simulated records that name as failed.
+func (imp *importer) send(ctx context.Context, batch []*types.MsgCreateDomain) error {
+	msgs := make([]sdk.Msg, len(batch))
+	for i, msg := range batch {
+		msgs[i] = msg
+	}
+
+	_, gas, err := tx.CalculateGas(imp.clientCtx, imp.txf, msgs...)
+	if err != nil || gas > imp.maxBatchGas {
+		if len(batch) > 1 {
+			half := len(batch) / 2
+			if err := imp.send(ctx, batch[:half]); err != nil {
+				return err
+			}
+			return imp.send(ctx, batch[half:])
+		}
+		detail := fmt.Sprintf("needs %d gas, more than the %d allowed per transaction", gas, imp.maxBatchGas)
+		if err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			detail = err.Error()
+		}
+		imp.state.set(batch[0].Name, importResult{Status: importStatusFailed, Detail: detail})
+		return imp.state.save()
+	}
+
+	txf := imp.txf.WithGas(gas)
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(ctx, txf, imp.clientCtx.FromName, txBuilder, true); err != nil {
+		return err
+	}
+	txBytes, err := imp.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := imp.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("transaction rejected with code %d: %s", res.Code, res.RawLog)
+	}
+	// From here the names may be registered: record them so a resumed run checks before resending.
+	for _, msg := range batch {
+		imp.state.set(msg.Name, importResult{Status: importStatusPending, TxHash: res.TxHash})
+	}
+	if err := imp.state.save(); err != nil {
+		return err
+	}
+	imp.txf = imp.txf.WithSequence(imp.txf.Sequence() + 1)
+
+	included, err := imp.waitForTx(ctx, res.TxHash)
+	if err != nil {
+		return err
+	}
+	for _, msg := range batch {
+		if included.Code == 0 {
+			imp.state.set(msg.Name, importResult{Status: importStatusCreated, TxHash: included.TxHash})
+		} else {
+			imp.state.set(msg.Name, importResult{Status: importStatusFailed, TxHash: included.TxHash, Detail: included.RawLog})
+		}
+	}
+	return imp.state.save()
+}
+
+// waitForTx polls for a transaction until it is included in a block or the confirm timeout passes.
+func (imp *importer) waitForTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, imp.confirmTimeout)
+	defer cancel()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		if res, err := authtx.QueryTx(imp.clientCtx, hash); err == nil {
+			return res, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.Join(fmt.Errorf("transaction %s not confirmed", hash), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeImportReport prints the outcome of every entry and a summary.
+func writeImportReport(out io.Writer, entries []importEntry, state *importState) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSTATUS\tTX\tDETAIL")
+	counts := map[string]int{}
+	for _, entry := range entries {
+		result, ok := state.Results[entry.Name]
+		if !ok {
+			result = importResult{Status: importStatusNotSent}
+		}
+		counts[result.Status]++
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entry.Name, result.Status, result.TxHash, strings.ReplaceAll(result.Detail, "\n", " "))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	summary := make([]string, 0, len(counts))
+	for _, status := range []string{importStatusCreated, importStatusReady, importStatusSkipped, importStatusFailed, importStatusPending, importStatusNotSent} {
+		if counts[status] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[status], status))
+		}
+	}
+	_, err := fmt.Fprintf(out, "%d names: %s\n", len(entries), strings.Join(summary, ", "))
+	return err
+}
@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const (
+	importFormatCSV  = "csv"
+	importFormatZone = "zone"
+)
+
+// importEntry is a domain to register, as read from an import file.
+type importEntry struct {
+	Name      string
+	Owner     string
+	Years     uint64
+	NsRecords []*types.NSRecordWithIP
+}
+
+// parseImportFile reads the entries of a CSV or zone file. An empty format is inferred from the
+// file extension, with anything other than .csv read as a zone file.
+func parseImportFile(path, format, origin string) ([]importEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if format == "" {
+		format = importFormatZone
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			format = importFormatCSV
+		}
+	}
+	switch format {
+	case importFormatCSV:
+		return parseImportCSV(f)
+	case importFormatZone:
+		return parseImportZone(f, origin, path)
+	default:
+		return nil, fmt.Errorf("unknown import format %q: must be %s or %s", format, importFormatCSV, importFormatZone)
+	}
+}
+
+// parseImportCSV reads rows of "name,owner,ns,ipv4,ipv6,years", one per name server. Rows of the
+// same name are merged into one entry; owner, ipv4, ipv6 and years may be empty, and multiple
+// addresses are separated by spaces or semicolons. A header row starting with "name" is skipped,
+// as are lines starting with '#'.
+func parseImportCSV(r io.Reader) ([]importEntry, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var (
+		entries []importEntry
+		index   = map[string]int{}
+	)
+	for line := 1; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && len(row) > 0 && strings.EqualFold(strings.TrimSpace(row[0]), "name") {
+			continue
+		}
+		field := func(i int) string {
+			if i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		name := normalizeImportName(field(0))
+		if name == "" {
+			return nil, fmt.Errorf("line %d: name cannot be empty", line)
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(entries)
+			index[name] = i
+			entries = append(entries, importEntry{Name: name})
+		}
+		entry := &entries[i]
+
+		if owner := field(1); owner != "" {
+			if entry.Owner != "" && entry.Owner != owner {
+				return nil, fmt.Errorf("line %d: conflicting owners %s and %s for %s", line, entry.Owner, owner, name)
+			}
+			entry.Owner = owner
+		}
+		if years := field(5); years != "" {
+			n, err := strconv.ParseUint(years, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid years %q", line, years)
+			}
+			entry.Years = n
+		}
+		if host := normalizeImportName(field(2)); host != "" {
+			entry.NsRecords = append(entry.NsRecords, &types.NSRecordWithIP{
+				Name:          host,
+				Ipv4Addresses: splitAddresses(field(3)),
+				Ipv6Addresses: splitAddresses(field(4)),
+			})
+		}
+	}
+	return entries, nil
+}
+
+// parseImportZone reads the delegations of a master file: every name with NS records other than a
+// zone apex becomes an entry, with the file's A and AAAA records of its name servers as glue.
+// Other records are ignored.
+func parseImportZone(r io.Reader, origin, file string) ([]importEntry, error) {
+	var (
+		apexes = map[string]bool{}
+		order  []string
+		hosts  = map[string][]string{}
+		ipv4   = map[string][]string{}
+		ipv6   = map[string][]string{}
+	)
+	parser := dns.NewZoneParser(r, dns.Fqdn(origin), file)
+	for rr, ok := parser.Next(); ok; rr, ok = parser.Next() {
+		name := normalizeImportName(rr.Header().Name)
+		switch rr := rr.(type) {
+		case *dns.SOA:
+			apexes[name] = true
+		case *dns.NS:
+			if _, seen := hosts[name]; !seen {
+				order = append(order, name)
+			}
+			hosts[name] = append(hosts[name], normalizeImportName(rr.Ns))
+		case *dns.A:
+			ipv4[name] = append(ipv4[name], rr.A.String())
+		case *dns.AAAA:
+			ipv6[name] = append(ipv6[name], rr.AAAA.String())
+		}
+	}
+	if err := parser.Err(); err != nil {
+		return nil, err
+	}
+
+	var entries []importEntry
+	for _, name := range order {
+		if apexes[name] || !strings.Contains(name, ".") {
+			continue
+		}
+		entry := importEntry{Name: name}
+		for _, host := range hosts[name] {
+			entry.NsRecords = append(entry.NsRecords, &types.NSRecordWithIP{
+				Name:          host,
+				Ipv4Addresses: ipv4[host],
+				Ipv6Addresses: ipv6[host],
+			})
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// sortParentsFirst orders entries so every name comes after the names it is a subdomain of,
+// keeping the file order otherwise.
+func sortParentsFirst(entries []importEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return strings.Count(entries[i].Name, ".") < strings.Count(entries[j].Name, ".")
+	})
+}
+
+func normalizeImportName(name string) string {
+	return strings.ToLower(strings.Trim(strings.TrimSpace(name), "."))
+}
+
+func splitAddresses(s string) []string {
+	addrs := strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == ';' })
+	if len(addrs) == 0 {
+		return nil
+	}
+	return addrs
+}
+
+// Import statuses recorded per name.
+const (
+	importStatusCreated = "created"
+	importStatusSkipped = "skipped"
+	importStatusFailed  = "failed"
+	// importStatusPending marks names sent in a transaction whose outcome is not known yet; a
+	// resumed run checks the registry for them.
+	importStatusPending = "pending"
+	// importStatusReady marks names that passed every check in a dry run.
+	importStatusReady = "ready"
+	// importStatusNotSent is reported for names a stopped run did not get to.
+	importStatusNotSent = "not sent"
+)
+
+// importResult is the outcome of importing one name.
+type importResult struct {
+	Status string `json:"status"`
+	TxHash string `json:"tx_hash,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// importState records the outcome of each name, so an interrupted import resumes where it
+// stopped instead of resending names that were already registered.
+type importState struct {
+	path    string
+	Results map[string]importResult `json:"results"`
+}
+
+// loadImportState reads the state file at path, returning an empty state if it does not exist.
+func loadImportState(path string) (*importState, error) {
+	state := &importState{path: path, Results: map[string]importResult{}}
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, fmt.Errorf("invalid import state file %s: %w", path, err)
+	}
+	if state.Results == nil {
+		state.Results = map[string]importResult{}
+	}
+	return state, nil
+}
+
+func (s *importState) set(name string, result importResult) {
+	s.Results[name] = result
+}
+
+// save writes the state atomically, so a crash never leaves a truncated file behind.
+func (s *importState) save() error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestParseImportCSV(t *testing.T) {
+	entries, err := parseImportCSV(strings.NewReader(`name,owner,ns,ipv4,ipv6,years
+# comment
+Example.web3.,cosmos1owner,ns1.example.web3,192.0.2.1;192.0.2.2,2001:db8::1,2
+example.web3,,ns2.provider.org,,,
+www.example.web3,,ns1.example.web3,192.0.2.1
+`))
+	require.NoError(t, err)
+	require.Equal(t, []importEntry{
+		{Name: "example.web3", Owner: "cosmos1owner", Years: 2, NsRecords: []*types.NSRecordWithIP{
+			{Name: "ns1.example.web3", Ipv4Addresses: []string{"192.0.2.1", "192.0.2.2"}, Ipv6Addresses: []string{"2001:db8::1"}},
+			{Name: "ns2.provider.org"},
+		}},
+		{Name: "www.example.web3", NsRecords: []*types.NSRecordWithIP{
+			{Name: "ns1.example.web3", Ipv4Addresses: []string{"192.0.2.1"}},
+		}},
+	}, entries)
+
+	_, err = parseImportCSV(strings.NewReader("a.web3,owner1,ns1.a.web3\na.web3,owner2,ns2.a.web3\n"))
+	require.ErrorContains(t, err, "conflicting owners")
+	_, err = parseImportCSV(strings.NewReader("a.web3,,ns1.a.web3,,,many\n"))
+	require.ErrorContains(t, err, "invalid years")
+}
+
+func TestParseImportZone(t *testing.T) {
+	entries, err := parseImportZone(strings.NewReader(`$TTL 3600
+@        IN SOA ns.registry.example. hostmaster.web3. 1 3600 600 86400 300
+@        IN NS  ns.registry.example.
+example  IN NS  ns1.example
+example  IN NS  ns.provider.org.
+ns1.example IN A    192.0.2.1
+ns1.example IN AAAA 2001:db8::1
+example  IN DS  2371 13 2 ABABABABABABABABABABABABABABABABABABABABABABABABABABABABABABABAB
+other    IN NS  ns.provider.org.
+ns.provider.org. IN A 198.51.100.1
+www      IN A   192.0.2.9
+`), "web3", "web3.zone")
+	require.NoError(t, err)
+	require.Equal(t, []importEntry{
+		{Name: "example.web3", NsRecords: []*types.NSRecordWithIP{
+			{Name: "ns1.example.web3", Ipv4Addresses: []string{"192.0.2.1"}, Ipv6Addresses: []string{"2001:db8::1"}},
+			{Name: "ns.provider.org", Ipv4Addresses: []string{"198.51.100.1"}},
+		}},
+		{Name: "other.web3", NsRecords: []*types.NSRecordWithIP{
+			{Name: "ns.provider.org", Ipv4Addresses: []string{"198.51.100.1"}},
+		}},
+	}, entries)
+
+	_, err = parseImportZone(strings.NewReader("example IN A not-an-address\n"), "web3", "bad.zone")
+	require.Error(t, err)
+}
+
+func TestImportStateResume(t *testing.T) {
+	entries := []importEntry{{Name: "www.b.web3"}, {Name: "b.web3"}, {Name: "a.web3"}}
+	sortParentsFirst(entries)
+	require.Equal(t, []string{"b.web3", "a.web3", "www.b.web3"}, []string{entries[0].Name, entries[1].Name, entries[2].Name})
+
+	path := filepath.Join(t.TempDir(), "names.csv.import.json")
+	state, err := loadImportState(path)
+	require.NoError(t, err)
+	require.Empty(t, state.Results)
+
+	state.set("a.web3", importResult{Status: importStatusCreated, TxHash: "ABC"})
+	state.set("b.web3", importResult{Status: importStatusPending, TxHash: "DEF"})
+	require.NoError(t, state.save())
+
+	resumed, err := loadImportState(path)
+	require.NoError(t, err)
+	require.Equal(t, state.Results, resumed.Results)
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"dnsblockchain/x/dnsblockchain/resolver"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const (
+	FlagUpstream = "upstream"
+	FlagNotFound = "not-found"
+	FlagTimeout  = "timeout"
+)
+
+// GetResolverCmd returns a command running the hybrid recursive resolver described in the
+// whitepaper, resolving chain names from the registry of the node given by --node.
+func GetResolverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolver",
+		Args:  cobra.NoArgs,
+		Short: "Run a hybrid recursive resolver for chain and ICANN names",
+		Long: `Run a recursive DNS resolver answering UDP and TCP queries. Names under ICANN-reserved TLDs are
+forwarded to the --upstream resolvers. Names under chain TLDs are looked up on chain: delegated
+domains are resolved by following their NS records to the owner's authoritative servers, and
+domains without a delegation are answered from their on-chain records. Names the chain does not
+know get NXDOMAIN, or are forwarded to the upstream resolvers with --not-found icann.
+
+With --doh-listen and --dot-listen the resolver is also served over DNS-over-HTTPS (RFC 8484, at
+/dns-query) and DNS-over-TLS (RFC 7858), using the certificate in --tls-cert and --tls-key.`,
+		Example: `dnsblockchaind resolver --listen 127.0.0.1:5300 --upstream 1.1.1.1:53 --upstream 8.8.8.8:53 --not-found icann
+dnsblockchaind resolver --listen "" --doh-listen :443 --tls-cert cert.pem --tls-key key.pem
+dig @127.0.0.1 -p 5300 example.web3 A`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			cfg, err := resolverConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger := server.GetServerContextFromCmd(cmd).Logger.With("module", "resolver")
+			res := resolver.New(types.NewQueryClient(clientCtx), cfg, logger)
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			logger.Info("Serving hybrid resolver", "not_found", cfg.NotFound.String())
+			return serveFrontends(ctx, cmd, res, logger)
+		},
+	}
+
+	addFrontendFlags(cmd, "127.0.0.1:5300")
+	addResolverFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// addResolverFlags adds the flags configuring upstreams and the not-found policy.
+func addResolverFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagUpstream, []string{"1.1.1.1:53", "8.8.8.8:53"}, "ICANN resolvers to forward to, tried in order")
+	cmd.Flags().String(FlagNotFound, resolver.NotFoundNXDOMAIN.String(), "Answer for chain-TLD names not on chain: nxdomain or icann")
+	cmd.Flags().Duration(FlagTimeout, resolver.DefaultTimeout, "Timeout of each resolution")
+}
+
+func resolverConfigFromFlags(cmd *cobra.Command) (resolver.Config, error) {
+	var cfg resolver.Config
+
+	upstreams, err := cmd.Flags().GetStringSlice(FlagUpstream)
+	if err != nil {
+		return cfg, err
+	}
+	if len(upstreams) > 0 {
+		cfg.ICANN = resolver.NewDNSUpstream(upstreams...)
+	}
+
+	notFound, err := cmd.Flags().GetString(FlagNotFound)
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.NotFound, err = resolver.ParseNotFoundPolicy(notFound); err != nil {
+		return cfg, err
+	}
+
+	if cfg.Timeout, err = cmd.Flags().GetDuration(FlagTimeout); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
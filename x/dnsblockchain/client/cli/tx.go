@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// GetTxCmd returns the hand-written transaction commands of the module. AutoCLI adds the
+// generated message commands to it.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewImportCmd(),
+		NewCommitDomainCmd(),
+		NewPlaceBidCmd(),
+	)
+	return cmd
+}
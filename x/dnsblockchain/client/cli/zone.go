@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const (
+	FlagTLD        = "tld"
+	FlagOutputFile = "output-file"
+)
+
+// GetZoneCmd returns the commands that export the registry as DNS zone files.
+func GetZoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "zone",
+		Short:                      "Export the on-chain domain registry as DNS zone files",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewZoneExportCmd())
+	return cmd
+}
+
+// NewZoneExportCmd returns a command writing the zone of a TLD as an RFC 1035 master file.
+func NewZoneExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export --tld [tld]",
+		Args:  cobra.NoArgs,
+		Short: "Export the active domains of a TLD as an RFC 1035 zone file",
+		Long: `Export the active domains of a TLD as an RFC 1035 master file that BIND, Knot and other
+secondaries can load. The zone holds an SOA whose serial is the block height it was exported at,
+the NS delegation of each domain with its in-zone glue A/AAAA records and DS records, and the
+on-chain records of domains without a delegation. Expired domains are left out. Use --height to
+export the registry as of an earlier block. The zone is queried and written a page of domains at a
+time, every page at the height of the first.`,
+		Example: `dnsblockchaind zone export --tld web3 > web3.zone
+dnsblockchaind zone export --tld web3 --height 120000 --nameserver ns1.example.org --output-file web3.zone`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tld, err := cmd.Flags().GetString(FlagTLD)
+			if err != nil {
+				return err
+			}
+			nameserver, err := cmd.Flags().GetString(FlagNameserver)
+			if err != nil {
+				return err
+			}
+			hostmaster, err := cmd.Flags().GetString(FlagHostmaster)
+			if err != nil {
+				return err
+			}
+			outputFile, err := cmd.Flags().GetString(FlagOutputFile)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			var file *os.File
+			if outputFile != "" {
+				if file, err = os.Create(outputFile); err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+
+			req := &types.QueryExportZoneRequest{
+				Tld:        tld,
+				Nameserver: nameserver,
+				Hostmaster: hostmaster,
+			}
+			var (
+				domains uint64
+				serial  uint32
+			)
+			for {
+				res, err := types.NewQueryClient(clientCtx).ExportZone(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				if _, err := fmt.Fprint(out, res.Zone); err != nil {
+					return err
+				}
+				domains += res.DomainCount
+				serial = res.Serial
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				// The rest of the zone is read at the height of its first page.
+				clientCtx = clientCtx.WithHeight(int64(res.Serial))
+				req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			if file == nil {
+				return nil
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+			cmd.PrintErrf("Wrote %d domains of %s with serial %d to %s\n", domains, tld, serial, outputFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagTLD, "", "TLD to export, e.g. web3")
+	cmd.Flags().String(FlagNameserver, "", "Host name published as the zone's primary name server (default ns1.dnsblockchain.)")
+	cmd.Flags().String(FlagHostmaster, "", "SOA responsible mailbox in domain form (default hostmaster.<tld>.)")
+	cmd.Flags().String(FlagOutputFile, "", "Write the zone to this file instead of stdout")
+	_ = cmd.MarkFlagRequired(FlagTLD)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,155 @@
+package dnsserver
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/miekg/dns"
+)
+
+const (
+	// DoHPath is the URI path DNS-over-HTTPS queries are served on, as suggested by RFC 8484.
+	DoHPath = "/dns-query"
+	// DoHMediaType is the media type of DNS wire-format messages over HTTPS (RFC 8484 section 6).
+	DoHMediaType = "application/dns-message"
+
+	// dohShutdownTimeout bounds the graceful shutdown of the HTTPS server.
+	dohShutdownTimeout = 5 * time.Second
+)
+
+// NewDoHHandler adapts an Answerer to an RFC 8484 DNS-over-HTTPS handler, accepting queries as
+// POST bodies and as the base64url "dns" parameter of GET requests.
+func NewDoHHandler(answerer Answerer, logger log.Logger) http.Handler {
+	return dohHandler{answerer: answerer, logger: logger}
+}
+
+type dohHandler struct {
+	answerer Answerer
+	logger   log.Logger
+}
+
+func (h dohHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		wire []byte
+		err  error
+	)
+	switch r.Method {
+	case http.MethodGet:
+		wire, err = base64.RawURLEncoding.DecodeString(r.URL.Query().Get("dns"))
+		if err != nil || len(wire) == 0 {
+			http.Error(w, "missing or malformed dns parameter", http.StatusBadRequest)
+			return
+		}
+	case http.MethodPost:
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != DoHMediaType {
+			http.Error(w, "content type must be "+DoHMediaType, http.StatusUnsupportedMediaType)
+			return
+		}
+		wire, err = io.ReadAll(http.MaxBytesReader(w, r.Body, dns.MaxMsgSize))
+		if err != nil {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req := new(dns.Msg)
+	if err := req.Unpack(wire); err != nil {
+		http.Error(w, "malformed DNS message", http.StatusBadRequest)
+		return
+	}
+
+	resp := h.answerer.Answer(r.Context(), req)
+	packed, err := resp.Pack()
+	if err != nil {
+		h.logger.Error("Failed to pack DNS response", "name", questionName(req), "error", err)
+		http.Error(w, "failed to pack DNS response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", DoHMediaType)
+	if ttl, ok := minTTL(resp); ok {
+		w.Header().Set("Cache-Control", "max-age="+strconv.FormatUint(uint64(ttl), 10))
+	}
+	if _, err := w.Write(packed); err != nil {
+		h.logger.Debug("Failed to write DoH response", "remote", r.RemoteAddr, "error", err)
+	}
+}
+
+// ListenAndServeDoH serves handler over HTTPS on addr at DoHPath until ctx is cancelled.
+func ListenAndServeDoH(ctx context.Context, handler http.Handler, addr string, tlsConfig *tls.Config) error {
+	l, err := tls.Listen("tcp", addr, tlsConfig)
+	if err != nil {
+		return err
+	}
+	return ServeDoH(ctx, handler, l)
+}
+
+// ServeDoH serves handler at DoHPath on l, which must already terminate TLS, until ctx is
+// cancelled or the listener fails. The listener is closed on return.
+func ServeDoH(ctx context.Context, handler http.Handler, l net.Listener) error {
+	mux := http.NewServeMux()
+	mux.Handle(DoHPath, handler)
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.Serve(l) }()
+
+	select {
+	case <-ctx.Done():
+	case err := <-errCh:
+		return err
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), dohShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// minTTL returns the smallest TTL in a response, which bounds how long it may be cached per
+// RFC 8484 section 5.1.
+func minTTL(m *dns.Msg) (uint32, bool) {
+	var (
+		ttl   uint32
+		found bool
+	)
+	for _, section := range [][]dns.RR{m.Answer, m.Ns, m.Extra} {
+		for _, rr := range section {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+			if !found || rr.Header().Ttl < ttl {
+				ttl, found = rr.Header().Ttl, true
+			}
+		}
+	}
+	return ttl, found
+}
+
+func questionName(m *dns.Msg) string {
+	if len(m.Question) == 0 {
+		return ""
+	}
+	return m.Question[0].Name
+}
@@ -0,0 +1,145 @@
+package dnsserver_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/dnsserver"
+)
+
+// writeTestCert writes a self-signed certificate for 127.0.0.1 and its key to PEM files and
+// returns their paths with a pool trusting the certificate.
+func writeTestCert(t *testing.T) (certFile, keyFile string, roots *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "dns.test"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	roots = x509.NewCertPool()
+	roots.AddCert(cert)
+	return certFile, keyFile, roots
+}
+
+// startTLS serves the test registry over DoH and DoT on localhost and returns the DoH URL, the
+// DoT address and a client TLS configuration trusting the servers.
+func startTLS(t *testing.T) (dohURL, dotAddr string, clientTLS *tls.Config) {
+	t.Helper()
+	certFile, keyFile, roots := writeTestCert(t)
+	tlsConfig, err := dnsserver.LoadTLSConfig(certFile, keyFile)
+	require.NoError(t, err)
+
+	srv := dnsserver.NewServer(testRegistry(), dnsserver.DefaultConfig(), log.NewNopLogger())
+	dohListener, err := tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	require.NoError(t, err)
+	dotListener, err := tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 2)
+	go func() { done <- dnsserver.ServeDoH(ctx, dnsserver.NewDoHHandler(srv, log.NewNopLogger()), dohListener) }()
+	go func() { done <- dnsserver.ServeDoT(ctx, srv, dotListener) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+		require.NoError(t, <-done)
+	})
+
+	dohURL = "https://" + dohListener.Addr().String() + dnsserver.DoHPath
+	return dohURL, dotListener.Addr().String(), &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+}
+
+func TestDoH(t *testing.T) {
+	dohURL, _, clientTLS := startTLS(t)
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}}
+
+	req := new(dns.Msg)
+	req.SetQuestion("onchain.web3.", dns.TypeA)
+	req.Id = 0
+	wire, err := req.Pack()
+	require.NoError(t, err)
+
+	get, err := client.Get(dohURL + "?dns=" + base64.RawURLEncoding.EncodeToString(wire))
+	require.NoError(t, err)
+	post, err := client.Post(dohURL, dnsserver.DoHMediaType, bytes.NewReader(wire))
+	require.NoError(t, err)
+
+	for _, httpResp := range []*http.Response{get, post} {
+		body, err := io.ReadAll(httpResp.Body)
+		require.NoError(t, err)
+		require.NoError(t, httpResp.Body.Close())
+		require.Equal(t, http.StatusOK, httpResp.StatusCode)
+		require.Equal(t, dnsserver.DoHMediaType, httpResp.Header.Get("Content-Type"))
+		require.Equal(t, "max-age=300", httpResp.Header.Get("Cache-Control"))
+
+		resp := new(dns.Msg)
+		require.NoError(t, resp.Unpack(body))
+		require.Len(t, resp.Answer, 1)
+		require.Equal(t, "192.0.2.10", resp.Answer[0].(*dns.A).A.String())
+	}
+
+	// Malformed queries and other media types are rejected.
+	httpResp, err := client.Get(dohURL + "?dns=not-a-message")
+	require.NoError(t, err)
+	require.NoError(t, httpResp.Body.Close())
+	require.Equal(t, http.StatusBadRequest, httpResp.StatusCode)
+
+	httpResp, err = client.Post(dohURL, "application/json", bytes.NewReader(wire))
+	require.NoError(t, err)
+	require.NoError(t, httpResp.Body.Close())
+	require.Equal(t, http.StatusUnsupportedMediaType, httpResp.StatusCode)
+}
+
+func TestDoT(t *testing.T) {
+	_, dotAddr, clientTLS := startTLS(t)
+
+	req := new(dns.Msg)
+	req.SetQuestion("www.delegated.web3.", dns.TypeA)
+	client := &dns.Client{Net: "tcp-tls", TLSConfig: clientTLS}
+	resp, _, err := client.Exchange(req, dotAddr)
+	require.NoError(t, err)
+	require.Equal(t, dns.RcodeSuccess, resp.Rcode)
+	require.Empty(t, resp.Answer)
+	require.NotEmpty(t, resp.Ns)
+
+	_, err = dnsserver.LoadTLSConfig("", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package dnsserver
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/miekg/dns"
+)
+
+// LoadTLSConfig returns the TLS configuration of the DoT and DoH front-ends, using the PEM
+// certificate chain and private key in the given files.
+func LoadTLSConfig(certFile, keyFile string) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("both a TLS certificate and a key file are required")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ListenAndServeDoT runs handler as an RFC 7858 DNS-over-TLS server on addr until ctx is
+// cancelled.
+func ListenAndServeDoT(ctx context.Context, handler dns.Handler, addr string, tlsConfig *tls.Config, opts ...ServeOption) error {
+	l, err := tls.Listen("tcp", addr, tlsConfig)
+	if err != nil {
+		return err
+	}
+	return ServeDoT(ctx, handler, l, opts...)
+}
+
+// ServeDoT runs handler on l, which must already terminate TLS, until ctx is cancelled or the
+// listener fails. The listener is closed on return.
+func ServeDoT(ctx context.Context, handler dns.Handler, l net.Listener, opts ...ServeOption) error {
+	srv := &dns.Server{Listener: l, Net: "tcp-tls", Handler: handler}
+	for _, opt := range opts {
+		opt(srv)
+	}
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.ActivateAndServe() }()
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-errCh:
+	}
+	_ = srv.Shutdown()
+	return err
+}
@@ -0,0 +1,227 @@
+package dnsserver
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/miekg/dns"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const (
+	// DefaultNotifyPollInterval is how often the chain is checked for new blocks.
+	DefaultNotifyPollInterval = time.Second
+	// maxNotifyBacklog caps the blocks inspected one by one after falling behind; past it every zone
+	// is notified once.
+	maxNotifyBacklog = 100
+	// notifyAttempts is how many times a NOTIFY is sent before giving up on a secondary.
+	notifyAttempts = 3
+)
+
+// zoneChangingEvents are the module events whose domain changes the records of its zone.
+var zoneChangingEvents = map[string]bool{
+	types.EventTypeCreateDomain:       true,
+	types.EventTypeUpdateDomain:       true,
+	types.EventTypeDeleteDomain:       true,
+	types.EventTypeSetDomainRecords:   true,
+	types.EventTypeSetDomainDSRecords: true,
+	types.EventTypeExpireDomain:       true,
+	types.EventTypeRemoveSubdomain:    true,
+	types.EventTypeDomainStatus:       true,
+}
+
+// ChangeFeed reports the chain height and the TLDs whose zones each block changed.
+type ChangeFeed interface {
+	LatestHeight(ctx context.Context) (int64, error)
+	ChangedTLDs(ctx context.Context, height int64) ([]string, error)
+}
+
+// CometClient is the part of a CometBFT RPC client the change feed reads blocks from. The
+// client.Context Client field satisfies it.
+type CometClient interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// NewCometChangeFeed returns a ChangeFeed reading the domain events of each block from a node.
+func NewCometChangeFeed(client CometClient) ChangeFeed {
+	return cometChangeFeed{client: client}
+}
+
+type cometChangeFeed struct {
+	client CometClient
+}
+
+func (f cometChangeFeed) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := f.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (f cometChangeFeed) ChangedTLDs(ctx context.Context, height int64) ([]string, error) {
+	res, err := f.client.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var tlds []string
+	collect := func(eventType string, attrs map[string]string) {
+		if !zoneChangingEvents[eventType] {
+			return
+		}
+		tld := types.ExtractTLD(strings.ToLower(attrs[types.AttributeKeyDomainName]))
+		if tld != "" && !seen[tld] {
+			seen[tld] = true
+			tlds = append(tlds, tld)
+		}
+	}
+	for _, tx := range res.TxsResults {
+		if tx == nil || tx.Code != 0 {
+			continue
+		}
+		for _, event := range tx.Events {
+			attrs := map[string]string{}
+			for _, attr := range event.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			collect(event.Type, attrs)
+		}
+	}
+	for _, event := range res.FinalizeBlockEvents {
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		collect(event.Type, attrs)
+	}
+	return tlds, nil
+}
+
+// WatchChanges follows the chain until ctx is cancelled, keeping the SOA serial at the latest
+// block height and the permitted TLDs current. When transfers and their change feed are
+// configured, it also sends NOTIFY to the secondaries for every zone a new block changes.
+func (s *Server) WatchChanges(ctx context.Context, pollInterval time.Duration) error {
+	cfg := s.cfg.Transfer
+	if pollInterval <= 0 {
+		pollInterval = DefaultNotifyPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		var err error
+		if cfg != nil && cfg.Changes != nil {
+			err = s.pollChanges(ctx)
+		} else {
+			_, err = s.refreshTLDs(ctx)
+		}
+		if err != nil && ctx.Err() == nil {
+			s.logger.Error("Failed to check the chain for changes", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollChanges notifies the zones changed by the blocks after the last one seen.
+func (s *Server) pollChanges(ctx context.Context) error {
+	cfg := s.cfg.Transfer
+	latest, err := cfg.Changes.LatestHeight(ctx)
+	if err != nil {
+		return err
+	}
+	s.observeHeight(latest)
+	last := s.notified
+	if last == 0 || latest <= last {
+		// The first poll only learns the height: secondaries refresh on their own at startup.
+		s.notified = max(latest, last)
+		return nil
+	}
+
+	changed := map[string]bool{}
+	if latest-last > maxNotifyBacklog {
+		tlds, err := s.refreshTLDs(ctx)
+		if err != nil {
+			return err
+		}
+		for tld := range tlds.set {
+			changed[tld] = true
+		}
+	} else {
+		for height := last + 1; height <= latest; height++ {
+			tlds, err := cfg.Changes.ChangedTLDs(ctx, height)
+			if err != nil {
+				return err
+			}
+			for _, tld := range tlds {
+				changed[tld] = true
+			}
+		}
+	}
+	s.notified = latest
+
+	for tld := range changed {
+		s.notify(ctx, dns.Fqdn(tld), uint32(latest))
+	}
+	return nil
+}
+
+// notify sends a NOTIFY for zone to every secondary, retrying unanswered ones.
+func (s *Server) notify(ctx context.Context, zone string, serial uint32) {
+	cfg := s.cfg.Transfer
+	client := &dns.Client{Net: "udp", Timeout: 2 * time.Second}
+	var key *TSIGKey
+	for i := range cfg.TSIGKeys {
+		if strings.EqualFold(cfg.TSIGKeys[i].Name, dns.Fqdn(cfg.NotifyKey)) {
+			key = &cfg.TSIGKeys[i]
+			client.TsigSecret = map[string]string{key.Name: key.Secret}
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, secondary := range cfg.Secondaries {
+		wg.Add(1)
+		go func(secondary string) {
+			defer wg.Done()
+			for attempt := 1; attempt <= notifyAttempts; attempt++ {
+				msg := new(dns.Msg)
+				msg.SetNotify(zone)
+				msg.Authoritative = true
+				msg.Answer = []dns.RR{zoneSOA(zone, s.cfg, serial)}
+				if key != nil {
+					msg.SetTsig(key.Name, key.Algorithm, 300, time.Now().Unix())
+				}
+				resp, _, err := client.ExchangeContext(ctx, msg, secondary)
+				if err == nil && resp.Rcode == dns.RcodeSuccess {
+					s.logger.Info("Sent NOTIFY", "zone", zone, "serial", serial, "secondary", secondary)
+					return
+				}
+				if err == nil {
+					err = errorFromRcode(resp.Rcode)
+				}
+				s.logger.Debug("NOTIFY not acknowledged", "zone", zone, "secondary", secondary, "attempt", attempt, "error", err)
+				if ctx.Err() != nil {
+					return
+				}
+			}
+			s.logger.Error("Secondary did not acknowledge NOTIFY", "zone", zone, "serial", serial, "secondary", secondary)
+		}(secondary)
+	}
+	wg.Wait()
+}
+
+type rcodeError int
+
+func (e rcodeError) Error() string { return "response " + dns.RcodeToString[int(e)] }
+
+func errorFromRcode(rcode int) error { return rcodeError(rcode) }
@@ -0,0 +1,124 @@
+package dnsserver
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// maxTXTStringLength is the longest character-string a TXT record can hold on the wire.
+const maxTXTStringLength = 255
+
+// ResourceRecordToRR converts an on-chain record published for owner into its wire form.
+func ResourceRecordToRR(owner string, r types.ResourceRecord) (dns.RR, error) {
+	hdr := dns.RR_Header{Name: dns.Fqdn(owner), Class: dns.ClassINET, Ttl: r.EffectiveTTL()}
+	switch r.Type {
+	case types.RecordType_RECORD_TYPE_A:
+		hdr.Rrtype = dns.TypeA
+		return &dns.A{Hdr: hdr, A: net.ParseIP(r.Value).To4()}, nil
+	case types.RecordType_RECORD_TYPE_AAAA:
+		hdr.Rrtype = dns.TypeAAAA
+		return &dns.AAAA{Hdr: hdr, AAAA: net.ParseIP(r.Value)}, nil
+	case types.RecordType_RECORD_TYPE_TXT:
+		hdr.Rrtype = dns.TypeTXT
+		return &dns.TXT{Hdr: hdr, Txt: splitTXT(r.Value)}, nil
+	case types.RecordType_RECORD_TYPE_MX:
+		hdr.Rrtype = dns.TypeMX
+		return &dns.MX{Hdr: hdr, Preference: uint16(r.Priority), Mx: dns.Fqdn(r.Value)}, nil
+	case types.RecordType_RECORD_TYPE_CNAME:
+		hdr.Rrtype = dns.TypeCNAME
+		return &dns.CNAME{Hdr: hdr, Target: dns.Fqdn(r.Value)}, nil
+	case types.RecordType_RECORD_TYPE_SRV:
+		hdr.Rrtype = dns.TypeSRV
+		return &dns.SRV{Hdr: hdr, Priority: uint16(r.Priority), Weight: uint16(r.Weight), Port: uint16(r.Port), Target: dns.Fqdn(r.Value)}, nil
+	case types.RecordType_RECORD_TYPE_CAA:
+		hdr.Rrtype = dns.TypeCAA
+		return &dns.CAA{Hdr: hdr, Flag: uint8(r.Flags), Tag: strings.ToLower(r.Tag), Value: r.Value}, nil
+	default:
+		return nil, fmt.Errorf("unsupported record type %s", r.Type)
+	}
+}
+
+// RecordTypeOf maps a DNS query type to the on-chain record type, or RECORD_TYPE_UNSPECIFIED for
+// types that cannot be published on chain.
+func RecordTypeOf(qtype uint16) types.RecordType {
+	switch qtype {
+	case dns.TypeA:
+		return types.RecordType_RECORD_TYPE_A
+	case dns.TypeAAAA:
+		return types.RecordType_RECORD_TYPE_AAAA
+	case dns.TypeTXT:
+		return types.RecordType_RECORD_TYPE_TXT
+	case dns.TypeMX:
+		return types.RecordType_RECORD_TYPE_MX
+	case dns.TypeCNAME:
+		return types.RecordType_RECORD_TYPE_CNAME
+	case dns.TypeSRV:
+		return types.RecordType_RECORD_TYPE_SRV
+	case dns.TypeCAA:
+		return types.RecordType_RECORD_TYPE_CAA
+	default:
+		return types.RecordType_RECORD_TYPE_UNSPECIFIED
+	}
+}
+
+// DelegationRRs returns the NS records of a delegated domain and the glue address records of its
+// name servers.
+func DelegationRRs(domain types.Domain) (ns []dns.RR, glue []dns.RR) {
+	zone := dns.Fqdn(domain.Name)
+	ttl := types.DefaultRecordTTL
+	for _, rec := range domain.NsRecords {
+		if rec == nil {
+			continue
+		}
+		host := dns.Fqdn(strings.ToLower(rec.Name))
+		ns = append(ns, &dns.NS{Hdr: dns.RR_Header{Name: zone, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: ttl}, Ns: host})
+		for _, addr := range rec.Ipv4Addresses {
+			if ip := net.ParseIP(addr).To4(); ip != nil {
+				glue = append(glue, &dns.A{Hdr: dns.RR_Header{Name: host, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: ttl}, A: ip})
+			}
+		}
+		for _, addr := range rec.Ipv6Addresses {
+			if ip := net.ParseIP(addr); ip != nil {
+				glue = append(glue, &dns.AAAA{Hdr: dns.RR_Header{Name: host, Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: ttl}, AAAA: ip})
+			}
+		}
+	}
+	return ns, glue
+}
+
+// DSRRs returns the DS records of a delegated domain.
+func DSRRs(domain types.Domain) []dns.RR {
+	zone := dns.Fqdn(domain.Name)
+	var out []dns.RR
+	for _, ds := range domain.DsRecords {
+		if ds == nil {
+			continue
+		}
+		out = append(out, &dns.DS{
+			Hdr:        dns.RR_Header{Name: zone, Rrtype: dns.TypeDS, Class: dns.ClassINET, Ttl: types.DefaultRecordTTL},
+			KeyTag:     uint16(ds.KeyTag),
+			Algorithm:  uint8(ds.Algorithm),
+			DigestType: uint8(ds.DigestType),
+			Digest:     strings.ToUpper(ds.Digest),
+		})
+	}
+	return out
+}
+
+// splitTXT splits a TXT value into character-strings of at most 255 bytes.
+func splitTXT(value string) []string {
+	if value == "" {
+		return []string{""}
+	}
+	var parts []string
+	for len(value) > maxTXTStringLength {
+		parts = append(parts, value[:maxTXTStringLength])
+		value = value[maxTXTStringLength:]
+	}
+	return append(parts, value)
+}
@@ -0,0 +1,391 @@
+// Package dnsserver answers DNS wire-protocol queries for the permitted TLDs from the on-chain
+// domain registry, as the authoritative server of those TLDs.
+package dnsserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"cosmossdk.io/log"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/miekg/dns"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const (
+	// DefaultNegativeTTL is how long NXDOMAIN and NODATA answers may be cached (5 minutes).
+	DefaultNegativeTTL uint32 = 300
+	// DefaultQueryTimeout bounds each registry lookup.
+	DefaultQueryTimeout = 5 * time.Second
+	// EDNSUDPSize is the UDP payload size advertised to EDNS clients, per the DNS flag day 2020
+	// recommendation.
+	EDNSUDPSize = 1232
+)
+
+// Registry is the view of the on-chain registry the server answers from. The module's
+// types.QueryClient satisfies it, so the server can run against any node's gRPC endpoint. The
+// server reads the block height each answer was read at from the gRPC block height header.
+type Registry interface {
+	GetDomainByName(ctx context.Context, in *types.QueryGetDomainByNameRequest, opts ...grpc.CallOption) (*types.QueryGetDomainByNameResponse, error)
+	ListPermittedTLDs(ctx context.Context, in *types.QueryListPermittedTLDsRequest, opts ...grpc.CallOption) (*types.QueryListPermittedTLDsResponse, error)
+}
+
+// Config holds the zone metadata the server publishes for every TLD it is authoritative for.
+type Config struct {
+	// Nameserver is the host name of this server, used as the SOA primary and the TLD's NS record.
+	Nameserver string
+	// Hostmaster is the SOA responsible mailbox in domain form; empty uses "hostmaster.<tld>.".
+	Hostmaster string
+	// NegativeTTL is the SOA minimum TTL and the TTL of the SOA returned with negative answers.
+	NegativeTTL uint32
+	// QueryTimeout bounds each registry lookup.
+	QueryTimeout time.Duration
+	// Transfer enables zone transfers and NOTIFY; nil refuses transfers.
+	Transfer *TransferConfig
+}
+
+// DefaultConfig returns the configuration used by `dnsblockchaind dns serve` unless overridden.
+func DefaultConfig() Config {
+	return Config{
+		Nameserver:   "ns1.dnsblockchain.",
+		NegativeTTL:  DefaultNegativeTTL,
+		QueryTimeout: DefaultQueryTimeout,
+	}
+}
+
+// Server is an authoritative DNS server for the permitted TLDs. Delegated domains are answered
+// with a referral to their NS records, with glue and DS records; domains without a delegation are
+// answered from their on-chain resource records. Unknown and expired names get NXDOMAIN, and names
+// under ICANN-reserved or unpermitted TLDs are refused.
+type Server struct {
+	registry Registry
+	cfg      Config
+	logger   log.Logger
+	// height is the latest block height seen in registry answers or by WatchChanges, used as the
+	// SOA serial of every zone.
+	height atomic.Int64
+	// notified is the height up to which WatchChanges has notified zone changes.
+	notified int64
+	// tlds caches the permitted TLDs, refreshed once the chain moves past the height they were
+	// read at.
+	tlds atomic.Pointer[permittedTLDs]
+}
+
+// permittedTLDs is the set of permitted TLDs at a block height.
+type permittedTLDs struct {
+	height int64
+	set    map[string]bool
+}
+
+// NewServer returns a server answering from registry.
+func NewServer(registry Registry, cfg Config, logger log.Logger) *Server {
+	return &Server{registry: registry, cfg: cfg.withDefaults(), logger: logger}
+}
+
+// Sync learns the chain's latest block height, which becomes the SOA serial of every zone, and its
+// permitted TLDs. It is called before serving so that no answer carries a serial that is not a
+// block height.
+func (s *Server) Sync(ctx context.Context) error {
+	tlds, err := s.refreshTLDs(ctx)
+	if err != nil {
+		return err
+	}
+	if tlds.height == 0 {
+		return errors.New("the registry did not report the block height of its answer")
+	}
+	return nil
+}
+
+// refreshTLDs reads the permitted TLDs and the block height they were read at.
+func (s *Server) refreshTLDs(ctx context.Context) (*permittedTLDs, error) {
+	var md metadata.MD
+	res, err := s.registry.ListPermittedTLDs(ctx, &types.QueryListPermittedTLDsRequest{}, grpc.Header(&md))
+	if err != nil {
+		return nil, err
+	}
+	tlds := &permittedTLDs{set: make(map[string]bool, len(res.Tlds))}
+	for _, tld := range res.Tlds {
+		tlds.set[strings.ToLower(tld)] = true
+	}
+	if height, ok := heightOf(md); ok {
+		tlds.height = height
+		s.observeHeight(height)
+	}
+	s.tlds.Store(tlds)
+	return tlds, nil
+}
+
+// observeHeight records a block height the chain has reached.
+func (s *Server) observeHeight(height int64) {
+	for {
+		current := s.height.Load()
+		if height <= current || s.height.CompareAndSwap(current, height) {
+			return
+		}
+	}
+}
+
+// heightOf returns the block height in the gRPC header of a registry answer.
+func heightOf(md metadata.MD) (int64, bool) {
+	values := md.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(values) == 0 {
+		return 0, false
+	}
+	height, err := strconv.ParseInt(values[0], 10, 64)
+	return height, err == nil && height > 0
+}
+
+// withDefaults fills in unset fields from DefaultConfig and normalizes the name server.
+func (cfg Config) withDefaults() Config {
+	defaults := DefaultConfig()
+	if cfg.Nameserver == "" {
+		cfg.Nameserver = defaults.Nameserver
+	}
+	cfg.Nameserver = dns.Fqdn(strings.ToLower(cfg.Nameserver))
+	if cfg.NegativeTTL == 0 {
+		cfg.NegativeTTL = defaults.NegativeTTL
+	}
+	if cfg.QueryTimeout == 0 {
+		cfg.QueryTimeout = defaults.QueryTimeout
+	}
+	return cfg
+}
+
+// ListenAndServe answers queries over UDP and TCP on addr until ctx is cancelled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	return ListenAndServe(ctx, s, addr, s.ServeOptions()...)
+}
+
+// Serve answers queries on the given UDP and TCP listeners until ctx is cancelled or either
+// listener fails. Both listeners are closed on return.
+func (s *Server) Serve(ctx context.Context, pc net.PacketConn, l net.Listener) error {
+	return Serve(ctx, s, pc, l, s.ServeOptions()...)
+}
+
+// ServeOptions returns the options the server's listeners need, such as the TSIG keys of zone
+// transfers.
+func (s *Server) ServeOptions() []ServeOption {
+	if s.cfg.Transfer == nil || len(s.cfg.Transfer.TSIGKeys) == 0 {
+		return nil
+	}
+	return []ServeOption{WithTSIGKeys(s.cfg.Transfer.TSIGKeys)}
+}
+
+// ServeDNS implements dns.Handler.
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	NewHandler(s, s.logger).ServeDNS(w, req)
+}
+
+// Answer implements Answerer.
+func (s *Server) Answer(ctx context.Context, req *dns.Msg) *dns.Msg {
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	resp.RecursionAvailable = false
+
+	switch {
+	case req.Opcode != dns.OpcodeQuery:
+		resp.Rcode = dns.RcodeNotImplemented
+	case len(req.Question) != 1:
+		resp.Rcode = dns.RcodeFormatError
+	case req.Question[0].Qclass != dns.ClassINET && req.Question[0].Qclass != dns.ClassANY:
+		resp.Rcode = dns.RcodeRefused
+	default:
+		ctx, cancel := context.WithTimeout(ctx, s.cfg.QueryTimeout)
+		defer cancel()
+		if err := s.answer(ctx, req.Question[0], resp); err != nil {
+			s.logger.Error("Failed to answer DNS query", "name", req.Question[0].Name, "error", err)
+			resp.Authoritative = false
+			resp.Answer, resp.Ns, resp.Extra = nil, nil, nil
+			resp.Rcode = dns.RcodeServerFailure
+		}
+	}
+
+	if opt := req.IsEdns0(); opt != nil {
+		resp.SetEdns0(EDNSUDPSize, opt.Do())
+	}
+	return resp
+}
+
+// answer fills in resp for a single question.
+func (s *Server) answer(ctx context.Context, q dns.Question, resp *dns.Msg) error {
+	name := strings.ToLower(dns.Fqdn(q.Name))
+	labels := dns.SplitDomainName(name)
+	if len(labels) == 0 {
+		resp.Rcode = dns.RcodeRefused
+		return nil
+	}
+	tld := labels[len(labels)-1]
+	if types.IsReservedTLD(tld) {
+		resp.Rcode = dns.RcodeRefused
+		return nil
+	}
+	permitted, err := s.isPermittedTLD(ctx, tld)
+	if err != nil {
+		return err
+	}
+	if !permitted {
+		resp.Rcode = dns.RcodeRefused
+		return nil
+	}
+
+	zone := tld + "."
+	resp.Authoritative = true
+	if len(labels) == 1 {
+		s.answerZoneApex(zone, q.Qtype, resp)
+		return nil
+	}
+
+	var md metadata.MD
+	res, err := s.registry.GetDomainByName(ctx, &types.QueryGetDomainByNameRequest{Name: name}, grpc.Header(&md))
+	if err != nil {
+		return err
+	}
+	if height, ok := heightOf(md); ok {
+		s.observeHeight(height)
+	}
+	if !res.Found || res.Expired {
+		s.negative(zone, dns.RcodeNameError, resp)
+		return nil
+	}
+	domain := res.Domain
+
+	if len(domain.NsRecords) > 0 {
+		// The DS set lives on the parent side of the delegation, so it is answered here.
+		if q.Qtype == dns.TypeDS && res.Exact {
+			if ds := DSRRs(domain); len(ds) > 0 {
+				resp.Answer = ds
+			} else {
+				s.negative(zone, dns.RcodeSuccess, resp)
+			}
+			return nil
+		}
+		ns, glue := DelegationRRs(domain)
+		resp.Authoritative = false
+		resp.Ns = append(ns, DSRRs(domain)...)
+		resp.Extra = glue
+		return nil
+	}
+
+	s.answerRecords(zone, domain, name, q.Qtype, resp)
+	return nil
+}
+
+// answerZoneApex answers a query for the TLD itself, which only has SOA and NS records.
+func (s *Server) answerZoneApex(zone string, qtype uint16, resp *dns.Msg) {
+	switch qtype {
+	case dns.TypeSOA:
+		resp.Answer = []dns.RR{s.soa(zone)}
+	case dns.TypeNS:
+		resp.Answer = []dns.RR{apexNS(zone, s.cfg)}
+	default:
+		s.negative(zone, dns.RcodeSuccess, resp)
+	}
+}
+
+// answerRecords answers from the on-chain records of a domain without an NS delegation. A CNAME
+// answers every type at its name, and a "*" record covers names with no records of their own.
+func (s *Server) answerRecords(zone string, domain types.Domain, name string, qtype uint16, resp *dns.Msg) {
+	records := domain.RecordsAt(name, types.RecordType_RECORD_TYPE_UNSPECIFIED)
+	if len(records) == 0 && name != dns.Fqdn(domain.Name) && !hasDescendants(domain, name) {
+		_, parent, _ := strings.Cut(name, ".")
+		records = domain.RecordsAt("*."+parent, types.RecordType_RECORD_TYPE_UNSPECIFIED)
+		if len(records) == 0 {
+			s.negative(zone, dns.RcodeNameError, resp)
+			return
+		}
+	}
+
+	want := RecordTypeOf(qtype)
+	for _, r := range records {
+		if r.Type == types.RecordType_RECORD_TYPE_CNAME && qtype != dns.TypeCNAME {
+			want = types.RecordType_RECORD_TYPE_CNAME
+			break
+		}
+	}
+
+	for _, r := range records {
+		if qtype != dns.TypeANY && r.Type != want {
+			continue
+		}
+		rr, err := ResourceRecordToRR(name, r)
+		if err != nil {
+			s.logger.Error("Skipping invalid on-chain record", "domain", domain.Name, "error", err)
+			continue
+		}
+		resp.Answer = append(resp.Answer, rr)
+	}
+	if len(resp.Answer) == 0 {
+		s.negative(zone, dns.RcodeSuccess, resp)
+	}
+}
+
+// negative sets rcode and adds the zone's SOA to the authority section, so resolvers can cache
+// the NXDOMAIN or NODATA answer.
+func (s *Server) negative(zone string, rcode int, resp *dns.Msg) {
+	resp.Rcode = rcode
+	resp.Ns = []dns.RR{s.soa(zone)}
+}
+
+// soa returns the SOA record of a zone, whose serial is the latest block height seen.
+func (s *Server) soa(zone string) dns.RR {
+	return zoneSOA(zone, s.cfg, uint32(s.height.Load()))
+}
+
+// zoneSOA returns the SOA record of a TLD zone.
+func zoneSOA(zone string, cfg Config, serial uint32) dns.RR {
+	mbox := cfg.Hostmaster
+	if mbox == "" {
+		mbox = "hostmaster." + zone
+	}
+	return &dns.SOA{
+		Hdr:     dns.RR_Header{Name: zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: cfg.NegativeTTL},
+		Ns:      cfg.Nameserver,
+		Mbox:    dns.Fqdn(mbox),
+		Serial:  serial,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  cfg.NegativeTTL,
+	}
+}
+
+// apexNS returns the NS record naming this server as authoritative for a TLD zone.
+func apexNS(zone string, cfg Config) dns.RR {
+	return &dns.NS{
+		Hdr: dns.RR_Header{Name: zone, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: types.DefaultRecordTTL},
+		Ns:  cfg.Nameserver,
+	}
+}
+
+// isPermittedTLD looks tld up in the cached permitted TLDs, reading them again first when a newer
+// block has been seen since they were read.
+func (s *Server) isPermittedTLD(ctx context.Context, tld string) (bool, error) {
+	tlds := s.tlds.Load()
+	if tlds == nil || tlds.height < s.height.Load() {
+		var err error
+		if tlds, err = s.refreshTLDs(ctx); err != nil {
+			return false, err
+		}
+	}
+	return tlds.set[strings.ToLower(tld)], nil
+}
+
+// hasDescendants reports whether any record of domain lives below name, which makes name an
+// empty non-terminal that exists without records of its own.
+func hasDescendants(domain types.Domain, name string) bool {
+	suffix := "." + strings.TrimSuffix(name, ".")
+	for _, r := range domain.Records {
+		if r != nil && strings.HasSuffix(types.RecordFQDN(domain.Name, r.Name), suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,274 @@
+package dnsserver_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"dnsblockchain/x/dnsblockchain/dnsserver"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// fakeRegistry resolves names to the longest registered suffix, like the module's query server.
+// Its answers carry the block height header when height is set.
+type fakeRegistry struct {
+	tlds    []string
+	domains map[string]types.Domain
+	height  int64
+}
+
+func (r fakeRegistry) setHeight(opts []grpc.CallOption) {
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok && r.height > 0 {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(r.height, 10))
+		}
+	}
+}
+
+func (r fakeRegistry) GetDomainByName(_ context.Context, in *types.QueryGetDomainByNameRequest, opts ...grpc.CallOption) (*types.QueryGetDomainByNameResponse, error) {
+	r.setHeight(opts)
+	candidate := strings.ToLower(strings.Trim(in.Name, "."))
+	for exact := true; strings.Contains(candidate, "."); exact = false {
+		if domain, ok := r.domains[candidate]; ok {
+			expired := domain.Status != types.DomainStatus_DOMAIN_STATUS_ACTIVE
+			return &types.QueryGetDomainByNameResponse{Domain: domain, Found: true, Expired: expired, Exact: exact}, nil
+		}
+		_, candidate, _ = strings.Cut(candidate, ".")
+	}
+	return &types.QueryGetDomainByNameResponse{}, nil
+}
+
+func (r fakeRegistry) ListPermittedTLDs(_ context.Context, _ *types.QueryListPermittedTLDsRequest, opts ...grpc.CallOption) (*types.QueryListPermittedTLDsResponse, error) {
+	r.setHeight(opts)
+	return &types.QueryListPermittedTLDsResponse{Tlds: r.tlds}, nil
+}
+
+func testRegistry() fakeRegistry {
+	return fakeRegistry{
+		tlds: []string{"web3"},
+		domains: map[string]types.Domain{
+			"delegated.web3": {
+				Name: "delegated.web3",
+				NsRecords: []*types.NSRecordWithIP{
+					{Name: "ns1.delegated.web3", Ipv4Addresses: []string{"192.0.2.53"}, Ipv6Addresses: []string{"2001:db8::53"}},
+					{Name: "ns.provider.org"},
+				},
+				DsRecords: []*types.DSRecord{{KeyTag: 2371, Algorithm: 13, DigestType: 2, Digest: strings.Repeat("AB", 32)}},
+			},
+			"onchain.web3": {
+				Name: "onchain.web3",
+				Records: []*types.ResourceRecord{
+					{Type: types.RecordType_RECORD_TYPE_A, Value: "192.0.2.10", Ttl: 300},
+					{Type: types.RecordType_RECORD_TYPE_MX, Value: "mail.onchain.web3", Priority: 10},
+					{Name: "www", Type: types.RecordType_RECORD_TYPE_CNAME, Value: "onchain.web3"},
+					{Name: "*", Type: types.RecordType_RECORD_TYPE_TXT, Value: strings.Repeat("x", 300)},
+					{Name: "a.b", Type: types.RecordType_RECORD_TYPE_A, Value: "192.0.2.11"},
+				},
+			},
+			"expired.web3": {
+				Name:      "expired.web3",
+				Status:    types.DomainStatus_DOMAIN_STATUS_GRACE,
+				NsRecords: []*types.NSRecordWithIP{{Name: "ns1.expired.web3", Ipv4Addresses: []string{"192.0.2.1"}}},
+			},
+		},
+	}
+}
+
+// startServer serves the test registry on localhost and returns its address.
+func startServer(t *testing.T) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	require.NoError(t, err)
+
+	srv := dnsserver.NewServer(testRegistry(), dnsserver.DefaultConfig(), log.NewNopLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(ctx, pc, l) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+	return pc.LocalAddr().String()
+}
+
+func exchange(t *testing.T, addr, network, name string, qtype uint16) *dns.Msg {
+	t.Helper()
+	req := new(dns.Msg)
+	req.SetQuestion(dns.Fqdn(name), qtype)
+	client := &dns.Client{Net: network}
+	resp, _, err := client.Exchange(req, addr)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestServerReferralWithGlue(t *testing.T) {
+	addr := startServer(t)
+
+	for _, network := range []string{"udp", "tcp"} {
+		resp := exchange(t, addr, network, "www.Delegated.web3", dns.TypeA)
+		require.Equal(t, dns.RcodeSuccess, resp.Rcode, network)
+		require.False(t, resp.Authoritative)
+		require.Empty(t, resp.Answer)
+
+		var ns []string
+		var ds int
+		for _, rr := range resp.Ns {
+			switch rr := rr.(type) {
+			case *dns.NS:
+				require.Equal(t, "delegated.web3.", rr.Hdr.Name)
+				ns = append(ns, rr.Ns)
+			case *dns.DS:
+				ds++
+			}
+		}
+		require.ElementsMatch(t, []string{"ns1.delegated.web3.", "ns.provider.org."}, ns)
+		require.Equal(t, 1, ds)
+
+		require.Len(t, resp.Extra, 2)
+		require.Equal(t, "192.0.2.53", resp.Extra[0].(*dns.A).A.String())
+		require.Equal(t, "2001:db8::53", resp.Extra[1].(*dns.AAAA).AAAA.String())
+	}
+
+	// The DS set is answered authoritatively from the parent side.
+	resp := exchange(t, addr, "udp", "delegated.web3", dns.TypeDS)
+	require.True(t, resp.Authoritative)
+	require.Len(t, resp.Answer, 1)
+	require.Equal(t, uint16(2371), resp.Answer[0].(*dns.DS).KeyTag)
+}
+
+func TestServerNegativeAnswers(t *testing.T) {
+	addr := startServer(t)
+
+	for _, name := range []string{"unknown.web3", "expired.web3", "deep.expired.web3"} {
+		resp := exchange(t, addr, "udp", name, dns.TypeA)
+		require.Equal(t, dns.RcodeNameError, resp.Rcode, name)
+		require.True(t, resp.Authoritative, name)
+		require.Len(t, resp.Ns, 1, name)
+		require.IsType(t, &dns.SOA{}, resp.Ns[0], name)
+	}
+
+	for _, name := range []string{"example.com", "google.com", "unknown.nope", "."} {
+		resp := exchange(t, addr, "udp", name, dns.TypeA)
+		require.Equal(t, dns.RcodeRefused, resp.Rcode, name)
+	}
+
+	// The TLD apex carries the server's own SOA and NS.
+	resp := exchange(t, addr, "udp", "web3", dns.TypeSOA)
+	require.Equal(t, dns.RcodeSuccess, resp.Rcode)
+	require.Equal(t, "ns1.dnsblockchain.", resp.Answer[0].(*dns.SOA).Ns)
+}
+
+func TestServerAnswersOnChainRecords(t *testing.T) {
+	addr := startServer(t)
+
+	resp := exchange(t, addr, "udp", "onchain.web3", dns.TypeA)
+	require.True(t, resp.Authoritative)
+	require.Len(t, resp.Answer, 1)
+	require.Equal(t, "192.0.2.10", resp.Answer[0].(*dns.A).A.String())
+	require.Equal(t, uint32(300), resp.Answer[0].Header().Ttl)
+
+	resp = exchange(t, addr, "udp", "onchain.web3", dns.TypeMX)
+	require.Len(t, resp.Answer, 1)
+	require.Equal(t, "mail.onchain.web3.", resp.Answer[0].(*dns.MX).Mx)
+	require.Equal(t, types.DefaultRecordTTL, resp.Answer[0].Header().Ttl)
+
+	// A CNAME answers every type at its name.
+	resp = exchange(t, addr, "udp", "www.onchain.web3", dns.TypeAAAA)
+	require.Len(t, resp.Answer, 1)
+	require.Equal(t, "onchain.web3.", resp.Answer[0].(*dns.CNAME).Target)
+
+	// Wildcards cover names without records, and long TXT values are split into strings.
+	resp = exchange(t, addr, "tcp", "anything.onchain.web3", dns.TypeTXT)
+	require.Len(t, resp.Answer, 1)
+	txt := resp.Answer[0].(*dns.TXT)
+	require.Equal(t, "anything.onchain.web3.", txt.Hdr.Name)
+	require.Len(t, txt.Txt, 2)
+
+	// Existing names without the requested type, and empty non-terminals, are NODATA.
+	for _, name := range []string{"onchain.web3", "b.onchain.web3"} {
+		resp = exchange(t, addr, "udp", name, dns.TypeSRV)
+		require.Equal(t, dns.RcodeSuccess, resp.Rcode, name)
+		require.Empty(t, resp.Answer, name)
+		require.IsType(t, &dns.SOA{}, resp.Ns[0], name)
+	}
+}
+
+func TestSOASerialIsBlockHeight(t *testing.T) {
+	ctx := context.Background()
+	soaSerial := func(srv *dnsserver.Server, name string, qtype uint16) uint32 {
+		req := new(dns.Msg)
+		req.SetQuestion(name, qtype)
+		resp := srv.Answer(ctx, req)
+		if len(resp.Answer) > 0 {
+			return resp.Answer[0].(*dns.SOA).Serial
+		}
+		return resp.Ns[0].(*dns.SOA).Serial
+	}
+
+	// The height is read from the chain at startup.
+	registry := testRegistry()
+	registry.height = 7
+	srv := dnsserver.NewServer(registry, dnsserver.DefaultConfig(), log.NewNopLogger())
+	require.NoError(t, srv.Sync(ctx))
+	require.Equal(t, uint32(7), soaSerial(srv, "web3.", dns.TypeSOA))
+
+	// Later answers move the serial to the height they were read at.
+	registry.height = 9
+	srv = dnsserver.NewServer(registry, dnsserver.DefaultConfig(), log.NewNopLogger())
+	require.Equal(t, uint32(9), soaSerial(srv, "unknown.web3.", dns.TypeA))
+
+	// A registry that does not report heights cannot be served from.
+	srv = dnsserver.NewServer(testRegistry(), dnsserver.DefaultConfig(), log.NewNopLogger())
+	require.Error(t, srv.Sync(ctx))
+}
+
+// countingRegistry counts the permitted TLD lookups of the server.
+type countingRegistry struct {
+	fakeRegistry
+	tldLookups int
+}
+
+func (r *countingRegistry) ListPermittedTLDs(ctx context.Context, in *types.QueryListPermittedTLDsRequest, opts ...grpc.CallOption) (*types.QueryListPermittedTLDsResponse, error) {
+	r.tldLookups++
+	return r.fakeRegistry.ListPermittedTLDs(ctx, in, opts...)
+}
+
+func TestPermittedTLDsCachedPerBlock(t *testing.T) {
+	ctx := context.Background()
+	registry := &countingRegistry{fakeRegistry: testRegistry()}
+	registry.height = 5
+	srv := dnsserver.NewServer(registry, dnsserver.DefaultConfig(), log.NewNopLogger())
+	require.NoError(t, srv.Sync(ctx))
+
+	rcode := func(name string) int {
+		req := new(dns.Msg)
+		req.SetQuestion(name, dns.TypeA)
+		return srv.Answer(ctx, req).Rcode
+	}
+
+	// Queries at the same height are answered from the TLDs read at startup.
+	for range 3 {
+		require.Equal(t, dns.RcodeSuccess, rcode("onchain.web3."))
+	}
+	require.Equal(t, dns.RcodeRefused, rcode("rocket.moon."))
+	require.Equal(t, 1, registry.tldLookups)
+
+	// Once a newer block is seen, the TLDs are read again.
+	registry.tlds = []string{"web3", "moon"}
+	registry.height = 6
+	require.Equal(t, dns.RcodeSuccess, rcode("onchain.web3."))
+	require.Equal(t, dns.RcodeNameError, rcode("rocket.moon."))
+	require.Equal(t, dns.RcodeNameError, rcode("orbit.moon."))
+	require.Equal(t, 2, registry.tldLookups)
+}
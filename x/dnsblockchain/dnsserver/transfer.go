@@ -0,0 +1,307 @@
+package dnsserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// transferChunkSize is the number of records sent per message of a zone transfer, well below the
+// 64 KiB a TCP message can hold.
+const transferChunkSize = 100
+
+// ZoneExporter renders the zone of a TLD a page at a time, at the height given by the gRPC block
+// height header or the latest height without one. The module's types.QueryClient satisfies it.
+type ZoneExporter interface {
+	ExportZone(ctx context.Context, in *types.QueryExportZoneRequest, opts ...grpc.CallOption) (*types.QueryExportZoneResponse, error)
+}
+
+// TransferConfig enables AXFR and IXFR (RFC 5936, RFC 1995) and NOTIFY (RFC 1996) on a Server.
+// Every zone's SOA serial is the block height it was exported at, so IXFR is the difference
+// between the zone at the secondary's height and at the latest height.
+type TransferConfig struct {
+	// Zones exports the zones transferred.
+	Zones ZoneExporter
+	// AllowedNetworks may transfer zones without TSIG.
+	AllowedNetworks []netip.Prefix
+	// TSIGKeys may sign transfer requests; a request signed with one of them is allowed from any
+	// address. The servers must be started with WithTSIGKeys for the signatures to be checked.
+	TSIGKeys []TSIGKey
+	// Changes reports the blocks that change each zone; nil disables NOTIFY. SOA serials are the
+	// block height learnt by Sync and WatchChanges either way.
+	Changes ChangeFeed
+	// Secondaries are the "host:port" addresses notified when a block changes a zone.
+	Secondaries []string
+	// NotifyKey names the key in TSIGKeys that signs NOTIFY messages; empty sends them unsigned.
+	NotifyKey string
+}
+
+// TSIGKey is a shared secret authenticating zone transfers and NOTIFY messages.
+type TSIGKey struct {
+	// Name is the key name in fully qualified form, e.g. "xfr-key.".
+	Name string
+	// Algorithm is the HMAC algorithm in fully qualified form, e.g. "hmac-sha256.".
+	Algorithm string
+	// Secret is the base64-encoded secret.
+	Secret string
+}
+
+// ParseTSIGKey parses a key given as "[algorithm:]name:secret", as dig -y does. The algorithm
+// defaults to hmac-sha256.
+func ParseTSIGKey(s string) (TSIGKey, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) == 2 {
+		parts = append([]string{"hmac-sha256"}, parts...)
+	}
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return TSIGKey{}, fmt.Errorf("invalid TSIG key %q: must be [algorithm:]name:secret", s)
+	}
+	algorithm := dns.Fqdn(strings.ToLower(parts[0]))
+	switch algorithm {
+	case dns.HmacSHA1, dns.HmacSHA224, dns.HmacSHA256, dns.HmacSHA384, dns.HmacSHA512:
+	default:
+		return TSIGKey{}, fmt.Errorf("unsupported TSIG algorithm %q", parts[0])
+	}
+	return TSIGKey{Name: dns.Fqdn(strings.ToLower(parts[1])), Algorithm: algorithm, Secret: parts[2]}, nil
+}
+
+// ParseNetworks parses IP addresses and CIDR prefixes for TransferConfig.AllowedNetworks.
+func ParseNetworks(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, v := range values {
+		if prefix, err := netip.ParsePrefix(v); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address or network %q", v)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes, nil
+}
+
+// ServeTransfer implements Transferer.
+func (s *Server) ServeTransfer(w dns.ResponseWriter, req *dns.Msg) {
+	q := req.Question[0]
+	fail := func(rcode int) {
+		resp := new(dns.Msg)
+		resp.SetRcode(req, rcode)
+		if err := w.WriteMsg(resp); err != nil {
+			s.logger.Debug("Failed to write DNS response", "remote", w.RemoteAddr().String(), "error", err)
+		}
+	}
+
+	cfg := s.cfg.Transfer
+	if cfg == nil || cfg.Zones == nil {
+		fail(dns.RcodeRefused)
+		return
+	}
+	if req.IsTsig() != nil && w.TsigStatus() != nil {
+		s.logger.Info("Refusing zone transfer with a bad TSIG signature", "zone", q.Name, "remote", w.RemoteAddr().String(), "error", w.TsigStatus())
+		fail(dns.RcodeNotAuth)
+		return
+	}
+	if !s.transferAllowed(w, req) {
+		s.logger.Info("Refusing zone transfer", "zone", q.Name, "remote", w.RemoteAddr().String())
+		fail(dns.RcodeRefused)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.QueryTimeout)
+	defer cancel()
+	zone := strings.ToLower(dns.Fqdn(q.Name))
+	tld := strings.TrimSuffix(zone, ".")
+	if strings.Contains(tld, ".") || types.IsReservedTLD(tld) {
+		fail(dns.RcodeNotAuth)
+		return
+	}
+	permitted, err := s.isPermittedTLD(ctx, tld)
+	if err != nil || !permitted {
+		fail(dns.RcodeNotAuth)
+		return
+	}
+
+	current, err := s.exportZone(ctx, tld, 0)
+	if err != nil {
+		s.logger.Error("Failed to export zone", "zone", zone, "error", err)
+		fail(dns.RcodeServerFailure)
+		return
+	}
+
+	rrs := axfrRRs(current)
+	if q.Qtype == dns.TypeIXFR {
+		if _, tcp := w.RemoteAddr().(*net.TCPAddr); !tcp {
+			// An IXFR over UDP only learns whether it is current; the difference is fetched over TCP.
+			resp := new(dns.Msg)
+			resp.SetReply(req)
+			resp.Authoritative = true
+			resp.Answer = []dns.RR{current[0]}
+			_ = w.WriteMsg(resp)
+			return
+		}
+		rrs = s.ixfrRRs(ctx, tld, req, current)
+	}
+
+	ch := make(chan *dns.Envelope)
+	done := make(chan struct{})
+	go func() {
+		defer close(ch)
+		for start := 0; start < len(rrs); start += transferChunkSize {
+			select {
+			case ch <- &dns.Envelope{RR: rrs[start:min(start+transferChunkSize, len(rrs))]}:
+			case <-done:
+				return
+			}
+		}
+	}()
+	err = new(dns.Transfer).Out(w, req, ch)
+	close(done)
+	if err != nil {
+		s.logger.Debug("Failed to send zone transfer", "zone", zone, "remote", w.RemoteAddr().String(), "error", err)
+		return
+	}
+	s.logger.Info("Sent zone transfer", "zone", zone, "type", dns.TypeToString[q.Qtype], "serial", current[0].(*dns.SOA).Serial, "remote", w.RemoteAddr().String())
+}
+
+// transferAllowed reports whether a request is signed with a configured key or comes from an
+// allowed network.
+func (s *Server) transferAllowed(w dns.ResponseWriter, req *dns.Msg) bool {
+	cfg := s.cfg.Transfer
+	if tsig := req.IsTsig(); tsig != nil {
+		for _, key := range cfg.TSIGKeys {
+			if strings.EqualFold(key.Name, tsig.Hdr.Name) {
+				return true
+			}
+		}
+	}
+
+	var ip net.IP
+	switch addr := w.RemoteAddr().(type) {
+	case *net.TCPAddr:
+		ip = addr.IP
+	case *net.UDPAddr:
+		ip = addr.IP
+	}
+	remote, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	for _, prefix := range cfg.AllowedNetworks {
+		if prefix.Contains(remote.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+// exportZone returns the records of a zone at height, or at the latest height when it is 0, with
+// the SOA first. The pages after the first are read at the first page's serial.
+func (s *Server) exportZone(ctx context.Context, tld string, height int64) ([]dns.RR, error) {
+	req := &types.QueryExportZoneRequest{
+		Tld:        tld,
+		Nameserver: s.cfg.Nameserver,
+		Hostmaster: s.cfg.Hostmaster,
+	}
+	var rrs []dns.RR
+	for {
+		pageCtx := ctx
+		if height > 0 {
+			pageCtx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		}
+		res, err := s.cfg.Transfer.Zones.ExportZone(pageCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		parser := dns.NewZoneParser(strings.NewReader(res.Zone), "", "")
+		for rr, ok := parser.Next(); ok; rr, ok = parser.Next() {
+			rrs = append(rrs, rr)
+		}
+		if err := parser.Err(); err != nil {
+			return nil, err
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		height = int64(res.Serial)
+		req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+	if len(rrs) == 0 || rrs[0].Header().Rrtype != dns.TypeSOA {
+		return nil, fmt.Errorf("exported zone %s does not start with an SOA record", tld)
+	}
+	return rrs, nil
+}
+
+// axfrRRs returns a full transfer: the zone between two copies of its SOA.
+func axfrRRs(zone []dns.RR) []dns.RR {
+	return append(append([]dns.RR{}, zone...), zone[0])
+}
+
+// ixfrRRs returns an incremental transfer from the serial in the request's authority section to
+// the current zone. Secondaries already current get the SOA alone, and those whose height the
+// node can no longer query get a full transfer, as RFC 1995 allows.
+func (s *Server) ixfrRRs(ctx context.Context, tld string, req *dns.Msg, current []dns.RR) []dns.RR {
+	currentSOA := current[0].(*dns.SOA)
+	var clientSOA *dns.SOA
+	for _, rr := range req.Ns {
+		if soa, ok := rr.(*dns.SOA); ok {
+			clientSOA = soa
+		}
+	}
+	if clientSOA == nil {
+		return axfrRRs(current)
+	}
+	if clientSOA.Serial >= currentSOA.Serial {
+		return []dns.RR{currentSOA}
+	}
+
+	previous, err := s.exportZone(ctx, tld, int64(clientSOA.Serial))
+	if err != nil {
+		s.logger.Info("Falling back to AXFR", "zone", dns.Fqdn(tld), "serial", clientSOA.Serial, "error", err)
+		return axfrRRs(current)
+	}
+
+	deleted, added := diffRRs(previous[1:], current[1:])
+	rrs := []dns.RR{currentSOA, previous[0]}
+	rrs = append(rrs, deleted...)
+	rrs = append(rrs, currentSOA)
+	rrs = append(rrs, added...)
+	return append(rrs, currentSOA)
+}
+
+// diffRRs returns the records only in from, and those only in to.
+func diffRRs(from, to []dns.RR) (deleted, added []dns.RR) {
+	inFrom := make(map[string]bool, len(from))
+	for _, rr := range from {
+		inFrom[rr.String()] = true
+	}
+	inTo := make(map[string]bool, len(to))
+	for _, rr := range to {
+		inTo[rr.String()] = true
+	}
+	for _, rr := range from {
+		if !inTo[rr.String()] {
+			deleted = append(deleted, rr)
+		}
+	}
+	for _, rr := range to {
+		if !inFrom[rr.String()] {
+			added = append(added, rr)
+		}
+	}
+	return deleted, added
+}
@@ -0,0 +1,298 @@
+package dnsserver_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"dnsblockchain/x/dnsblockchain/dnsserver"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const testTSIGSecret = "c2VjcmV0LXNlY3JldC1zZWNyZXQ="
+
+// fakeExporter exports the zone as it was at the height in the gRPC header, like a node keeping
+// the state of every height, one domain per page.
+type fakeExporter struct {
+	heights map[int64][]types.Domain
+	latest  int64
+}
+
+func (e fakeExporter) ExportZone(ctx context.Context, in *types.QueryExportZoneRequest, _ ...grpc.CallOption) (*types.QueryExportZoneResponse, error) {
+	height := e.latest
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(grpctypes.GRPCBlockHeightHeader)) > 0 {
+		var err error
+		if height, err = strconv.ParseInt(md.Get(grpctypes.GRPCBlockHeightHeader)[0], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	domains, ok := e.heights[height]
+	if !ok {
+		return nil, context.DeadlineExceeded
+	}
+	page := 0
+	if in.Pagination != nil {
+		var err error
+		if page, err = strconv.Atoi(string(in.Pagination.Key)); err != nil {
+			return nil, err
+		}
+	}
+	res := &types.QueryExportZoneResponse{Serial: uint32(height), Pagination: &query.PageResponse{}}
+	var count int
+	if page == 0 {
+		cfg := dnsserver.Config{Nameserver: in.Nameserver, Hostmaster: in.Hostmaster, NegativeTTL: dnsserver.DefaultNegativeTTL}
+		res.Zone, count = dnsserver.ExportZone(in.Tld, uint32(height), cfg, domains[:min(1, len(domains))])
+	} else {
+		res.Zone, count = dnsserver.ExportZoneRecords(in.Tld, domains[page:page+1])
+	}
+	res.DomainCount = uint64(count)
+	if page+1 < len(domains) {
+		res.Pagination.NextKey = []byte(strconv.Itoa(page + 1))
+	}
+	return res, nil
+}
+
+func testExporter() fakeExporter {
+	delegated := types.Domain{
+		Name:      "delegated.web3",
+		NsRecords: []*types.NSRecordWithIP{{Name: "ns1.delegated.web3", Ipv4Addresses: []string{"192.0.2.53"}}},
+	}
+	moved := delegated
+	moved.NsRecords = []*types.NSRecordWithIP{{Name: "ns1.delegated.web3", Ipv4Addresses: []string{"192.0.2.54"}}}
+	return fakeExporter{
+		latest: 2,
+		heights: map[int64][]types.Domain{
+			1: {delegated},
+			2: {moved, {Name: "onchain.web3", Records: []*types.ResourceRecord{{Type: types.RecordType_RECORD_TYPE_A, Value: "192.0.2.10"}}}},
+		},
+	}
+}
+
+// startTransferServer serves the test registry with transfers enabled and returns its address.
+func startTransferServer(t *testing.T, transfer *dnsserver.TransferConfig) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	require.NoError(t, err)
+
+	cfg := dnsserver.DefaultConfig()
+	cfg.Transfer = transfer
+	srv := dnsserver.NewServer(testRegistry(), cfg, log.NewNopLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(ctx, pc, l) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+	return pc.LocalAddr().String()
+}
+
+// transferIn runs a zone transfer and returns its records, or the error it failed with.
+func transferIn(xfr *dns.Transfer, req *dns.Msg, addr string) ([]dns.RR, error) {
+	ch, err := xfr.In(req, addr)
+	if err != nil {
+		return nil, err
+	}
+	var rrs []dns.RR
+	for env := range ch {
+		if env.Error != nil {
+			return nil, env.Error
+		}
+		rrs = append(rrs, env.RR...)
+	}
+	return rrs, nil
+}
+
+func TestTransferAXFR(t *testing.T) {
+	addr := startTransferServer(t, &dnsserver.TransferConfig{
+		Zones:           testExporter(),
+		AllowedNetworks: []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")},
+	})
+
+	req := new(dns.Msg)
+	req.SetAxfr("web3.")
+	rrs, err := transferIn(new(dns.Transfer), req, addr)
+	require.NoError(t, err)
+
+	require.Equal(t, uint32(2), rrs[0].(*dns.SOA).Serial)
+	require.Equal(t, rrs[0].String(), rrs[len(rrs)-1].String())
+	var zone []string
+	for _, rr := range rrs[1 : len(rrs)-1] {
+		zone = append(zone, rr.String())
+	}
+	require.Contains(t, zone, "delegated.web3.\t3600\tIN\tNS\tns1.delegated.web3.")
+	require.Contains(t, zone, "ns1.delegated.web3.\t3600\tIN\tA\t192.0.2.54")
+	require.Contains(t, zone, "onchain.web3.\t3600\tIN\tA\t192.0.2.10")
+
+	// Names outside the permitted TLDs are not served.
+	req.SetAxfr("example.")
+	_, err = transferIn(new(dns.Transfer), req, addr)
+	require.Error(t, err)
+}
+
+func TestTransferIXFR(t *testing.T) {
+	addr := startTransferServer(t, &dnsserver.TransferConfig{
+		Zones:           testExporter(),
+		AllowedNetworks: []netip.Prefix{netip.MustParsePrefix("127.0.0.1/32")},
+	})
+
+	req := new(dns.Msg)
+	req.SetIxfr("web3.", 1, "ns1.dnsblockchain.", "hostmaster.web3.")
+	rrs, err := transferIn(new(dns.Transfer), req, addr)
+	require.NoError(t, err)
+
+	serials := []uint32{}
+	var deleted, added []string
+	for _, rr := range rrs {
+		if soa, ok := rr.(*dns.SOA); ok {
+			serials = append(serials, soa.Serial)
+			continue
+		}
+		switch len(serials) {
+		case 2:
+			deleted = append(deleted, rr.String())
+		case 3:
+			added = append(added, rr.String())
+		}
+	}
+	require.Equal(t, []uint32{2, 1, 2, 2}, serials)
+	require.Equal(t, []string{"ns1.delegated.web3.\t3600\tIN\tA\t192.0.2.53"}, deleted)
+	require.ElementsMatch(t, []string{
+		"ns1.delegated.web3.\t3600\tIN\tA\t192.0.2.54",
+		"onchain.web3.\t3600\tIN\tA\t192.0.2.10",
+	}, added)
+
+	// A secondary already at the latest height gets the SOA alone.
+	req.SetIxfr("web3.", 2, "ns1.dnsblockchain.", "hostmaster.web3.")
+	rrs, err = transferIn(new(dns.Transfer), req, addr)
+	require.NoError(t, err)
+	require.Len(t, rrs, 1)
+
+	// A height the node can no longer query falls back to a full transfer.
+	req.SetIxfr("web3.", 0, "ns1.dnsblockchain.", "hostmaster.web3.")
+	rrs, err = transferIn(new(dns.Transfer), req, addr)
+	require.NoError(t, err)
+	require.Greater(t, len(rrs), 3)
+	require.Equal(t, uint32(2), rrs[len(rrs)-1].(*dns.SOA).Serial)
+}
+
+func TestTransferAccessControl(t *testing.T) {
+	key, err := dnsserver.ParseTSIGKey("xfr-key:" + testTSIGSecret)
+	require.NoError(t, err)
+	addr := startTransferServer(t, &dnsserver.TransferConfig{
+		Zones:    testExporter(),
+		TSIGKeys: []dnsserver.TSIGKey{key},
+	})
+
+	req := new(dns.Msg)
+	req.SetAxfr("web3.")
+	_, err = transferIn(new(dns.Transfer), req, addr)
+	require.ErrorContains(t, err, "rcode: "+strconv.Itoa(dns.RcodeRefused))
+
+	signed := new(dns.Msg)
+	signed.SetAxfr("web3.")
+	signed.SetTsig(key.Name, key.Algorithm, 300, time.Now().Unix())
+	rrs, err := transferIn(&dns.Transfer{TsigSecret: map[string]string{key.Name: key.Secret}}, signed, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), rrs[0].(*dns.SOA).Serial)
+
+	// A signature made with another secret is rejected.
+	forged := new(dns.Msg)
+	forged.SetAxfr("web3.")
+	forged.SetTsig(key.Name, key.Algorithm, 300, time.Now().Unix())
+	_, err = transferIn(&dns.Transfer{TsigSecret: map[string]string{key.Name: "Zm9yZ2Vk"}}, forged, addr)
+	require.Error(t, err)
+}
+
+func TestParseTSIGKey(t *testing.T) {
+	key, err := dnsserver.ParseTSIGKey("hmac-sha512:Xfr-Key:" + testTSIGSecret)
+	require.NoError(t, err)
+	require.Equal(t, dnsserver.TSIGKey{Name: "xfr-key.", Algorithm: dns.HmacSHA512, Secret: testTSIGSecret}, key)
+
+	_, err = dnsserver.ParseTSIGKey("hmac-md4:xfr-key:" + testTSIGSecret)
+	require.Error(t, err)
+	_, err = dnsserver.ParseTSIGKey("xfr-key")
+	require.Error(t, err)
+}
+
+// fakeChangeFeed reports the TLDs changed at each height up to an adjustable latest height.
+type fakeChangeFeed struct {
+	latest  atomic.Int64
+	changes map[int64][]string
+}
+
+func (f *fakeChangeFeed) LatestHeight(context.Context) (int64, error) {
+	return f.latest.Load(), nil
+}
+
+func (f *fakeChangeFeed) ChangedTLDs(_ context.Context, height int64) ([]string, error) {
+	return f.changes[height], nil
+}
+
+func TestNotifySecondaries(t *testing.T) {
+	notified := make(chan *dns.Msg, 10)
+	secondary := &dns.Server{Addr: "127.0.0.1:0", Net: "udp", Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		_ = w.WriteMsg(resp)
+		notified <- req
+	})}
+	started := make(chan struct{})
+	secondary.NotifyStartedFunc = func() { close(started) }
+	go func() { _ = secondary.ListenAndServe() }()
+	<-started
+	t.Cleanup(func() { _ = secondary.Shutdown() })
+
+	feed := &fakeChangeFeed{changes: map[int64][]string{3: {"web3"}, 4: {"web3"}}}
+	feed.latest.Store(2)
+	cfg := dnsserver.DefaultConfig()
+	cfg.Transfer = &dnsserver.TransferConfig{
+		Zones:       testExporter(),
+		Changes:     feed,
+		Secondaries: []string{secondary.PacketConn.LocalAddr().String()},
+	}
+	srv := dnsserver.NewServer(testRegistry(), cfg, log.NewNopLogger())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- srv.WatchChanges(ctx, 10*time.Millisecond) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	// The first poll only learns the height, which becomes the SOA serial.
+	soaQuery := new(dns.Msg)
+	soaQuery.SetQuestion("web3.", dns.TypeSOA)
+	require.Eventually(t, func() bool {
+		resp := srv.Answer(ctx, soaQuery)
+		return len(resp.Answer) == 1 && resp.Answer[0].(*dns.SOA).Serial == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Empty(t, notified)
+
+	// Blocks 3 and 4 are seen together and notified once, with the latest height as serial.
+	feed.latest.Store(4)
+	select {
+	case req := <-notified:
+		require.Equal(t, dns.OpcodeNotify, req.Opcode)
+		require.Equal(t, "web3.", req.Question[0].Name)
+		require.Equal(t, uint32(4), req.Answer[0].(*dns.SOA).Serial)
+	case <-time.After(5 * time.Second):
+		t.Fatal("secondary was not notified")
+	}
+}
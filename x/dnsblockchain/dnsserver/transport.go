@@ -0,0 +1,118 @@
+package dnsserver
+
+import (
+	"context"
+	"net"
+
+	"cosmossdk.io/log"
+	"github.com/miekg/dns"
+)
+
+// Answerer builds the response to a DNS query. Both the authoritative Server and the hybrid
+// resolver implement it, so every transport shares the same answer logic.
+type Answerer interface {
+	Answer(ctx context.Context, req *dns.Msg) *dns.Msg
+}
+
+// Transferer is implemented by answerers that serve zone transfers, which stream several messages
+// over one connection instead of returning a single response.
+type Transferer interface {
+	ServeTransfer(w dns.ResponseWriter, req *dns.Msg)
+}
+
+// NewHandler adapts an Answerer to a dns.Handler for plain UDP and TCP, truncating UDP responses
+// to the size the client accepts. AXFR and IXFR requests are passed to answerers implementing
+// Transferer.
+func NewHandler(answerer Answerer, logger log.Logger) dns.Handler {
+	return handler{answerer: answerer, logger: logger}
+}
+
+type handler struct {
+	answerer Answerer
+	logger   log.Logger
+}
+
+func (h handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	if t, ok := h.answerer.(Transferer); ok && isTransfer(req) {
+		t.ServeTransfer(w, req)
+		return
+	}
+	resp := h.answerer.Answer(context.Background(), req)
+	if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+		resp.Truncate(udpSize(req))
+	}
+	if err := w.WriteMsg(resp); err != nil {
+		h.logger.Debug("Failed to write DNS response", "remote", w.RemoteAddr().String(), "error", err)
+	}
+}
+
+// ServeOption customizes the DNS servers started by ListenAndServe and Serve.
+type ServeOption func(*dns.Server)
+
+// WithTSIGKeys makes the servers verify requests signed with the given keys and sign the responses,
+// so handlers can check the outcome with ResponseWriter.TsigStatus.
+func WithTSIGKeys(keys []TSIGKey) ServeOption {
+	return func(srv *dns.Server) {
+		if len(keys) == 0 {
+			return
+		}
+		srv.TsigSecret = make(map[string]string, len(keys))
+		for _, key := range keys {
+			srv.TsigSecret[key.Name] = key.Secret
+		}
+	}
+}
+
+// ListenAndServe runs handler over UDP and TCP on addr until ctx is cancelled.
+func ListenAndServe(ctx context.Context, handler dns.Handler, addr string, opts ...ServeOption) error {
+	pc, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		pc.Close()
+		return err
+	}
+	return Serve(ctx, handler, pc, l, opts...)
+}
+
+// Serve runs handler on the given UDP and TCP listeners until ctx is cancelled or either listener
+// fails. Both listeners are closed on return.
+func Serve(ctx context.Context, handler dns.Handler, pc net.PacketConn, l net.Listener, opts ...ServeOption) error {
+	servers := []*dns.Server{
+		{PacketConn: pc, Handler: handler},
+		{Listener: l, Handler: handler},
+	}
+	for _, srv := range servers {
+		for _, opt := range opts {
+			opt(srv)
+		}
+	}
+	errCh := make(chan error, len(servers))
+	for _, srv := range servers {
+		go func(srv *dns.Server) { errCh <- srv.ActivateAndServe() }(srv)
+	}
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-errCh:
+	}
+	for _, srv := range servers {
+		_ = srv.Shutdown()
+	}
+	return err
+}
+
+func isTransfer(req *dns.Msg) bool {
+	return len(req.Question) == 1 && (req.Question[0].Qtype == dns.TypeAXFR || req.Question[0].Qtype == dns.TypeIXFR)
+}
+
+// udpSize returns the largest response the client accepts over UDP.
+func udpSize(req *dns.Msg) int {
+	if opt := req.IsEdns0(); opt != nil {
+		return int(min(max(opt.UDPSize(), dns.MinMsgSize), EDNSUDPSize))
+	}
+	return dns.MinMsgSize
+}
@@ -0,0 +1,123 @@
+package dnsserver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// ZoneRRs returns the records of a TLD zone built from its active domains, in master file order:
+// the SOA and apex NS first, then each domain's delegation (NS, DS and in-zone glue) or, for
+// domains without one, its on-chain records. Domains below a delegation are occluded by it and
+// left out. It returns the records and the number of domains they cover.
+func ZoneRRs(tld string, serial uint32, cfg Config, domains []types.Domain) ([]dns.RR, int) {
+	cfg = cfg.withDefaults()
+	zone := dns.Fqdn(strings.ToLower(tld))
+	rrs, included := domainRRs(zone, domains)
+	return append([]dns.RR{zoneSOA(zone, cfg, serial), apexNS(zone, cfg)}, rrs...), included
+}
+
+// domainRRs returns the records ZoneRRs lists after the apex, and the number of domains they cover.
+func domainRRs(zone string, domains []types.Domain) ([]dns.RR, int) {
+	var rrs []dns.RR
+	sorted := make([]types.Domain, len(domains))
+	copy(sorted, domains)
+	// Canonical order lists every domain right before its subdomains.
+	sort.Slice(sorted, func(i, j int) bool { return canonicalLess(sorted[i].Name, sorted[j].Name) })
+
+	var (
+		delegated []string
+		included  int
+	)
+	for _, domain := range sorted {
+		name := dns.Fqdn(strings.ToLower(domain.Name))
+		if !dns.IsSubDomain(zone, name) || name == zone || isOccluded(delegated, name) {
+			continue
+		}
+		included++
+
+		if len(domain.NsRecords) > 0 {
+			delegated = append(delegated, name)
+			ns, glue := DelegationRRs(domain)
+			rrs = append(rrs, ns...)
+			rrs = append(rrs, DSRRs(domain)...)
+			for _, rr := range glue {
+				// Glue outside the zone is not ours to publish; loaders reject it as out-of-zone data.
+				if dns.IsSubDomain(zone, rr.Header().Name) {
+					rrs = append(rrs, rr)
+				}
+			}
+			continue
+		}
+
+		for _, r := range domain.Records {
+			if r == nil {
+				continue
+			}
+			rr, err := ResourceRecordToRR(types.RecordFQDN(domain.Name, r.Name), *r)
+			if err != nil {
+				continue
+			}
+			rrs = append(rrs, rr)
+		}
+	}
+	return rrs, included
+}
+
+// ExportZone renders ZoneRRs as an RFC 1035 master file. It returns the file and the number of
+// domains it covers.
+func ExportZone(tld string, serial uint32, cfg Config, domains []types.Domain) (string, int) {
+	rrs, included := ZoneRRs(tld, serial, cfg, domains)
+	zone := dns.Fqdn(strings.ToLower(tld))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "; Zone %s exported from the dnsblockchain registry, serial %d\n", zone, serial)
+	fmt.Fprintf(&b, "$ORIGIN %s\n", zone)
+	writeRRs(&b, rrs)
+	return b.String(), included
+}
+
+// ExportZoneRecords renders the records of the given domains without the SOA and apex NS, for
+// continuing a master file started by ExportZone with more of the zone's domains. It returns the
+// records and the number of domains they cover.
+func ExportZoneRecords(tld string, domains []types.Domain) (string, int) {
+	rrs, included := domainRRs(dns.Fqdn(strings.ToLower(tld)), domains)
+
+	var b strings.Builder
+	writeRRs(&b, rrs)
+	return b.String(), included
+}
+
+// writeRRs writes records one per line in master file format.
+func writeRRs(b *strings.Builder, rrs []dns.RR) {
+	for _, rr := range rrs {
+		b.WriteString(rr.String())
+		b.WriteByte('\n')
+	}
+}
+
+// isOccluded reports whether name lies below one of the delegated names.
+func isOccluded(delegated []string, name string) bool {
+	for _, cut := range delegated {
+		if name != cut && dns.IsSubDomain(cut, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// canonicalLess orders names by their labels from the TLD down, as in RFC 4034 section 6.1.
+func canonicalLess(a, b string) bool {
+	la := dns.SplitDomainName(strings.ToLower(a))
+	lb := dns.SplitDomainName(strings.ToLower(b))
+	for i, j := len(la)-1, len(lb)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if la[i] != lb[j] {
+			return la[i] < lb[j]
+		}
+	}
+	return len(la) < len(lb)
+}
@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// SetAuction stores an auction and queues it for settlement at the end of its reveal period.
+func (k Keeper) SetAuction(ctx context.Context, auction types.Auction) error {
+	if err := k.Auctions.Set(ctx, auction.Name, auction); err != nil {
+		return err
+	}
+	return k.AuctionQueue.Set(ctx, collections.Join(auction.RevealEnd, auction.Name))
+}
+
+// RemoveAuction deletes an auction, its queue entry and its bids.
+func (k Keeper) RemoveAuction(ctx context.Context, auction types.Auction) error {
+	bids, err := k.GetAuctionBids(ctx, auction.Name)
+	if err != nil {
+		return err
+	}
+	for _, bid := range bids {
+		if err := k.AuctionBids.Remove(ctx, collections.Join(bid.Name, bid.Bidder)); err != nil {
+			return err
+		}
+	}
+	if err := k.Auctions.Remove(ctx, auction.Name); err != nil {
+		return err
+	}
+	return k.AuctionQueue.Remove(ctx, collections.Join(auction.RevealEnd, auction.Name))
+}
+
+// GetAuction returns the auction of the given name.
+func (k Keeper) GetAuction(ctx context.Context, name string) (types.Auction, bool, error) {
+	auction, err := k.Auctions.Get(ctx, name)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Auction{}, false, nil
+	}
+	if err != nil {
+		return types.Auction{}, false, err
+	}
+	return auction, true, nil
+}
+
+// GetAuctionBids returns the bids placed in the auction of the given name, ordered by bidder.
+func (k Keeper) GetAuctionBids(ctx context.Context, name string) ([]types.AuctionBid, error) {
+	var bids []types.AuctionBid
+	rng := collections.NewPrefixedPairRange[string, string](name)
+	err := k.AuctionBids.Walk(ctx, rng, func(_ collections.Pair[string, string], bid types.AuctionBid) (bool, error) {
+		bids = append(bids, bid)
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to get bids of auction '%s'", name)
+	}
+	return bids, nil
+}
+
+// SettleAuctions settles at most limit auctions whose reveal period has ended, earliest first.
+// Each auction is settled in its own cache context; one that fails is logged and set aside for
+// types.SweepRetryBlocks blocks. It returns how many auctions it processed.
+func (k Keeper) SettleAuctions(ctx context.Context, limit uint64) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := uint64(sdkCtx.BlockTime().Unix())
+	if err := k.requeueAuctions(sdkCtx); err != nil {
+		return 0, errorsmod.Wrap(err, "failed to requeue set-aside auctions")
+	}
+
+	var due []types.Auction
+	rng := new(collections.Range[collections.Pair[uint64, string]]).
+		EndExclusive(collections.PairPrefix[uint64, string](now + 1))
+	err := k.AuctionQueue.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		auction, err := k.Auctions.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		due = append(due, auction)
+		return uint64(len(due)) >= limit, nil
+	})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to iterate auction queue")
+	}
+
+	for _, auction := range due {
+		processed := k.sweepItem(sdkCtx, "auction", auction.Name, func(ctx sdk.Context) error {
+			return k.settleAuction(ctx, auction)
+		})
+		if !processed {
+			if err := k.setAsideAuction(sdkCtx, auction); err != nil {
+				return 0, errorsmod.Wrapf(err, "failed to set aside auction of '%s'", auction.Name)
+			}
+		}
+	}
+	return uint64(len(due)), nil
+}
+
+// setAsideAuction takes an auction that failed to settle out of the auction queue until
+// types.SweepRetryBlocks blocks later, so it cannot keep the auctions ending after it from
+// settling.
+func (k Keeper) setAsideAuction(ctx sdk.Context, auction types.Auction) error {
+	if err := k.AuctionQueue.Remove(ctx, collections.Join(auction.RevealEnd, auction.Name)); err != nil {
+		return err
+	}
+	return k.AuctionRetryQueue.Set(ctx, collections.Join(ctx.BlockHeight()+types.SweepRetryBlocks, auction.Name))
+}
+
+// requeueAuctions puts the auctions set aside until the current height back into the auction
+// queue. Auctions removed in the meantime are dropped.
+func (k Keeper) requeueAuctions(ctx sdk.Context) error {
+	var keys []collections.Pair[int64, string]
+	rng := new(collections.Range[collections.Pair[int64, string]]).
+		EndExclusive(collections.PairPrefix[int64, string](ctx.BlockHeight() + 1))
+	err := k.AuctionRetryQueue.Walk(ctx, rng, func(key collections.Pair[int64, string]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.AuctionRetryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		auction, found, err := k.GetAuction(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if found {
+			if err := k.AuctionQueue.Set(ctx, collections.Join(auction.RevealEnd, auction.Name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// settleAuction awards the name to the highest revealed bid at the price of the second-highest
+// one, or the auction's minimum price when it is the only one; ties go to the earliest bid. The
+// price is distributed through the fee split and the rest of every revealed deposit is refunded.
+// Bids never revealed forfeit Params.AuctionForfeitBps of their deposit. The opener's deposit is
+// refunded if any bid was revealed and forfeited otherwise.
+func (k Keeper) settleAuction(ctx context.Context, auction types.Auction) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+
+	bids, err := k.GetAuctionBids(ctx, auction.Name)
+	if err != nil {
+		return err
+	}
+	if err := k.RemoveAuction(ctx, auction); err != nil {
+		return err
+	}
+
+	var revealed []types.AuctionBid
+	for _, bid := range bids {
+		if bid.Revealed {
+			revealed = append(revealed, bid)
+			continue
+		}
+		forfeited, refunded := params.AuctionForfeit(bid.Deposit)
+		if err := k.distributeFee(ctx, bid.Bidder, auction.Name, forfeited, types.FeeTypeBidForfeit); err != nil {
+			return err
+		}
+		if err := k.refundDeposit(ctx, bid.Bidder, refunded); err != nil {
+			return err
+		}
+	}
+	sort.SliceStable(revealed, func(i, j int) bool {
+		a, b := revealed[i], revealed[j]
+		if !a.Amount.Amount.Equal(b.Amount.Amount) {
+			return a.Amount.Amount.GT(b.Amount.Amount)
+		}
+		if a.Height != b.Height {
+			return a.Height < b.Height
+		}
+		return a.Bidder < b.Bidder
+	})
+
+	for _, bid := range revealed[min(1, len(revealed)):] {
+		if err := k.refundDeposit(ctx, bid.Bidder, bid.Deposit); err != nil {
+			return err
+		}
+	}
+	if len(revealed) == 0 {
+		if err := k.distributeFee(ctx, auction.Creator, auction.Name, auction.Deposit, types.FeeTypeAuctionForfeit); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSettleAuction,
+			sdk.NewAttribute(types.AttributeKeyDomainName, auction.Name),
+		))
+		return nil
+	}
+	if err := k.refundDeposit(ctx, auction.Creator, auction.Deposit); err != nil {
+		return err
+	}
+
+	winner := revealed[0]
+	price := auction.MinPrice
+	if len(revealed) > 1 {
+		price = revealed[1].Amount
+	}
+
+	id, awarded, err := k.awardAuction(ctx, auction.Name, winner)
+	if err != nil {
+		return err
+	}
+	if !awarded {
+		// The name was taken out of circulation during the auction, e.g. by delisting its TLD.
+		k.Logger(sdkCtx).Info("Auctioned name is no longer available; refunding the winner", "name", auction.Name, "winner", winner.Bidder)
+		return k.refundDeposit(ctx, winner.Bidder, winner.Deposit)
+	}
+	if err := k.distributeFee(ctx, winner.Bidder, auction.Name, sdk.NewCoins(price), types.FeeTypeAuction); err != nil {
+		return err
+	}
+	if err := k.refundDeposit(ctx, winner.Bidder, winner.Deposit.Sub(price)); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSettleAuction,
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, auction.Name),
+		sdk.NewAttribute(types.AttributeKeyWinner, winner.Bidder),
+		sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+	))
+	return nil
+}
+
+// awardAuction registers an auctioned name to the winning bid for one year, with the name servers
+// revealed with it. It reports false when the name can no longer be registered.
+func (k Keeper) awardAuction(ctx context.Context, name string, winner types.AuctionBid) (uint64, bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	availability, err := k.CheckNameAvailability(ctx, name)
+	if err != nil {
+		return 0, false, err
+	}
+	// The launch phase is what sent the name to auction in the first place.
+	if availability.Err != nil && availability.Reason != types.Availability_AVAILABILITY_AUCTION_ONLY {
+		return 0, false, nil
+	}
+	if availability.Released != nil {
+		if err := k.ReclaimExpiredDomain(ctx, *availability.Released); err != nil {
+			return 0, false, errorsmod.Wrapf(err, "failed to reclaim expired domain '%s'", name)
+		}
+	}
+
+	id, err := k.DomainSeq.Next(ctx)
+	if err != nil {
+		return 0, false, errorsmod.Wrap(err, "failed to get next id")
+	}
+	domain := types.Domain{
+		Id:         id,
+		Creator:    winner.Bidder,
+		Name:       availability.Name,
+		Owner:      winner.Bidder,
+		NsRecords:  winner.NsRecords,
+		Expiration: types.AddYears(uint64(sdkCtx.BlockTime().Unix()), 1),
+	}
+	if err := k.SetDomain(ctx, domain); err != nil {
+		return 0, false, errorsmod.Wrap(err, "failed to set domain")
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateDomain,
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+		sdk.NewAttribute(types.AttributeKeyOwner, winner.Bidder),
+		sdk.NewAttribute(types.AttributeKeyCreator, winner.Bidder),
+	))
+	return id, true, nil
+}
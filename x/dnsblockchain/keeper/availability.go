@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// NameAvailability is the outcome of the registration checks run on a candidate name.
+type NameAvailability struct {
+	// Name is the normalized name.
+	Name   string
+	Reason types.Availability
+	// Err explains why the name cannot be registered, wrapping the module error CreateDomain
+	// returns. It is nil when the name is available.
+	Err error
+	// Released is the stored entry of a name whose pending-delete period has ended but which the
+	// EndBlocker has not swept yet. It must be reclaimed before the name is registered again.
+	Released *types.Domain
+	// Parent is the active parent domain of a subdomain, nil for names registered under a TLD.
+	Parent *types.Domain
+}
+
+// Limits on domain names from RFC 1035.
+const (
+	maxLabelLength = 63
+	maxNameLength  = 253
+)
+
+// CheckNameAvailability normalizes a candidate name and runs the checks CreateDomain applies before
+// registering it: name format, ICANN-reserved and permitted TLDs, an active parent for subdomains,
+// and any existing entry. The returned error is only set for store failures.
+func (k Keeper) CheckNameAvailability(ctx context.Context, name string) (NameAvailability, error) {
+	normalizedName := strings.ToLower(strings.Trim(name, "."))
+	res := NameAvailability{Name: normalizedName}
+
+	parts := strings.Split(normalizedName, ".")
+	validFormat := len(parts) >= 2 && len(normalizedName) <= maxNameLength
+	for _, label := range parts {
+		if label == "" || len(label) > maxLabelLength {
+			validFormat = false
+		}
+	}
+	if !validFormat {
+		res.Reason = types.Availability_AVAILABILITY_INVALID_NAME
+		res.Err = errorsmod.Wrapf(types.ErrInvalidDomainName, "domain name '%s' must be in 'label.tld' or 'sub.label.tld' format", name)
+		return res, nil
+	}
+	tld := parts[len(parts)-1]
+
+	if types.IsReservedTLD(tld) {
+		res.Reason = types.Availability_AVAILABILITY_TLD_RESERVED
+		res.Err = errorsmod.Wrapf(types.ErrTLDReservedByICANN, "TLD '%s' from domain name '%s' is reserved by ICANN", tld, name)
+		return res, nil
+	}
+
+	isPermitted, err := k.IsTLDPermitted(ctx, tld)
+	if err != nil {
+		return res, errorsmod.Wrap(err, "failed to check if TLD is permitted")
+	}
+	if !isPermitted {
+		res.Reason = types.Availability_AVAILABILITY_TLD_NOT_PERMITTED
+		res.Err = errorsmod.Wrapf(types.ErrTLDNotPermitted, "TLD '%s' from domain name '%s' is not permitted", tld, name)
+		return res, nil
+	}
+
+	if parentName := types.ParentName(normalizedName); parentName != "" {
+		parent, err := k.GetDomainByName(ctx, parentName)
+		active := err == nil
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return res, errorsmod.Wrap(err, "failed to get parent domain")
+		}
+		if active {
+			if parent, _, err = k.EffectiveDomain(ctx, parent); err != nil {
+				return res, errorsmod.Wrap(err, "failed to evaluate parent domain lifecycle")
+			}
+			active = parent.Status == types.DomainStatus_DOMAIN_STATUS_ACTIVE
+		}
+		if !active {
+			res.Reason = types.Availability_AVAILABILITY_PARENT_NOT_REGISTERED
+			res.Err = errorsmod.Wrapf(types.ErrInvalidParentDomain, "parent domain '%s' of '%s' is not registered and active", parentName, normalizedName)
+			return res, nil
+		}
+		res.Parent = &parent
+	}
+
+	existing, err := k.GetDomainByName(ctx, normalizedName)
+	if errors.Is(err, collections.ErrNotFound) {
+		return k.checkAuctionAvailability(ctx, res, tld)
+	}
+	if err != nil {
+		return res, errorsmod.Wrap(err, "failed to get existing domain for name")
+	}
+	effective, released, err := k.EffectiveDomain(ctx, existing)
+	if err != nil {
+		return res, errorsmod.Wrap(err, "failed to evaluate existing domain lifecycle")
+	}
+	switch {
+	case released:
+		res.Released = &existing
+		return k.checkAuctionAvailability(ctx, res, tld)
+	case effective.Status == types.DomainStatus_DOMAIN_STATUS_ACTIVE:
+		res.Reason = types.Availability_AVAILABILITY_REGISTERED
+		res.Err = errorsmod.Wrapf(types.ErrDuplicateDomainName, "domain name '%s' already exists", normalizedName)
+	default:
+		res.Reason = types.Availability_AVAILABILITY_EXPIRED_HELD
+		res.Err = errorsmod.Wrapf(types.ErrDuplicateDomainName, "domain name '%s' already exists and is in %s", normalizedName, effective.Status)
+	}
+	return res, nil
+}
+
+// checkCreateAvailability runs CheckNameAvailability for a name to be registered with
+// MsgCreateDomain. While Params.RequireCommitment is set, names directly under a TLD that are
+// otherwise available must be committed to and revealed instead.
+func (k Keeper) checkCreateAvailability(ctx context.Context, params types.Params, name string) (NameAvailability, error) {
+	res, err := k.CheckNameAvailability(ctx, name)
+	if err != nil || res.Err != nil || !params.RequireCommitment || res.Parent != nil {
+		return res, err
+	}
+	res.Reason = types.Availability_AVAILABILITY_COMMITMENT_REQUIRED
+	res.Err = errorsmod.Wrapf(types.ErrCommitmentRequired, "register %s with MsgCommitDomain and MsgRevealDomain", res.Name)
+	return res, nil
+}
+
+// checkAuctionAvailability marks an otherwise available name as taken by its open auction, or as
+// auction-only when it lies directly under a TLD in its launch phase.
+func (k Keeper) checkAuctionAvailability(ctx context.Context, res NameAvailability, tld string) (NameAvailability, error) {
+	inAuction, err := k.Auctions.Has(ctx, res.Name)
+	if err != nil {
+		return res, errorsmod.Wrap(err, "failed to get auction")
+	}
+	if inAuction {
+		res.Reason = types.Availability_AVAILABILITY_IN_AUCTION
+		res.Err = errorsmod.Wrapf(types.ErrNameInAuction, "domain name '%s' is being auctioned", res.Name)
+		return res, nil
+	}
+	if res.Parent != nil {
+		return res, nil
+	}
+
+	launchEnd, err := k.TLDLaunches.Get(ctx, tld)
+	if errors.Is(err, collections.ErrNotFound) {
+		return res, nil
+	}
+	if err != nil {
+		return res, errorsmod.Wrap(err, "failed to get TLD launch")
+	}
+	if uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()) < launchEnd {
+		res.Reason = types.Availability_AVAILABILITY_AUCTION_ONLY
+		res.Err = errorsmod.Wrapf(types.ErrNameInAuction, "TLD '%s' is launching: '%s' can only be won at auction until %d", tld, res.Name, launchEnd)
+	}
+	return res, nil
+}
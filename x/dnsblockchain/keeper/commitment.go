@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// SetCommitment stores a commitment and queues it for pruning.
+func (k Keeper) SetCommitment(ctx context.Context, commitment types.Commitment) error {
+	if err := k.Commitments.Set(ctx, collections.Join(commitment.Creator, commitment.Hash), commitment); err != nil {
+		return err
+	}
+	return k.CommitmentQueue.Set(ctx, collections.Join3(commitment.Height, commitment.Creator, commitment.Hash))
+}
+
+// RemoveCommitment deletes a commitment and its queue entry.
+func (k Keeper) RemoveCommitment(ctx context.Context, commitment types.Commitment) error {
+	if err := k.Commitments.Remove(ctx, collections.Join(commitment.Creator, commitment.Hash)); err != nil {
+		return err
+	}
+	return k.CommitmentQueue.Remove(ctx, collections.Join3(commitment.Height, commitment.Creator, commitment.Hash))
+}
+
+// refundDeposit returns escrowed coins from the module account to addr.
+func (k Keeper) refundDeposit(ctx context.Context, addr string, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	bz, err := k.addressCodec.StringToBytes(addr)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid refund address %s", addr)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(bz), amount); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund %s to %s", amount, addr)
+	}
+	return nil
+}
+
+// PruneCommitments removes at most limit commitments that can no longer be revealed, oldest first.
+// Params.CommitForfeitBps of each deposit is forfeited through the fee split and the rest is
+// refunded to the committer. Each commitment is pruned in its own cache context; one that fails
+// is logged and set aside for types.SweepRetryBlocks blocks. It returns how many commitments it
+// processed.
+func (k Keeper) PruneCommitments(ctx context.Context, limit uint64) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	if err := k.requeueCommitments(sdkCtx); err != nil {
+		return 0, errorsmod.Wrap(err, "failed to requeue set-aside commitments")
+	}
+	_, maxBlocks := params.CommitWindow()
+	// Commitments made at or before this height are past their reveal window.
+	lastStale := sdkCtx.BlockHeight() - int64(maxBlocks) - 1
+	if lastStale < 0 {
+		return 0, nil
+	}
+
+	var stale []types.Commitment
+	rng := new(collections.Range[collections.Triple[int64, string, []byte]]).
+		EndExclusive(collections.TriplePrefix[int64, string, []byte](lastStale + 1))
+	err = k.CommitmentQueue.Walk(ctx, rng, func(key collections.Triple[int64, string, []byte]) (bool, error) {
+		commitment, err := k.Commitments.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return true, err
+		}
+		stale = append(stale, commitment)
+		return uint64(len(stale)) >= limit, nil
+	})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to iterate commitment queue")
+	}
+
+	for _, commitment := range stale {
+		processed := k.sweepItem(sdkCtx, "commitment", hex.EncodeToString(commitment.Hash), func(ctx sdk.Context) error {
+			return k.pruneCommitment(ctx, params, commitment)
+		})
+		if !processed {
+			if err := k.setAsideCommitment(sdkCtx, commitment); err != nil {
+				return 0, errorsmod.Wrapf(err, "failed to set aside commitment %x", commitment.Hash)
+			}
+		}
+	}
+	return uint64(len(stale)), nil
+}
+
+// setAsideCommitment takes a stale commitment that failed to be pruned out of the commitment queue
+// until types.SweepRetryBlocks blocks later, so it cannot keep the commitments after it from being
+// pruned.
+func (k Keeper) setAsideCommitment(ctx sdk.Context, commitment types.Commitment) error {
+	if err := k.CommitmentQueue.Remove(ctx, collections.Join3(commitment.Height, commitment.Creator, commitment.Hash)); err != nil {
+		return err
+	}
+	return k.CommitmentRetryQueue.Set(ctx, collections.Join3(ctx.BlockHeight()+types.SweepRetryBlocks, commitment.Creator, commitment.Hash))
+}
+
+// requeueCommitments puts the commitments set aside until the current height back into the
+// commitment queue. Commitments removed in the meantime are dropped.
+func (k Keeper) requeueCommitments(ctx sdk.Context) error {
+	var keys []collections.Triple[int64, string, []byte]
+	rng := new(collections.Range[collections.Triple[int64, string, []byte]]).
+		EndExclusive(collections.TriplePrefix[int64, string, []byte](ctx.BlockHeight() + 1))
+	err := k.CommitmentRetryQueue.Walk(ctx, rng, func(key collections.Triple[int64, string, []byte]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.CommitmentRetryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		commitment, found, err := k.GetCommitment(ctx, key.K2(), key.K3())
+		if err != nil {
+			return err
+		}
+		if found {
+			if err := k.CommitmentQueue.Set(ctx, collections.Join3(commitment.Height, commitment.Creator, commitment.Hash)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pruneCommitment removes a stale commitment, forfeiting Params.CommitForfeitBps of its deposit
+// and refunding the rest.
+func (k Keeper) pruneCommitment(ctx sdk.Context, params types.Params, commitment types.Commitment) error {
+	if err := k.RemoveCommitment(ctx, commitment); err != nil {
+		return err
+	}
+	forfeited, refunded := params.CommitForfeit(commitment.Deposit)
+	if err := k.distributeFee(ctx, commitment.Creator, "", forfeited, types.FeeTypeForfeit); err != nil {
+		return err
+	}
+	if err := k.refundDeposit(ctx, commitment.Creator, refunded); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePruneCommitment,
+		sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(commitment.Hash)),
+		sdk.NewAttribute(types.AttributeKeyCreator, commitment.Creator),
+		sdk.NewAttribute(types.AttributeKeyDeposit, commitment.Deposit.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refunded.String()),
+	))
+	return nil
+}
+
+// GetCommitment returns the commitment with the given hash made by creator.
+func (k Keeper) GetCommitment(ctx context.Context, creator string, hash []byte) (types.Commitment, bool, error) {
+	commitment, err := k.Commitments.Get(ctx, collections.Join(creator, hash))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Commitment{}, false, nil
+	}
+	if err != nil {
+		return types.Commitment{}, false, err
+	}
+	return commitment, true, nil
+}
@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// EffectiveDomain returns the domain advanced through any lifecycle transitions already due at the
+// current block time, which the EndBlocker may not have persisted yet, and whether its name is due
+// for release.
+func (k Keeper) EffectiveDomain(ctx context.Context, domain types.Domain) (types.Domain, bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return domain, false, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	advanced, released := domain.AdvanceLifecycle(uint64(sdkCtx.BlockTime().Unix()), params)
+	return advanced, released, nil
+}
+
+// SetDomain stores a domain and keeps its name and secondary indexes, its TLD's domain count and
+// its NFT in sync. A new domain without an original creator records its creator as such.
+func (k Keeper) SetDomain(ctx context.Context, domain types.Domain) error {
+	prev, err := k.Domain.Get(ctx, domain.Id)
+	switch {
+	case err == nil:
+		if err := k.unindexDomain(ctx, prev); err != nil {
+			return err
+		}
+		// An offer or listing made by or to the previous creator no longer stands once the domain
+		// changes hands, and a domain past its expiration cannot be sold. Counting the change of
+		// hands lapses the market offers without touching them; their buyers withdraw them.
+		if prev.Creator != domain.Creator {
+			domain.Transfers = prev.Transfers + 1
+			if err := k.RemoveTransferOffer(ctx, domain.Id); err != nil {
+				return err
+			}
+		}
+		if prev.Creator != domain.Creator || domain.Status != types.DomainStatus_DOMAIN_STATUS_ACTIVE {
+			if err := k.RemoveListing(ctx, domain.Id); err != nil {
+				return err
+			}
+		}
+	case errors.Is(err, collections.ErrNotFound):
+		if err := k.adjustTLDDomainCount(ctx, types.ExtractTLD(domain.Name), 1); err != nil {
+			return err
+		}
+		// A registration ends the release of the name.
+		if err := k.RemoveRelease(ctx, domain.Name); err != nil {
+			return err
+		}
+		if domain.OriginalCreator == "" {
+			domain.OriginalCreator = domain.Creator
+		}
+	default:
+		return err
+	}
+
+	if err := k.Domain.Set(ctx, domain.Id, domain); err != nil {
+		return err
+	}
+	if err := k.DomainName.Set(ctx, domain.Name, domain.Id); err != nil {
+		return err
+	}
+	if err := k.syncDomainNFT(ctx, domain); err != nil {
+		return err
+	}
+	return k.indexDomain(ctx, domain)
+}
+
+// RemoveDomain deletes a domain together with its name and secondary index entries and burns its
+// NFT. Its subdomains cannot outlive it and are removed first, emitting a remove_subdomain event
+// each. Market offers for it lapse and are left for their buyers to withdraw.
+func (k Keeper) RemoveDomain(ctx context.Context, domain types.Domain) error {
+	var children []uint64
+	err := k.Subdomains.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](domain.Name), func(key collections.Pair[string, uint64]) (bool, error) {
+		children = append(children, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, id := range children {
+		child, err := k.Domain.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.RemoveDomain(ctx, child); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveSubdomain,
+				sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", child.Id)),
+				sdk.NewAttribute(types.AttributeKeyDomainName, child.Name),
+				sdk.NewAttribute(types.AttributeKeyParent, domain.Name),
+				sdk.NewAttribute(types.AttributeKeyOwner, child.Owner),
+			),
+		)
+	}
+
+	if err := k.DomainName.Remove(ctx, domain.Name); err != nil {
+		return err
+	}
+	if err := k.unindexDomain(ctx, domain); err != nil {
+		return err
+	}
+	if err := k.adjustTLDDomainCount(ctx, types.ExtractTLD(domain.Name), -1); err != nil {
+		return err
+	}
+	if err := k.RemoveTransferOffer(ctx, domain.Id); err != nil {
+		return err
+	}
+	if err := k.RemoveListing(ctx, domain.Id); err != nil {
+		return err
+	}
+	if err := k.burnDomainNFT(ctx, domain); err != nil {
+		return err
+	}
+	return k.Domain.Remove(ctx, domain.Id)
+}
+
+// indexDomain writes the expiration, owner and TLD index entries of a domain.
+func (k Keeper) indexDomain(ctx context.Context, domain types.Domain) error {
+	tld := types.ExtractTLD(domain.Name)
+	if err := k.DomainExpirationQueue.Set(ctx, collections.Join(domain.NextDeadline(), domain.Id)); err != nil {
+		return err
+	}
+	if err := k.DomainsByOwner.Set(ctx, collections.Join(domain.Owner, domain.Id)); err != nil {
+		return err
+	}
+	if err := k.DomainsByTLD.Set(ctx, collections.Join(tld, domain.Id)); err != nil {
+		return err
+	}
+	if err := k.TLDExpirations.Set(ctx, collections.Join3(tld, domain.Expiration, domain.Id)); err != nil {
+		return err
+	}
+	if domain.Parent != "" {
+		return k.Subdomains.Set(ctx, collections.Join(domain.Parent, domain.Id))
+	}
+	return nil
+}
+
+// unindexDomain removes the index entries written by indexDomain.
+func (k Keeper) unindexDomain(ctx context.Context, domain types.Domain) error {
+	tld := types.ExtractTLD(domain.Name)
+	if err := k.DomainExpirationQueue.Remove(ctx, collections.Join(domain.NextDeadline(), domain.Id)); err != nil {
+		return err
+	}
+	if err := k.DomainsByOwner.Remove(ctx, collections.Join(domain.Owner, domain.Id)); err != nil {
+		return err
+	}
+	if err := k.DomainsByTLD.Remove(ctx, collections.Join(tld, domain.Id)); err != nil {
+		return err
+	}
+	if err := k.TLDExpirations.Remove(ctx, collections.Join3(tld, domain.Expiration, domain.Id)); err != nil {
+		return err
+	}
+	if domain.Parent != "" {
+		return k.Subdomains.Remove(ctx, collections.Join(domain.Parent, domain.Id))
+	}
+	return nil
+}
+
+// GetDomainByName returns the domain registered under an exact, normalized name.
+func (k Keeper) GetDomainByName(ctx context.Context, name string) (types.Domain, error) {
+	id, err := k.DomainName.Get(ctx, name)
+	if err != nil {
+		return types.Domain{}, err
+	}
+	return k.Domain.Get(ctx, id)
+}
+
+// LookupDomain returns the domain registered under name or, failing that, under its longest
+// registered suffix, e.g. "example.web3" for "www.shop.example.web3" when neither
+// "www.shop.example.web3" nor "shop.example.web3" is registered. Bare TLDs never match.
+// exact reports whether the returned domain is name itself.
+func (k Keeper) LookupDomain(ctx context.Context, name string) (domain types.Domain, exact bool, found bool, err error) {
+	candidate := strings.ToLower(strings.Trim(name, "."))
+	for exact = true; strings.Contains(candidate, "."); exact = false {
+		domain, err = k.GetDomainByName(ctx, candidate)
+		if err == nil {
+			return domain, exact, true, nil
+		}
+		if !errors.Is(err, collections.ErrNotFound) {
+			return domain, false, false, err
+		}
+		_, candidate, _ = strings.Cut(candidate, ".")
+	}
+	return types.Domain{}, false, false, nil
+}
+
+// ParentOwner returns the current owner of a subdomain's parent, or an empty string for domains
+// registered directly under a TLD.
+func (k Keeper) ParentOwner(ctx context.Context, domain types.Domain) (string, error) {
+	if domain.Parent == "" {
+		return "", nil
+	}
+	parent, err := k.GetDomainByName(ctx, domain.Parent)
+	if err != nil {
+		return "", err
+	}
+	return parent.Owner, nil
+}
+
+// IterateDueDomains visits, soonest first, up to limit domains whose next lifecycle deadline is at
+// or before the given unix time. Only the due window of the expiration index is scanned.
+func (k Keeper) IterateDueDomains(ctx context.Context, before uint64, limit uint64, cb func(domain types.Domain) (stop bool, err error)) error {
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndInclusive(collections.Join(before, uint64(math.MaxUint64)))
+
+	var visited uint64
+	return k.DomainExpirationQueue.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		if visited >= limit {
+			return true, nil
+		}
+		visited++
+
+		domain, err := k.Domain.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		return cb(domain)
+	})
+}
+
+// ReclaimExpiredDomain removes a domain whose pending-delete period has ended so its name can be
+// registered again, emitting an expiry event. Names directly under a TLD are released through a
+// descending-price auction starting when the pending-delete period ended.
+func (k Keeper) ReclaimExpiredDomain(ctx context.Context, domain types.Domain) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	release, releasing, err := k.releaseOf(ctx, domain)
+	if err != nil {
+		return err
+	}
+	if err := k.RemoveDomain(ctx, domain); err != nil {
+		return err
+	}
+	if releasing {
+		if err := k.SetRelease(ctx, release); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(sdkCtx).Info("Expired domain reclaimed", "name", domain.Name, "id", domain.Id, "expiration", domain.Expiration)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExpireDomain,
+			sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", domain.Id)),
+			sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, domain.Owner),
+			sdk.NewAttribute(types.AttributeKeyExpiration, fmt.Sprintf("%d", domain.Expiration)),
+		),
+	)
+	return nil
+}
+
+// AdvanceDomainLifecycle persists the lifecycle transitions due for a domain at the current block
+// time, emitting a status event, and releases the name once its pending-delete period has ended.
+func (k Keeper) AdvanceDomainLifecycle(ctx context.Context, domain types.Domain) error {
+	advanced, released, err := k.EffectiveDomain(ctx, domain)
+	if err != nil {
+		return err
+	}
+	if released {
+		// Index entries are keyed by the stored deadline, so remove the stored domain.
+		return k.ReclaimExpiredDomain(ctx, domain)
+	}
+	if advanced.Status == domain.Status {
+		return nil
+	}
+	if err := k.SetDomain(ctx, advanced); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDomainStatus,
+			sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", advanced.Id)),
+			sdk.NewAttribute(types.AttributeKeyDomainName, advanced.Name),
+			sdk.NewAttribute(types.AttributeKeyStatus, advanced.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyStatusEnd, fmt.Sprintf("%d", advanced.StatusDeadline)),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// EndBlocker is called at the end of every block.
+// It moves domains whose lifecycle deadline has passed through the grace, redemption and
+// pending-delete states, and releases names whose pending-delete period has ended so they
+// become available again. Registration commitments past their reveal window are then pruned,
+// auctions past their reveal period settled, and releases past their window and expired transfer
+// offers dropped. All of these share a budget of Params.MaxExpirationsPerBlock items per block,
+// spent in that order; the rest are picked up by the following blocks. Each domain is processed
+// in its own cache context: one that fails is logged and set aside for types.SweepRetryBlocks
+// blocks instead of halting the chain or holding up the domains behind it. Only store-level
+// failures are returned.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get dnsblockchain params in EndBlocker")
+	}
+	budget := params.ExpirationsPerBlock()
+
+	if err := k.requeueDomains(sdkCtx); err != nil {
+		return errorsmod.Wrap(err, "failed to requeue set-aside domains")
+	}
+	var due []types.Domain
+	now := uint64(sdkCtx.BlockTime().Unix())
+	err = k.IterateDueDomains(ctx, now, budget, func(domain types.Domain) (bool, error) {
+		due = append(due, domain)
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to iterate expiration queue")
+	}
+	budget -= uint64(len(due))
+
+	for _, domain := range due {
+		// Releasing a parent earlier in this block also removes its subdomains.
+		if has, err := k.Domain.Has(ctx, domain.Id); err != nil {
+			return errorsmod.Wrapf(err, "failed to get domain %d", domain.Id)
+		} else if !has {
+			continue
+		}
+		processed := k.sweepItem(sdkCtx, "domain lifecycle", domain.Name, func(ctx sdk.Context) error {
+			return k.AdvanceDomainLifecycle(ctx, domain)
+		})
+		if !processed {
+			if err := k.setAsideDomain(sdkCtx, domain); err != nil {
+				return errorsmod.Wrapf(err, "failed to set aside domain %d", domain.Id)
+			}
+		}
+	}
+
+	sweeps := []struct {
+		name  string
+		sweep func(ctx context.Context, limit uint64) (uint64, error)
+	}{
+		{"prune stale commitments", k.PruneCommitments},
+		{"settle auctions", k.SettleAuctions},
+		{"prune ended releases", k.PruneReleases},
+		{"prune expired transfer offers", k.PruneTransferOffers},
+	}
+	for _, s := range sweeps {
+		if budget == 0 {
+			return nil
+		}
+		processed, err := s.sweep(ctx, budget)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to %s", s.name)
+		}
+		budget -= processed
+	}
+	return nil
+}
+
+// sweepItem processes one item of an EndBlocker sweep in a cache context, committing its writes
+// and events only if it succeeds, and reports whether it did. A failure is logged and discarded;
+// the caller sets the item aside so it is retried in a later block.
+func (k Keeper) sweepItem(ctx sdk.Context, sweep, item string, process func(ctx sdk.Context) error) bool {
+	cacheCtx, write := ctx.CacheContext()
+	if err := process(cacheCtx); err != nil {
+		k.Logger(ctx).Error("Failed to process EndBlocker item; retrying in a later block", "sweep", sweep, "item", item, "error", err)
+		return false
+	}
+	write()
+	return true
+}
+
+// setAsideDomain takes a domain whose lifecycle failed to advance out of the expiration queue until
+// types.SweepRetryBlocks blocks later, so it cannot keep the domains due after it from advancing.
+func (k Keeper) setAsideDomain(ctx sdk.Context, domain types.Domain) error {
+	if err := k.DomainExpirationQueue.Remove(ctx, collections.Join(domain.NextDeadline(), domain.Id)); err != nil {
+		return err
+	}
+	return k.DomainRetryQueue.Set(ctx, collections.Join(ctx.BlockHeight()+types.SweepRetryBlocks, domain.Id))
+}
+
+// requeueDomains puts the domains set aside until the current height back into the expiration
+// queue, at the key of their current deadline. Domains removed in the meantime are dropped.
+func (k Keeper) requeueDomains(ctx sdk.Context) error {
+	var keys []collections.Pair[int64, uint64]
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndExclusive(collections.PairPrefix[int64, uint64](ctx.BlockHeight() + 1))
+	err := k.DomainRetryQueue.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.DomainRetryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		domain, err := k.Domain.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := k.DomainExpirationQueue.Set(ctx, collections.Join(domain.NextDeadline(), domain.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestEndBlockerReclaimsExpiredDomains(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "old.web3", Owner: creator, NsRecords: testNSRecords("old.web3")})
+	require.NoError(t, err)
+	oldID := resp.Id
+
+	// Create a second domain later so it expires after the first one.
+	later := ctx.WithBlockTime(now.Add(30 * 24 * time.Hour))
+	resp, err = srv.CreateDomain(later, &types.MsgCreateDomain{Creator: creator, Name: "new.web3", Owner: creator, NsRecords: testNSRecords("new.web3")})
+	require.NoError(t, err)
+	newID := resp.Id
+
+	// Nothing is swept before the expiration.
+	require.NoError(t, f.keeper.EndBlocker(later))
+	_, err = f.keeper.Domain.Get(ctx, oldID)
+	require.NoError(t, err)
+
+	// One year and a day later only the first domain has expired and enters its grace period.
+	grace := ctx.WithBlockTime(now.AddDate(1, 0, 1))
+	require.NoError(t, f.keeper.EndBlocker(grace))
+	domain, err := f.keeper.Domain.Get(grace, oldID)
+	require.NoError(t, err)
+	require.Equal(t, types.DomainStatus_DOMAIN_STATUS_GRACE, domain.Status)
+
+	// Once grace, redemption and pending-delete have all passed, the first domain is released.
+	sweep := ctx.WithBlockTime(now.AddDate(1, 0, 70)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.EndBlocker(sweep))
+
+	has, err := f.keeper.Domain.Has(sweep, oldID)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.DomainName.Has(sweep, "old.web3")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	has, err = f.keeper.Domain.Has(sweep, newID)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	var expireEvents int
+	for _, ev := range sweep.EventManager().Events() {
+		if ev.Type == types.EventTypeExpireDomain {
+			expireEvents++
+		}
+	}
+	require.Equal(t, 1, expireEvents)
+
+	// The reclaimed name can be registered again.
+	_, err = srv.CreateDomain(sweep, &types.MsgCreateDomain{Creator: creator, Name: "old.web3", Owner: creator, NsRecords: testNSRecords("old.web3")})
+	require.NoError(t, err)
+}
+
+func TestEndBlockerRespectsPerBlockCap(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MaxExpirationsPerBlock = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	for i := 0; i < 5; i++ {
+		name := fmt.Sprintf("capped%d.web3", i)
+		_, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: name, Owner: creator, NsRecords: testNSRecords(name)})
+		require.NoError(t, err)
+	}
+
+	countDomains := func() int {
+		var n int
+		require.NoError(t, f.keeper.Domain.Walk(ctx, nil, func(uint64, types.Domain) (bool, error) {
+			n++
+			return false, nil
+		}))
+		return n
+	}
+
+	sweep := ctx.WithBlockTime(now.AddDate(2, 0, 0))
+	require.NoError(t, f.keeper.EndBlocker(sweep))
+	require.Equal(t, 3, countDomains())
+	require.NoError(t, f.keeper.EndBlocker(sweep))
+	require.Equal(t, 1, countDomains())
+	require.NoError(t, f.keeper.EndBlocker(sweep))
+	require.Equal(t, 0, countDomains())
+}
+
+func TestEndBlockerSkipsFailingItems(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommitMaxBlocks = 10
+	params.CommitForfeitBps = 2500
+	params.CommitDeposit = sdk.NewCoins(sdk.NewInt64Coin("udns", 1000))
+	params.MaxExpirationsPerBlock = 1
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("committer___________________"))
+	require.NoError(t, err)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1)
+
+	// A commitment whose deposit cannot be refunded fails to be pruned.
+	broken := types.Commitment{Hash: types.CommitmentHash("broken.web3", "nobody", "nobody", "salt"), Creator: "nobody", Deposit: params.CommitDeposit, Height: 1}
+	require.NoError(t, f.keeper.SetCommitment(ctx, broken))
+	hash := types.CommitmentHash("fine.web3", creator, creator, "salt")
+	_, err = srv.CommitDomain(ctx.WithBlockHeight(2), &types.MsgCommitDomain{Creator: creator, Hash: hash})
+	require.NoError(t, err)
+
+	// The failure halts neither the block nor the sweep: the broken commitment is set aside with its
+	// partial writes discarded, and the one queued behind it is pruned in the next block.
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(20)))
+	require.True(t, f.bankKeeper.toAccounts.IsZero())
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(21)))
+	_, found, err := f.keeper.GetCommitment(ctx, creator, hash)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udns", 750)), f.bankKeeper.toAccounts)
+
+	setAside := func(height int64) {
+		t.Helper()
+		_, found, err := f.keeper.GetCommitment(ctx, broken.Creator, broken.Hash)
+		require.NoError(t, err)
+		require.True(t, found)
+		queued, err := f.keeper.CommitmentQueue.Has(ctx, collections.Join3(broken.Height, broken.Creator, broken.Hash))
+		require.NoError(t, err)
+		require.False(t, queued)
+		retry, err := f.keeper.CommitmentRetryQueue.Has(ctx, collections.Join3(height, broken.Creator, broken.Hash))
+		require.NoError(t, err)
+		require.True(t, retry)
+	}
+	setAside(20 + types.SweepRetryBlocks)
+
+	// It is retried once set aside long enough, and set aside again as it still fails.
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(20+types.SweepRetryBlocks)))
+	setAside(20 + 2*types.SweepRetryBlocks)
+}
+
+func TestEndBlockerSharesBudgetAcrossSweeps(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.MaxExpirationsPerBlock = 2
+	params.CommitMaxBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now).WithBlockHeight(1)
+
+	resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "budget.web3", Owner: creator, NsRecords: testNSRecords("budget.web3")})
+	require.NoError(t, err)
+	hashes := make([][]byte, 3)
+	for i := range hashes {
+		hashes[i] = types.CommitmentHash(fmt.Sprintf("stale%d.web3", i), creator, creator, "salt")
+		_, err = srv.CommitDomain(ctx, &types.MsgCommitDomain{Creator: creator, Hash: hashes[i]})
+		require.NoError(t, err)
+	}
+	pending := func() int {
+		var n int
+		for _, hash := range hashes {
+			_, found, err := f.keeper.GetCommitment(ctx, creator, hash)
+			require.NoError(t, err)
+			if found {
+				n++
+			}
+		}
+		return n
+	}
+
+	// The expiring domain takes one of the two items of the block, leaving one for commitments.
+	sweep := ctx.WithBlockTime(now.AddDate(1, 0, 1)).WithBlockHeight(20)
+	require.NoError(t, f.keeper.EndBlocker(sweep))
+	domain, err := f.keeper.Domain.Get(sweep, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DomainStatus_DOMAIN_STATUS_GRACE, domain.Status)
+	require.Equal(t, 2, pending())
+
+	require.NoError(t, f.keeper.EndBlocker(sweep.WithBlockHeight(21)))
+	require.Equal(t, 0, pending())
+}
+
+func TestEndBlockerSetsAsideFailingDomains(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.MaxExpirationsPerBlock = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now).WithBlockHeight(1)
+
+	// Two domains whose lifecycle cannot advance are due ahead of a healthy one.
+	ids := make([]uint64, 3)
+	for i := range ids {
+		name := fmt.Sprintf("due%d.web3", i)
+		resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: name, Owner: creator, NsRecords: testNSRecords(name)})
+		require.NoError(t, err)
+		ids[i] = resp.Id
+	}
+	for _, id := range ids[:2] {
+		domain, err := f.keeper.Domain.Get(ctx, id)
+		require.NoError(t, err)
+		domain.Creator = "nobody"
+		require.NoError(t, f.keeper.Domain.Set(ctx, id, domain))
+	}
+	status := func(ctx sdk.Context, id uint64) types.DomainStatus {
+		domain, err := f.keeper.Domain.Get(ctx, id)
+		require.NoError(t, err)
+		return domain.Status
+	}
+
+	// The failing domains use up the first block and are set aside, so the healthy one advances in
+	// the next.
+	sweep := ctx.WithBlockTime(now.AddDate(1, 0, 1)).WithBlockHeight(10)
+	require.NoError(t, f.keeper.EndBlocker(sweep))
+	require.Equal(t, types.DomainStatus_DOMAIN_STATUS_ACTIVE, status(sweep, ids[2]))
+	require.NoError(t, f.keeper.EndBlocker(sweep.WithBlockHeight(11)))
+	require.Equal(t, types.DomainStatus_DOMAIN_STATUS_GRACE, status(sweep, ids[2]))
+	retry := sweep.BlockHeight() + types.SweepRetryBlocks
+	for _, id := range ids[:2] {
+		require.Equal(t, types.DomainStatus_DOMAIN_STATUS_ACTIVE, status(sweep, id))
+		has, err := f.keeper.DomainRetryQueue.Has(sweep, collections.Join(retry, id))
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+
+	// They are retried once set aside long enough: the one fixed in the meantime advances, the
+	// other is set aside again.
+	domain, err := f.keeper.Domain.Get(ctx, ids[0])
+	require.NoError(t, err)
+	domain.Creator = creator
+	require.NoError(t, f.keeper.Domain.Set(ctx, ids[0], domain))
+	require.NoError(t, f.keeper.EndBlocker(sweep.WithBlockHeight(retry)))
+	require.Equal(t, types.DomainStatus_DOMAIN_STATUS_GRACE, status(sweep, ids[0]))
+	require.Equal(t, types.DomainStatus_DOMAIN_STATUS_ACTIVE, status(sweep, ids[1]))
+	has, err := f.keeper.DomainRetryQueue.Has(sweep, collections.Join(retry+types.SweepRetryBlocks, ids[1]))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestEndBlockerSetsAsideFailingAuctions(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.MaxExpirationsPerBlock = 1
+	params.AuctionForfeitBps = 2500
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	opener := bidders(t, f, 1)[0]
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0)).WithBlockHeight(10)
+
+	// An auction whose unrevealed bid cannot be refunded fails to settle, ahead of a healthy one.
+	broken := types.Auction{Name: "broken.web3", Creator: opener, BidEnd: 50, RevealEnd: 100, MinPrice: udns(10)}
+	require.NoError(t, f.keeper.SetAuction(ctx, broken))
+	require.NoError(t, f.keeper.AuctionBids.Set(ctx, collections.Join(broken.Name, "nobody"), types.AuctionBid{Name: broken.Name, Bidder: "nobody", Deposit: sdk.NewCoins(udns(100))}))
+	fine := types.Auction{Name: "fine.web3", Creator: opener, BidEnd: 50, RevealEnd: 101, MinPrice: udns(10)}
+	require.NoError(t, f.keeper.SetAuction(ctx, fine))
+	open := func(name string) bool {
+		_, found, err := f.keeper.GetAuction(ctx, name)
+		require.NoError(t, err)
+		return found
+	}
+
+	// The broken auction uses up the first block and is set aside, so the healthy one settles in the
+	// next.
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.True(t, open(fine.Name))
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(11)))
+	require.False(t, open(fine.Name))
+	require.True(t, open(broken.Name))
+	retry, err := f.keeper.AuctionRetryQueue.Has(ctx, collections.Join(10+types.SweepRetryBlocks, broken.Name))
+	require.NoError(t, err)
+	require.True(t, retry)
+
+	// Once set aside long enough it is retried, and set aside again as it still fails.
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(10+types.SweepRetryBlocks)))
+	require.True(t, open(broken.Name))
+	retry, err = f.keeper.AuctionRetryQueue.Has(ctx, collections.Join(10+2*types.SweepRetryBlocks, broken.Name))
+	require.NoError(t, err)
+	require.True(t, retry)
+}
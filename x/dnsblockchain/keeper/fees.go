@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// ChargeFee collects fee from payer into the module account and distributes it according to
+// Params.FeeSplit: the burned share is burned, the fee collector share is sent to the fee collector
+// for x/distribution to pay out to validators, and the community pool share funds the community
+// pool. A collected event is emitted, followed by one event per non-empty portion, all tagged with
+// feeType. The fee is added to the statistics of the domain name's TLD. A zero fee is a no-op.
+func (k Keeper) ChargeFee(ctx context.Context, payer string, domainName string, fee sdk.Coins, feeType string) error {
+	if fee.IsZero() {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	payerAddr, err := k.addressCodec.StringToBytes(payer)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid fee payer address %s", payer)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(payerAddr), types.ModuleName, fee); err != nil {
+		k.Logger(sdkCtx).Error("Failed to send domain fee from payer to module", "payer", payer, "fee", fee.String(), "error", err)
+		return errorsmod.Wrapf(err, "failed to send domain fee from %s to module account", payer)
+	}
+	return k.distributeFee(ctx, payer, domainName, fee, feeType)
+}
+
+// distributeFee splits a fee already held by the module account as ChargeFee does, for fees taken
+// out of escrowed funds such as forfeited deposits.
+func (k Keeper) distributeFee(ctx context.Context, payer string, domainName string, fee sdk.Coins, feeType string) error {
+	if fee.IsZero() {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDomainFeeCollected,
+			sdk.NewAttribute(types.AttributeKeyFeeCollector, payer),
+			sdk.NewAttribute(types.AttributeKeyDomainName, domainName),
+			sdk.NewAttribute(types.AttributeKeyFeeType, feeType),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+		),
+	}
+
+	burn, feeCollector, communityPool := params.FeeSplit.Split(fee)
+
+	if !burn.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burn); err != nil {
+			k.Logger(sdkCtx).Error("CRITICAL: Failed to burn domain fee from module account", "fee", burn.String(), "error", err)
+			return errorsmod.Wrap(err, "failed to burn domain fee from module account")
+		}
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDomainFeeBurned,
+			sdk.NewAttribute(types.AttributeKeyBurnerModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyFeeType, feeType),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, burn.String()),
+		))
+	}
+
+	if !feeCollector.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, feeCollector); err != nil {
+			k.Logger(sdkCtx).Error("CRITICAL: Failed to send domain fee to fee collector", "fee", feeCollector.String(), "error", err)
+			return errorsmod.Wrap(err, "failed to send domain fee to fee collector")
+		}
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDomainFeeToFeeCollector,
+			sdk.NewAttribute(types.AttributeKeyRecipient, authtypes.FeeCollectorName),
+			sdk.NewAttribute(types.AttributeKeyFeeType, feeType),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, feeCollector.String()),
+		))
+	}
+
+	if !communityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+			k.Logger(sdkCtx).Error("CRITICAL: Failed to fund community pool with domain fee", "fee", communityPool.String(), "error", err)
+			return errorsmod.Wrap(err, "failed to fund community pool with domain fee")
+		}
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDomainFeeToCommunityPool,
+			sdk.NewAttribute(types.AttributeKeyRecipient, "community_pool"),
+			sdk.NewAttribute(types.AttributeKeyFeeType, feeType),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, communityPool.String()),
+		))
+	}
+
+	if tld := types.ExtractTLD(domainName); tld != "" {
+		if err := k.addTLDFees(ctx, tld, fee); err != nil {
+			return errorsmod.Wrapf(err, "failed to record domain fee for TLD %s", tld)
+		}
+	}
+
+	k.Logger(sdkCtx).Info("Domain fee collected", "type", feeType, "payer", payer, "amount", fee.String(), "burned", burn.String(), "fee_collector", feeCollector.String(), "community_pool", communityPool.String())
+	sdkCtx.EventManager().EmitEvents(events)
+	return nil
+}
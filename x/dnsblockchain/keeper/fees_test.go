@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestChargeFeeSplitsFee(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	payer, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FeeSplit = types.FeeSplit{BurnBps: 2000, FeeCollectorBps: 5000, CommunityPoolBps: 3000}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("udns", 1000))
+	require.NoError(t, f.keeper.ChargeFee(ctx, payer, "fees.web3", fee, types.FeeTypeRegistration))
+
+	require.Equal(t, fee, f.bankKeeper.sentToModule)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udns", 200)), f.bankKeeper.burned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udns", 500)), f.bankKeeper.toFeeCollector)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udns", 300)), f.distrKeeper.communityPool)
+
+	emitted := map[string]bool{}
+	for _, ev := range ctx.EventManager().Events() {
+		emitted[ev.Type] = true
+	}
+	require.True(t, emitted[types.EventTypeDomainFeeCollected])
+	require.True(t, emitted[types.EventTypeDomainFeeBurned])
+	require.True(t, emitted[types.EventTypeDomainFeeToFeeCollector])
+	require.True(t, emitted[types.EventTypeDomainFeeToCommunityPool])
+}
+
+func TestChargeFeeEmptySplitBurns(t *testing.T) {
+	f := initFixture(t)
+
+	payer, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FeeSplit = types.FeeSplit{}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("udns", 1000))
+	require.NoError(t, f.keeper.ChargeFee(f.ctx, payer, "fees.web3", fee, types.FeeTypeRegistration))
+	require.Equal(t, fee, f.bankKeeper.burned)
+	require.True(t, f.bankKeeper.toFeeCollector.IsZero())
+	require.True(t, f.distrKeeper.communityPool.IsZero())
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"strings" // Añadido para normalizar nombres de dominio
 
+	"cosmossdk.io/collections"
+
 	"dnsblockchain/x/dnsblockchain/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types" // Para sdk.UnwrapSDKContext
@@ -17,6 +19,16 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.Domain.Set(ctx, elem.Id, elem); err != nil {
 			return err
 		}
+		if err := k.indexDomain(ctx, elem); err != nil {
+			return err
+		}
+		if err := k.adjustTLDDomainCount(ctx, types.ExtractTLD(elem.Name), 1); err != nil {
+			return err
+		}
+		// The nft module's genesis runs first, so tokens it already imported are kept as they are.
+		if err := k.syncDomainNFT(ctx, elem); err != nil {
+			return err
+		}
 		// Poblar el índice de nombres
 		normalizedName := strings.ToLower(strings.Trim(elem.Name, "."))
 		if normalizedName == "" && elem.Name != "" { // Evitar nombres vacíos si el original no lo era
@@ -34,6 +46,56 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 
+	// Domain counts were rebuilt above; only the fee totals are carried over.
+	for _, elem := range genState.TldStats {
+		if err := k.addTLDFees(ctx, elem.Tld, elem.TotalFees); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.Commitments {
+		if err := k.SetCommitment(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.Auctions {
+		if err := k.SetAuction(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.AuctionBids {
+		if err := k.AuctionBids.Set(ctx, collections.Join(elem.Name, elem.Bidder), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TldLaunches {
+		if err := k.TLDLaunches.Set(ctx, elem.Tld, elem.AuctionOnlyUntil); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.Releases {
+		if err := k.SetRelease(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TransferOffers {
+		if err := k.SetTransferOffer(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.Listings {
+		if err := k.Listings.Set(ctx, elem.DomainId, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.MarketOffers {
+		if err := k.MarketOffers.Set(ctx, collections.Join(elem.DomainId, elem.Buyer), elem); err != nil {
+			return err
+		}
+	}
+
 	if err := k.DomainSeq.Set(ctx, genState.DomainCount); err != nil {
 		return err
 	}
@@ -45,6 +107,9 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 				k.Logger(sdkCtx).Error("Genesis: failed to set permitted TLD", "tld", normalizedTLD, "error", err)
 				return err
 			}
+			if err := k.ensureNFTClass(ctx, normalizedTLD); err != nil {
+				return err
+			}
 		}
 	}
 	return k.Params.Set(ctx, genState.Params)
@@ -79,6 +144,69 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
+	err = k.TLDStats.Walk(ctx, nil, func(_ string, stats types.TLDStats) (bool, error) {
+		genesis.TldStats = append(genesis.TldStats, stats)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Commitments.Walk(ctx, nil, func(_ collections.Pair[string, []byte], commitment types.Commitment) (bool, error) {
+		genesis.Commitments = append(genesis.Commitments, commitment)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Auctions.Walk(ctx, nil, func(_ string, auction types.Auction) (bool, error) {
+		genesis.Auctions = append(genesis.Auctions, auction)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.AuctionBids.Walk(ctx, nil, func(_ collections.Pair[string, string], bid types.AuctionBid) (bool, error) {
+		genesis.AuctionBids = append(genesis.AuctionBids, bid)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.TLDLaunches.Walk(ctx, nil, func(tld string, until uint64) (bool, error) {
+		genesis.TldLaunches = append(genesis.TldLaunches, types.TLDLaunch{Tld: tld, AuctionOnlyUntil: until})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Releases.Walk(ctx, nil, func(_ string, release types.NameRelease) (bool, error) {
+		genesis.Releases = append(genesis.Releases, release)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.TransferOffers.Walk(ctx, nil, func(_ uint64, offer types.TransferOffer) (bool, error) {
+		genesis.TransferOffers = append(genesis.TransferOffers, offer)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Listings.Walk(ctx, nil, func(_ uint64, listing types.Listing) (bool, error) {
+		genesis.Listings = append(genesis.Listings, listing)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.MarketOffers.Walk(ctx, nil, func(_ collections.Pair[uint64, string], offer types.MarketOffer) (bool, error) {
+		genesis.MarketOffers = append(genesis.MarketOffers, offer)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	// El índice DomainName no necesita ser exportado explícitamente si se reconstruye
 	// durante InitGenesis a partir de DomainList.
 
@@ -5,13 +5,15 @@ import (
 
 	"dnsblockchain/x/dnsblockchain/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
 	genesisState := types.GenesisState{
 		Params:      types.DefaultParams(),
-		DomainList:  []types.Domain{{Id: 0}, {Id: 1}},
+		DomainList:  []types.Domain{{Id: 0, Name: "a.web3", Owner: owner, Creator: owner}, {Id: 1, Name: "b.web3", Owner: owner, Creator: owner}},
 		DomainCount: 2,
 	}
 	f := initFixture(t)
@@ -26,3 +28,24 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.DomainCount, got.DomainCount)
 
 }
+
+func TestGenesisRebuildsTLDStats(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("udns", 42))
+	alice, bob := sdk.AccAddress("alice_______________").String(), sdk.AccAddress("bob_________________").String()
+	genesisState := types.GenesisState{
+		Params:      types.DefaultParams(),
+		DomainList:  []types.Domain{{Id: 0, Name: "a.web3", Owner: alice, Creator: alice}, {Id: 1, Name: "b.web3", Owner: bob, Creator: bob}},
+		DomainCount: 2,
+		TldStats:    []types.TLDStats{{Tld: "web3", DomainCount: 7, TotalFees: fees}},
+	}
+	f := initFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+
+	stats, err := f.keeper.GetTLDStats(f.ctx, "web3")
+	require.NoError(t, err)
+	require.Equal(t, types.TLDStats{Tld: "web3", DomainCount: 2, TotalFees: fees}, stats)
+
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.TLDStats{stats}, got.TldStats)
+}
@@ -22,7 +22,9 @@ type Keeper struct {
 	addressCodec address.Codec
 	authority    []byte
 
-	bankKeeper types.BankKeeper // <--- AÑADIR ESTA LÍNEA
+	bankKeeper  types.BankKeeper // <--- AÑADIR ESTA LÍNEA
+	distrKeeper types.DistributionKeeper
+	nftKeeper   types.NFTKeeper
 
 	Schema        collections.Schema
 	Params        collections.Item[types.Params]
@@ -30,6 +32,55 @@ type Keeper struct {
 	Domain        collections.Map[uint64, types.Domain]
 	DomainName    collections.Map[string, uint64]
 	PermittedTLDs collections.KeySet[string]
+	// DomainExpirationQueue orders domains by (expiration, id) so expiry processing
+	// only range-scans the relevant window instead of walking the whole registry.
+	DomainExpirationQueue collections.KeySet[collections.Pair[uint64, uint64]]
+	// DomainRetryQueue holds, by the height they are queued again at, the domains set aside after
+	// their lifecycle failed to advance.
+	DomainRetryQueue collections.KeySet[collections.Pair[int64, uint64]]
+	// DomainsByOwner indexes domain ids by owner address.
+	DomainsByOwner collections.KeySet[collections.Pair[string, uint64]]
+	// DomainsByTLD indexes domain ids by TLD.
+	DomainsByTLD collections.KeySet[collections.Pair[string, uint64]]
+	// TLDExpirations orders each TLD's domains by expiration for the TLD statistics.
+	TLDExpirations collections.KeySet[collections.Triple[string, uint64, uint64]]
+	// TLDStats holds the running statistics of each TLD.
+	TLDStats collections.Map[string, types.TLDStats]
+	// Subdomains indexes subdomain ids by parent name.
+	Subdomains collections.KeySet[collections.Pair[string, uint64]]
+	// Commitments holds the pending registration commitments by (creator, hash), so copying a
+	// pending commitment cannot keep its committer from making it.
+	Commitments collections.Map[collections.Pair[string, []byte], types.Commitment]
+	// CommitmentQueue orders commitments by height so stale ones are pruned with a range scan.
+	CommitmentQueue collections.KeySet[collections.Triple[int64, string, []byte]]
+	// CommitmentRetryQueue holds, by the height they are queued again at, the stale commitments set
+	// aside after they failed to be pruned.
+	CommitmentRetryQueue collections.KeySet[collections.Triple[int64, string, []byte]]
+	// Auctions holds the open auctions by name.
+	Auctions collections.Map[string, types.Auction]
+	// AuctionQueue orders auctions by the end of their reveal period for settlement.
+	AuctionQueue collections.KeySet[collections.Pair[uint64, string]]
+	// AuctionRetryQueue holds, by the height they are queued again at, the auctions set aside after
+	// they failed to settle.
+	AuctionRetryQueue collections.KeySet[collections.Pair[int64, string]]
+	// AuctionBids holds the bids of each auction by (name, bidder).
+	AuctionBids collections.Map[collections.Pair[string, string], types.AuctionBid]
+	// TLDLaunches holds, per TLD, the time until which its names can only be won at auction.
+	TLDLaunches collections.Map[string, uint64]
+	// Releases holds the lapsed names in their descending-price release window.
+	Releases collections.Map[string, types.NameRelease]
+	// ReleaseQueue orders releases by the end of their window for pruning.
+	ReleaseQueue collections.KeySet[collections.Pair[uint64, string]]
+	// TransferOffers holds the pending transfer offer of each domain by domain id.
+	TransferOffers collections.Map[uint64, types.TransferOffer]
+	// TransferOffersByTo indexes pending transfer offers by recipient.
+	TransferOffersByTo collections.KeySet[collections.Pair[string, uint64]]
+	// TransferOfferQueue orders transfer offers by expiry for pruning.
+	TransferOfferQueue collections.KeySet[collections.Pair[uint64, uint64]]
+	// Listings holds the marketplace listing of each domain by domain id.
+	Listings collections.Map[uint64, types.Listing]
+	// MarketOffers holds the escrowed marketplace offers by (domain id, buyer).
+	MarketOffers collections.Map[collections.Pair[uint64, string], types.MarketOffer]
 }
 
 func NewKeeper(
@@ -38,6 +89,8 @@ func NewKeeper(
 	addressCodec address.Codec,
 	authority []byte,
 	bk types.BankKeeper, // <--- AÑADIR bk COMO PARÁMETRO
+	dk types.DistributionKeeper,
+	nk types.NFTKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -51,12 +104,68 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 		bankKeeper:   bk, // <--- ASIGNAR bk
+		distrKeeper:  dk,
+		nftKeeper:    nk,
 
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Domain:        collections.NewMap(sb, types.DomainKey, "domain_by_id", collections.Uint64Key, codec.CollValue[types.Domain](cdc)),
 		DomainName:    collections.NewMap(sb, types.DomainNameKey, "domain_by_name", collections.StringKey, collections.Uint64Value),
 		DomainSeq:     collections.NewSequence(sb, types.DomainCountKey, "domain_sequence"),
 		PermittedTLDs: collections.NewKeySet(sb, types.PermittedTLDsKey, "permitted_tlds", collections.StringKey),
+		DomainExpirationQueue: collections.NewKeySet(sb, types.DomainExpirationQueueKey, "domain_expiration_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		DomainRetryQueue: collections.NewKeySet(sb, types.DomainRetryQueueKey, "domain_retry_queue",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		DomainsByOwner: collections.NewKeySet(sb, types.DomainsByOwnerKey, "domains_by_owner",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		DomainsByTLD: collections.NewKeySet(sb, types.DomainsByTLDKey, "domains_by_tld",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		TLDExpirations: collections.NewKeySet(sb, types.TLDExpirationsKey, "tld_expirations",
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key),
+		),
+		TLDStats: collections.NewMap(sb, types.TLDStatsKey, "tld_stats", collections.StringKey, codec.CollValue[types.TLDStats](cdc)),
+		Subdomains: collections.NewKeySet(sb, types.SubdomainsKey, "subdomains",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		Commitments: collections.NewMap(sb, types.CommitmentsKey, "commitments",
+			collections.PairKeyCodec(collections.StringKey, collections.BytesKey), codec.CollValue[types.Commitment](cdc),
+		),
+		CommitmentQueue: collections.NewKeySet(sb, types.CommitmentQueueKey, "commitment_queue",
+			collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.BytesKey),
+		),
+		CommitmentRetryQueue: collections.NewKeySet(sb, types.CommitmentRetryQueueKey, "commitment_retry_queue",
+			collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.BytesKey),
+		),
+		Auctions: collections.NewMap(sb, types.AuctionsKey, "auctions", collections.StringKey, codec.CollValue[types.Auction](cdc)),
+		AuctionQueue: collections.NewKeySet(sb, types.AuctionQueueKey, "auction_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		AuctionRetryQueue: collections.NewKeySet(sb, types.AuctionRetryQueueKey, "auction_retry_queue",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+		AuctionBids: collections.NewMap(sb, types.AuctionBidsKey, "auction_bids",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.AuctionBid](cdc),
+		),
+		TLDLaunches: collections.NewMap(sb, types.TLDLaunchesKey, "tld_launches", collections.StringKey, collections.Uint64Value),
+		Releases:    collections.NewMap(sb, types.ReleasesKey, "releases", collections.StringKey, codec.CollValue[types.NameRelease](cdc)),
+		ReleaseQueue: collections.NewKeySet(sb, types.ReleaseQueueKey, "release_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		TransferOffers: collections.NewMap(sb, types.TransferOffersKey, "transfer_offers", collections.Uint64Key, codec.CollValue[types.TransferOffer](cdc)),
+		TransferOffersByTo: collections.NewKeySet(sb, types.TransferOffersByToKey, "transfer_offers_by_to",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		TransferOfferQueue: collections.NewKeySet(sb, types.TransferOfferQueueKey, "transfer_offer_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		Listings: collections.NewMap(sb, types.ListingsKey, "listings", collections.Uint64Key, codec.CollValue[types.Listing](cdc)),
+		MarketOffers: collections.NewMap(sb, types.MarketOffersKey, "market_offers",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.MarketOffer](cdc),
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -103,7 +212,23 @@ func (k Keeper) AddPermittedTLD(ctx context.Context, tld string) error {
 	}
 
 	k.Logger(sdkCtx).Info("Adding permitted TLD to store", "tld", normalizedTLD)
-	return k.PermittedTLDs.Set(sdkCtx, normalizedTLD)
+	if err := k.PermittedTLDs.Set(sdkCtx, normalizedTLD); err != nil {
+		return err
+	}
+	if err := k.ensureNFTClass(ctx, normalizedTLD); err != nil {
+		return err
+	}
+
+	// Names under a new TLD are auctioned during its launch phase instead of going to whoever
+	// registers them first.
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	if params.TldLaunchPeriod == 0 {
+		return nil
+	}
+	return k.TLDLaunches.Set(ctx, normalizedTLD, uint64(sdkCtx.BlockTime().Unix())+params.TldLaunchPeriod)
 }
 
 // IsTLDGloballyReserved checks if a TLD is in the hardcoded ICANN deny list.
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -22,6 +23,113 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	bankKeeper   *mockBankKeeper
+	distrKeeper  *mockDistrKeeper
+	nftKeeper    *mockNFTKeeper
+}
+
+// mockBankKeeper records the coins moved through it without enforcing balances.
+type mockBankKeeper struct {
+	sentToModule   sdk.Coins
+	burned         sdk.Coins
+	toFeeCollector sdk.Coins
+	toAccounts     sdk.Coins
+	// paidTo holds the coins sent from the module to each account, by address.
+	paidTo map[string]sdk.Coins
+}
+
+func (m *mockBankKeeper) SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+	m.sentToModule = m.sentToModule.Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, _ string, amt sdk.Coins) error {
+	m.burned = m.burned.Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, _, _ string, amt sdk.Coins) error {
+	m.toFeeCollector = m.toFeeCollector.Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, _ string, addr sdk.AccAddress, amt sdk.Coins) error {
+	m.toAccounts = m.toAccounts.Add(amt...)
+	if m.paidTo == nil {
+		m.paidTo = make(map[string]sdk.Coins)
+	}
+	m.paidTo[addr.String()] = m.paidTo[addr.String()].Add(amt...)
+	return nil
+}
+
+// mockDistrKeeper records the coins sent to the community pool.
+type mockDistrKeeper struct {
+	communityPool sdk.Coins
+}
+
+func (m *mockDistrKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, _ sdk.AccAddress) error {
+	m.communityPool = m.communityPool.Add(amount...)
+	return nil
+}
+
+// mockNFTKeeper holds the NFT classes and the owner of each NFT, by class and id.
+type mockNFTKeeper struct {
+	classes map[string]nft.Class
+	owners  map[string]map[string]sdk.AccAddress
+}
+
+func (m *mockNFTKeeper) SaveClass(_ context.Context, class nft.Class) error {
+	if _, ok := m.classes[class.Id]; ok {
+		return nft.ErrClassExists
+	}
+	m.classes[class.Id] = class
+	m.owners[class.Id] = make(map[string]sdk.AccAddress)
+	return nil
+}
+
+func (m *mockNFTKeeper) HasClass(_ context.Context, classID string) bool {
+	_, ok := m.classes[classID]
+	return ok
+}
+
+func (m *mockNFTKeeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if !m.HasClass(ctx, token.ClassId) {
+		return nft.ErrClassNotExists
+	}
+	if m.HasNFT(ctx, token.ClassId, token.Id) {
+		return nft.ErrNFTExists
+	}
+	m.owners[token.ClassId][token.Id] = receiver
+	return nil
+}
+
+func (m *mockNFTKeeper) Burn(ctx context.Context, classID, nftID string) error {
+	if !m.HasNFT(ctx, classID, nftID) {
+		return nft.ErrNFTNotExists
+	}
+	delete(m.owners[classID], nftID)
+	return nil
+}
+
+func (m *mockNFTKeeper) Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	if !m.HasNFT(ctx, classID, nftID) {
+		return nft.ErrNFTNotExists
+	}
+	m.owners[classID][nftID] = receiver
+	return nil
+}
+
+func (m *mockNFTKeeper) GetOwner(_ context.Context, classID, nftID string) sdk.AccAddress {
+	return m.owners[classID][nftID]
+}
+
+func (m *mockNFTKeeper) HasNFT(_ context.Context, classID, nftID string) bool {
+	_, ok := m.owners[classID][nftID]
+	return ok
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,22 +143,34 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := &mockBankKeeper{}
+	distrKeeper := &mockDistrKeeper{}
+	nftKeeper := &mockNFTKeeper{classes: make(map[string]nft.Class), owners: make(map[string]map[string]sdk.AccAddress)}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
+		distrKeeper,
+		nftKeeper,
 	)
 
 	// Initialize params
 	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
+	if err := k.PermittedTLDs.Set(ctx, "web3"); err != nil {
+		t.Fatalf("failed to set permitted TLD: %v", err)
+	}
 
 	return &fixture{
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		bankKeeper:   bankKeeper,
+		distrKeeper:  distrKeeper,
+		nftKeeper:    nftKeeper,
 	}
 }
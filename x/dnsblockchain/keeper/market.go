@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// RemoveListing deletes the marketplace listing of a domain, if any.
+func (k Keeper) RemoveListing(ctx context.Context, id uint64) error {
+	has, err := k.Listings.Has(ctx, id)
+	if err != nil || !has {
+		return err
+	}
+	return k.Listings.Remove(ctx, id)
+}
+
+// GetListing returns the marketplace listing of a domain.
+func (k Keeper) GetListing(ctx context.Context, id uint64) (types.Listing, bool, error) {
+	listing, err := k.Listings.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Listing{}, false, nil
+	}
+	if err != nil {
+		return types.Listing{}, false, err
+	}
+	return listing, true, nil
+}
+
+// GetMarketOffer returns the marketplace offer of a buyer for a domain.
+func (k Keeper) GetMarketOffer(ctx context.Context, id uint64, buyer string) (types.MarketOffer, bool, error) {
+	offer, err := k.MarketOffers.Get(ctx, collections.Join(id, buyer))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.MarketOffer{}, false, nil
+	}
+	if err != nil {
+		return types.MarketOffer{}, false, err
+	}
+	return offer, true, nil
+}
+
+// withdrawMarketOffer deletes a marketplace offer and refunds its amount to the buyer.
+func (k Keeper) withdrawMarketOffer(ctx context.Context, offer types.MarketOffer) error {
+	if err := k.MarketOffers.Remove(ctx, collections.Join(offer.DomainId, offer.Buyer)); err != nil {
+		return err
+	}
+	return k.refundDeposit(ctx, offer.Buyer, sdk.NewCoins(offer.Amount))
+}
+
+// sellDomain completes a marketplace sale whose price the module already holds.
+// Params.MarketRoyaltyBps of the price goes to the domain's original creator unless they are the
+// seller, the rest to the seller; actor pays the transfer fee, and the buyer becomes the domain's
+// creator and owner. Any offer the buyer still had on the domain is refunded.
+func (k Keeper) sellDomain(ctx context.Context, domain types.Domain, seller, buyer string, price sdk.Coin, actor string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+
+	royalty, proceeds := sdk.NewCoin(price.Denom, math.ZeroInt()), price
+	if domain.OriginalCreator != "" && domain.OriginalCreator != seller {
+		royalty, proceeds = params.MarketRoyalty(price)
+	}
+	if err := k.refundDeposit(ctx, domain.OriginalCreator, sdk.NewCoins(royalty)); err != nil {
+		return errorsmod.Wrap(err, "failed to pay royalty")
+	}
+	if err := k.refundDeposit(ctx, seller, sdk.NewCoins(proceeds)); err != nil {
+		return errorsmod.Wrap(err, "failed to pay seller")
+	}
+	if err := k.ChargeFee(ctx, actor, domain.Name, params.DomainTransferFee, types.FeeTypeTransfer); err != nil {
+		return err
+	}
+
+	if offer, found, err := k.GetMarketOffer(ctx, domain.Id, buyer); err != nil {
+		return err
+	} else if found {
+		if err := k.withdrawMarketOffer(ctx, offer); err != nil {
+			return err
+		}
+	}
+	if err := k.moveDomain(ctx, domain, buyer, actor); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSellDomain,
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", domain.Id)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+		sdk.NewAttribute(types.AttributeKeySeller, seller),
+		sdk.NewAttribute(types.AttributeKeyBuyer, buyer),
+		sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+		sdk.NewAttribute(types.AttributeKeyRoyalty, royalty.String()),
+	))
+	return nil
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 fills the params added since v1, whose params only held the domain creation fee,
+// with their defaults, and backfills the expiration index for domains created before it existed.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.migrateParams1to2(ctx); err != nil {
+		return err
+	}
+	return m.keeper.Domain.Walk(ctx, nil, func(id uint64, domain types.Domain) (bool, error) {
+		return false, m.keeper.DomainExpirationQueue.Set(ctx, collections.Join(domain.NextDeadline(), id))
+	})
+}
+
+// migrateParams1to2 replaces the v1 params with the defaults, keeping the v1 domain creation fee.
+// Left at their zero values, the new params would skip the grace and redemption periods and burn
+// every fee.
+func (m Migrator) migrateParams1to2(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	old, err := m.keeper.Params.Get(ctx)
+	switch {
+	case err == nil:
+		params.DomainCreationFee = old.DomainCreationFee
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate2to3 backfills the owner index for domains created before it existed.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.Domain.Walk(ctx, nil, func(id uint64, domain types.Domain) (bool, error) {
+		return false, m.keeper.DomainsByOwner.Set(ctx, collections.Join(domain.Owner, id))
+	})
+}
+
+// Migrate3to4 backfills the TLD indexes and domain counts for domains created before they existed.
+// Fees charged before the upgrade are not known, so TLD fee totals start from zero.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return m.keeper.Domain.Walk(ctx, nil, func(id uint64, domain types.Domain) (bool, error) {
+		tld := types.ExtractTLD(domain.Name)
+		if err := m.keeper.DomainsByTLD.Set(ctx, collections.Join(tld, id)); err != nil {
+			return true, err
+		}
+		if err := m.keeper.TLDExpirations.Set(ctx, collections.Join3(tld, domain.Expiration, id)); err != nil {
+			return true, err
+		}
+		return false, m.keeper.adjustTLDDomainCount(ctx, tld, 1)
+	})
+}
+
+// Migrate4to5 records the creator of each domain as its original creator, the royalty recipient
+// of marketplace sales. Earlier transfers are not known, so the current creator stands in.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	var domains []types.Domain
+	err := m.keeper.Domain.Walk(ctx, nil, func(_ uint64, domain types.Domain) (bool, error) {
+		if domain.OriginalCreator == "" {
+			domains = append(domains, domain)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, domain := range domains {
+		domain.OriginalCreator = domain.Creator
+		if err := m.keeper.Domain.Set(ctx, domain.Id, domain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate5to6 represents the domains as NFTs: it creates the x/nft class of every permitted TLD
+// and mints each existing domain's NFT to its creator.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	err := m.keeper.PermittedTLDs.Walk(ctx, nil, func(tld string) (bool, error) {
+		return false, m.keeper.ensureNFTClass(ctx, tld)
+	})
+	if err != nil {
+		return err
+	}
+	return m.keeper.Domain.Walk(ctx, nil, func(_ uint64, domain types.Domain) (bool, error) {
+		return false, m.keeper.syncDomainNFT(ctx, domain)
+	})
+}
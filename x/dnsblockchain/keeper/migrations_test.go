@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestMigrate1to2BackfillsExpirationQueue(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Domains written directly, as a v1 store would hold them.
+	domains := []types.Domain{
+		{Id: 0, Name: "a.web3", Expiration: 300},
+		{Id: 1, Name: "b.web3", Expiration: 100},
+	}
+	for _, d := range domains {
+		require.NoError(t, f.keeper.Domain.Set(ctx, d.Id, d))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	var keys []collections.Pair[uint64, uint64]
+	require.NoError(t, f.keeper.DomainExpirationQueue.Walk(ctx, nil, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}))
+	require.Equal(t, []collections.Pair[uint64, uint64]{
+		collections.Join(uint64(100), uint64(1)),
+		collections.Join(uint64(300), uint64(0)),
+	}, keys)
+}
+
+func TestMigrate1to2FillsNewParams(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// v1 params only held the domain creation fee.
+	creationFee := sdk.NewCoins(sdk.NewInt64Coin("udns", 123))
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{DomainCreationFee: creationFee}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	want := types.DefaultParams()
+	want.DomainCreationFee = creationFee
+	require.Equal(t, want, params)
+	require.NoError(t, params.Validate())
+	require.NotZero(t, params.GracePeriod)
+	require.Equal(t, types.DefaultParams().FeeSplit, params.FeeSplit)
+}
+
+func TestMigrate2to3BackfillsOwnerIndex(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	domains := []types.Domain{
+		{Id: 0, Name: "a.web3", Owner: "alice"},
+		{Id: 1, Name: "b.web3", Owner: "bob"},
+		{Id: 2, Name: "c.web3", Owner: "alice"},
+	}
+	for _, d := range domains {
+		require.NoError(t, f.keeper.Domain.Set(ctx, d.Id, d))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+
+	var keys []collections.Pair[string, uint64]
+	require.NoError(t, f.keeper.DomainsByOwner.Walk(ctx, nil, func(key collections.Pair[string, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}))
+	require.Equal(t, []collections.Pair[string, uint64]{
+		collections.Join("alice", uint64(0)),
+		collections.Join("alice", uint64(2)),
+		collections.Join("bob", uint64(1)),
+	}, keys)
+}
+
+func TestMigrate3to4BackfillsTLDIndexes(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	domains := []types.Domain{
+		{Id: 0, Name: "a.web3", Expiration: 100},
+		{Id: 1, Name: "b.dweb", Expiration: 200},
+		{Id: 2, Name: "c.web3", Expiration: 300},
+	}
+	for _, d := range domains {
+		require.NoError(t, f.keeper.Domain.Set(ctx, d.Id, d))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(ctx))
+
+	stats, err := f.keeper.GetTLDStats(ctx, "web3")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.DomainCount)
+	has, err := f.keeper.DomainsByTLD.Has(ctx, collections.Join("dweb", uint64(1)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	expiring, err := f.keeper.CountTLDExpirations(ctx, "web3", 0, 200)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), expiring)
+}
+
+func TestMigrate4to5BackfillsOriginalCreator(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	domains := []types.Domain{
+		{Id: 0, Name: "a.web3", Creator: "alice"},
+		{Id: 1, Name: "b.web3", Creator: "bob", OriginalCreator: "carol"},
+	}
+	for _, d := range domains {
+		require.NoError(t, f.keeper.Domain.Set(ctx, d.Id, d))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate4to5(ctx))
+
+	a, err := f.keeper.Domain.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "alice", a.OriginalCreator)
+	b, err := f.keeper.Domain.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "carol", b.OriginalCreator)
+}
+
+func TestMigrate5to6MintsDomainNFTs(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	alice, bob := sdk.AccAddress("alice_______________").String(), sdk.AccAddress("bob_________________").String()
+
+	require.NoError(t, f.keeper.PermittedTLDs.Set(ctx, "chain"))
+	domains := []types.Domain{
+		{Id: 0, Name: "a.web3", Creator: alice, Owner: bob},
+		{Id: 1, Name: "b.chain", Creator: bob, Owner: bob},
+	}
+	for _, d := range domains {
+		require.NoError(t, f.keeper.Domain.Set(ctx, d.Id, d))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate5to6(ctx))
+
+	// The NFTs go to the creators, who may transfer the domains.
+	require.True(t, f.nftKeeper.HasClass(ctx, "dns-web3"))
+	require.True(t, f.nftKeeper.HasClass(ctx, "dns-chain"))
+	require.Equal(t, alice, f.nftKeeper.GetOwner(ctx, "dns-web3", "a.web3").String())
+	require.Equal(t, bob, f.nftKeeper.GetOwner(ctx, "dns-chain", "b.chain").String())
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// OpenAuction starts a sealed-bid auction for a name directly under a TLD in its launch phase, or
+// for an available premium name. Its minimum price is what registering the name for a year costs,
+// and the opener escrows it as a deposit that is forfeited if no bid is revealed. Bids are made in
+// the denomination of the minimum price, so only names whose fee is a single nonzero coin can be
+// auctioned; a free name is registered directly instead.
+func (k msgServer) OpenAuction(goCtx context.Context, msg *types.MsgOpenAuction) (*types.MsgOpenAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+
+	availability, err := k.Keeper.CheckNameAvailability(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	name := availability.Name
+	switch {
+	case availability.Reason == types.Availability_AVAILABILITY_AUCTION_ONLY:
+	case availability.Err != nil:
+		return nil, availability.Err
+	case availability.Parent != nil:
+		return nil, errorsmod.Wrapf(types.ErrAuctionNotAllowed, "subdomain '%s' cannot be auctioned", name)
+	default:
+		if _, _, premium := params.NamePrice(name); !premium {
+			return nil, errorsmod.Wrapf(types.ErrAuctionNotAllowed, "'%s' is neither premium nor under a launching TLD", name)
+		}
+	}
+
+	minPrice, err := k.Keeper.RegistrationPrice(ctx, params, availability, 1)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get registration price")
+	}
+	// Coins hold no zero amounts, so a free name has no coin at all.
+	if len(minPrice) != 1 {
+		return nil, errorsmod.Wrapf(types.ErrAuctionNotAllowed, "the registration fee of '%s' must be a single coin to auction it, got %q", name, minPrice)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(creatorAddr), types.ModuleName, minPrice); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to escrow auction deposit from %s", msg.Creator)
+	}
+
+	bidPeriod, revealPeriod := params.AuctionPeriods()
+	now := uint64(ctx.BlockTime().Unix())
+	auction := types.Auction{
+		Name:      name,
+		Creator:   msg.Creator,
+		BidEnd:    now + bidPeriod,
+		RevealEnd: now + bidPeriod + revealPeriod,
+		MinPrice:  minPrice[0],
+		Deposit:   minPrice,
+	}
+	if err := k.SetAuction(ctx, auction); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set auction")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOpenAuction,
+		sdk.NewAttribute(types.AttributeKeyDomainName, name),
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPrice, auction.MinPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyDeposit, auction.Deposit.String()),
+		sdk.NewAttribute(types.AttributeKeyBidEnd, fmt.Sprintf("%d", auction.BidEnd)),
+		sdk.NewAttribute(types.AttributeKeyRevealEnd, fmt.Sprintf("%d", auction.RevealEnd)),
+	))
+	return &types.MsgOpenAuctionResponse{BidEnd: auction.BidEnd, RevealEnd: auction.RevealEnd}, nil
+}
+
+// PlaceBid records a sealed bid during the bid period and escrows its deposit. The deposit must
+// cover the bid once revealed, so it bounds the bid without disclosing it.
+func (k msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidderAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	if len(msg.SealedBid) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidBid, "sealed bid cannot be empty")
+	}
+	if !msg.Deposit.IsValid() || msg.Deposit.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBid, "invalid deposit %s", msg.Deposit)
+	}
+
+	auction, err := k.getOpenAuction(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(ctx.BlockTime().Unix()) >= auction.BidEnd {
+		return nil, errorsmod.Wrapf(types.ErrAuctionPhase, "bidding on '%s' closed at %d", auction.Name, auction.BidEnd)
+	}
+	key := collections.Join(auction.Name, msg.Creator)
+	if has, err := k.AuctionBids.Has(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get bid")
+	} else if has {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBid, "%s already bid on '%s'", msg.Creator, auction.Name)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(bidderAddr), types.ModuleName, msg.Deposit); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to escrow bid deposit from %s", msg.Creator)
+	}
+	bid := types.AuctionBid{
+		Name:      auction.Name,
+		Bidder:    msg.Creator,
+		SealedBid: msg.SealedBid,
+		Deposit:   msg.Deposit,
+		Height:    ctx.BlockHeight(),
+	}
+	if err := k.AuctionBids.Set(ctx, key, bid); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set bid")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePlaceBid,
+		sdk.NewAttribute(types.AttributeKeyDomainName, auction.Name),
+		sdk.NewAttribute(types.AttributeKeyBidder, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyDeposit, msg.Deposit.String()),
+	))
+	return &types.MsgPlaceBidResponse{}, nil
+}
+
+// RevealBid opens a sealed bid during the reveal period, with the name servers the name is
+// registered with if the bid wins. Only revealed bids take part in the settlement.
+func (k msgServer) RevealBid(goCtx context.Context, msg *types.MsgRevealBid) (*types.MsgRevealBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, err := k.getOpenAuction(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	now := uint64(ctx.BlockTime().Unix())
+	if now < auction.BidEnd || now >= auction.RevealEnd {
+		return nil, errorsmod.Wrapf(types.ErrAuctionPhase, "bids on '%s' can be revealed from %d until %d", auction.Name, auction.BidEnd, auction.RevealEnd)
+	}
+
+	key := collections.Join(auction.Name, msg.Creator)
+	bid, err := k.AuctionBids.Get(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBid, "%s has no bid on '%s'", msg.Creator, auction.Name)
+	}
+	if bid.Revealed {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBid, "bid of %s on '%s' is already revealed", msg.Creator, auction.Name)
+	}
+	if !bytes.Equal(bid.SealedBid, types.BidHash(auction.Name, msg.Creator, msg.Amount.String(), msg.Salt)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidBid, "revealed amount and salt do not match the sealed bid")
+	}
+	if msg.Amount.Denom != auction.MinPrice.Denom {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBid, "bid must be in %s", auction.MinPrice.Denom)
+	}
+	if msg.Amount.IsLT(auction.MinPrice) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBid, "bid %s is below the minimum price %s", msg.Amount, auction.MinPrice)
+	}
+	if bid.Deposit.AmountOf(msg.Amount.Denom).LT(msg.Amount.Amount) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBid, "bid %s exceeds its deposit %s", msg.Amount, bid.Deposit)
+	}
+	if err := validateNSRecords(msg.NsRecords); err != nil {
+		return nil, err
+	}
+
+	bid.Revealed = true
+	bid.Amount = msg.Amount
+	bid.NsRecords = msg.NsRecords
+	if err := k.AuctionBids.Set(ctx, key, bid); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set bid")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevealBid,
+		sdk.NewAttribute(types.AttributeKeyDomainName, auction.Name),
+		sdk.NewAttribute(types.AttributeKeyBidder, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPrice, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(bid.SealedBid)),
+	))
+	return &types.MsgRevealBidResponse{}, nil
+}
+
+// getOpenAuction returns the auction of a name, or ErrAuctionNotFound.
+func (k msgServer) getOpenAuction(ctx context.Context, name string) (types.Auction, error) {
+	name = strings.ToLower(strings.Trim(name, "."))
+	auction, found, err := k.GetAuction(ctx, name)
+	if err != nil {
+		return types.Auction{}, errorsmod.Wrap(err, "failed to get auction")
+	}
+	if !found {
+		return types.Auction{}, errorsmod.Wrapf(types.ErrAuctionNotFound, "no auction for '%s'", name)
+	}
+	return auction, nil
+}
@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// bidders returns n distinct test addresses.
+func bidders(t *testing.T, f *fixture, n int) []string {
+	t.Helper()
+	addrs := make([]string, n)
+	for i := range addrs {
+		addr, err := f.addressCodec.BytesToString([]byte{byte('a' + i), 'b', 'i', 'd', 'd', 'e', 'r', '_', '_', '_', '_', '_', '_', '_', '_', '_', '_', '_', '_', '_'})
+		require.NoError(t, err)
+		addrs[i] = addr
+	}
+	return addrs
+}
+
+func udns(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin("udns", amount)
+}
+
+func TestTLDLaunchAuction(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0)).WithBlockHeight(10)
+	addrs := bidders(t, f, 4)
+	alice, bob, carol, dave := addrs[0], addrs[1], addrs[2], addrs[3]
+
+	require.NoError(t, f.keeper.AddPermittedTLD(ctx, "moon"))
+
+	// During the launch phase names under the TLD cannot be registered directly.
+	_, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: alice, Name: "rocket.moon", Owner: alice, NsRecords: testNSRecords("rocket.moon")})
+	require.ErrorIs(t, err, types.ErrNameInAuction)
+
+	opened, err := srv.OpenAuction(ctx, &types.MsgOpenAuction{Creator: alice, Name: "Rocket.moon"})
+	require.NoError(t, err)
+	bidPeriod, revealPeriod := types.DefaultParams().AuctionPeriods()
+	require.Equal(t, uint64(1_000_000)+bidPeriod, opened.BidEnd)
+	_, err = srv.OpenAuction(ctx, &types.MsgOpenAuction{Creator: bob, Name: "rocket.moon"})
+	require.ErrorIs(t, err, types.ErrNameInAuction)
+
+	auction, found, err := f.keeper.GetAuction(ctx, "rocket.moon")
+	require.NoError(t, err)
+	require.True(t, found)
+	minPrice := auction.MinPrice
+	// The opener escrows the minimum price.
+	require.Equal(t, sdk.NewCoins(minPrice), auction.Deposit)
+	require.Equal(t, auction.Deposit, f.bankKeeper.sentToModule)
+
+	bids := map[string]sdk.Coin{
+		alice: minPrice.AddAmount(minPrice.Amount.MulRaw(2)), // highest
+		bob:   minPrice.AddAmount(minPrice.Amount),           // second: sets the price
+		carol: minPrice,
+		dave:  minPrice.AddAmount(minPrice.Amount.MulRaw(5)), // never revealed
+	}
+	deposits := sdk.NewCoins()
+	for _, bidder := range addrs {
+		deposit := sdk.NewCoins(bids[bidder].AddAmount(minPrice.Amount))
+		deposits = deposits.Add(deposit...)
+		_, err := srv.PlaceBid(ctx, &types.MsgPlaceBid{
+			Creator:   bidder,
+			Name:      "rocket.moon",
+			SealedBid: types.BidHash("rocket.moon", bidder, bids[bidder].String(), "salt-"+bidder),
+			Deposit:   deposit,
+		})
+		require.NoError(t, err)
+	}
+	require.Equal(t, deposits.Add(minPrice), f.bankKeeper.sentToModule)
+
+	_, err = srv.PlaceBid(ctx, &types.MsgPlaceBid{Creator: alice, Name: "rocket.moon", SealedBid: []byte("again"), Deposit: sdk.NewCoins(minPrice)})
+	require.ErrorIs(t, err, types.ErrInvalidBid)
+
+	reveal := func(ctx sdk.Context, bidder string, salt string) error {
+		_, err := srv.RevealBid(ctx, &types.MsgRevealBid{Creator: bidder, Name: "rocket.moon", Amount: bids[bidder], Salt: salt, NsRecords: testNSRecords("rocket.moon")})
+		return err
+	}
+	// Bids cannot be revealed while bidding is open, nor with another amount or salt.
+	require.ErrorIs(t, reveal(ctx, alice, "salt-"+alice), types.ErrAuctionPhase)
+	revealCtx := ctx.WithBlockTime(time.Unix(int64(opened.BidEnd), 0))
+	_, err = srv.PlaceBid(revealCtx, &types.MsgPlaceBid{Creator: alice, Name: "rocket.moon", SealedBid: []byte("late"), Deposit: sdk.NewCoins(minPrice)})
+	require.ErrorIs(t, err, types.ErrAuctionPhase)
+	require.ErrorIs(t, reveal(revealCtx, alice, "pepper"), types.ErrInvalidBid)
+	// The winner's name servers come with the reveal.
+	_, err = srv.RevealBid(revealCtx, &types.MsgRevealBid{Creator: alice, Name: "rocket.moon", Amount: bids[alice], Salt: "salt-" + alice})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	for _, bidder := range []string{alice, bob, carol} {
+		require.NoError(t, reveal(revealCtx, bidder, "salt-"+bidder))
+	}
+
+	// Nothing is settled before the reveal period ends.
+	require.NoError(t, f.keeper.EndBlocker(revealCtx))
+	_, found, err = f.keeper.GetAuction(ctx, "rocket.moon")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	f.bankKeeper.toAccounts = nil
+	settleCtx := ctx.WithBlockTime(time.Unix(int64(opened.RevealEnd), 0))
+	require.Equal(t, opened.BidEnd+revealPeriod, opened.RevealEnd)
+	require.NoError(t, f.keeper.EndBlocker(settleCtx))
+
+	domain, err := f.keeper.GetDomainByName(settleCtx, "rocket.moon")
+	require.NoError(t, err)
+	require.Equal(t, alice, domain.Owner)
+	require.Equal(t, types.AddYears(opened.RevealEnd, 1), domain.Expiration)
+	require.Equal(t, testNSRecords("rocket.moon"), domain.NsRecords)
+
+	// Alice pays Bob's bid; the unrevealed bid is forfeited; everything else, including the
+	// opener's deposit, is refunded.
+	price := bids[bob]
+	daveDeposit := bids[dave].AddAmount(minPrice.Amount)
+	require.Equal(t, sdk.NewCoins(price).Add(daveDeposit), f.bankKeeper.toFeeCollector)
+	require.Equal(t, deposits.Sub(price).Sub(daveDeposit).Add(minPrice), f.bankKeeper.toAccounts)
+
+	_, found, err = f.keeper.GetAuction(ctx, "rocket.moon")
+	require.NoError(t, err)
+	require.False(t, found)
+	remaining, err := f.keeper.GetAuctionBids(ctx, "rocket.moon")
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+
+	// After the launch phase names under the TLD are first come, first served.
+	launchEnd := ctx.WithBlockTime(time.Unix(1_000_000+int64(types.DefaultTLDLaunchPeriod), 0))
+	_, err = srv.CreateDomain(launchEnd, &types.MsgCreateDomain{Creator: bob, Name: "capsule.moon", Owner: bob, NsRecords: testNSRecords("capsule.moon")})
+	require.NoError(t, err)
+	_, err = srv.OpenAuction(launchEnd, &types.MsgOpenAuction{Creator: bob, Name: "orbit.moon"})
+	require.ErrorIs(t, err, types.ErrAuctionNotAllowed)
+}
+
+func TestPremiumNameAuction(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+	params := types.DefaultParams()
+	params.PremiumNames = []types.PremiumName{{Name: "gold.web3", RegistrationFee: sdk.NewCoins(udns(100)), RenewalFee: sdk.NewCoins(udns(10))}}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	alice := bidders(t, f, 1)[0]
+
+	_, err := srv.OpenAuction(ctx, &types.MsgOpenAuction{Creator: alice, Name: "plain.web3"})
+	require.ErrorIs(t, err, types.ErrAuctionNotAllowed)
+
+	opened, err := srv.OpenAuction(ctx, &types.MsgOpenAuction{Creator: alice, Name: "gold.web3"})
+	require.NoError(t, err)
+
+	// An open auction blocks direct registration, even of a premium name.
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: alice, Name: "gold.web3", Owner: alice, NsRecords: testNSRecords("gold.web3")})
+	require.ErrorIs(t, err, types.ErrNameInAuction)
+
+	_, err = srv.PlaceBid(ctx, &types.MsgPlaceBid{Creator: alice, Name: "gold.web3", SealedBid: types.BidHash("gold.web3", alice, "150udns", "s"), Deposit: sdk.NewCoins(udns(100))})
+	require.NoError(t, err)
+	revealCtx := ctx.WithBlockTime(time.Unix(int64(opened.BidEnd), 0))
+	// The deposit must cover the revealed bid.
+	_, err = srv.RevealBid(revealCtx, &types.MsgRevealBid{Creator: alice, Name: "gold.web3", Amount: udns(150), Salt: "s", NsRecords: testNSRecords("gold.web3")})
+	require.ErrorIs(t, err, types.ErrInvalidBid)
+
+	// A single bid never revealed leaves the name unsold, and the bid and the opener's deposit
+	// are forfeited.
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(int64(opened.RevealEnd), 0))))
+	_, err = f.keeper.GetDomainByName(ctx, "gold.web3")
+	require.Error(t, err)
+	require.Equal(t, sdk.NewCoins(udns(200)), f.bankKeeper.toFeeCollector)
+
+	// A lone revealed bid pays the minimum price.
+	ctx = ctx.WithBlockTime(time.Unix(int64(opened.RevealEnd), 0))
+	opened, err = srv.OpenAuction(ctx, &types.MsgOpenAuction{Creator: alice, Name: "gold.web3"})
+	require.NoError(t, err)
+	_, err = srv.PlaceBid(ctx, &types.MsgPlaceBid{Creator: alice, Name: "gold.web3", SealedBid: types.BidHash("gold.web3", alice, "150udns", "s"), Deposit: sdk.NewCoins(udns(200))})
+	require.NoError(t, err)
+	_, err = srv.RevealBid(ctx.WithBlockTime(time.Unix(int64(opened.BidEnd), 0)), &types.MsgRevealBid{Creator: alice, Name: "gold.web3", Amount: udns(150), Salt: "s", NsRecords: testNSRecords("gold.web3")})
+	require.NoError(t, err)
+	f.bankKeeper.toAccounts = nil
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(int64(opened.RevealEnd), 0))))
+
+	domain, err := f.keeper.GetDomainByName(ctx, "gold.web3")
+	require.NoError(t, err)
+	require.Equal(t, alice, domain.Owner)
+	require.Equal(t, sdk.NewCoins(udns(300)), f.bankKeeper.toFeeCollector)
+	require.Equal(t, sdk.NewCoins(udns(200)), f.bankKeeper.toAccounts)
+}
+
+func TestGenesisAuctions(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+	alice := bidders(t, f, 1)[0]
+
+	require.NoError(t, f.keeper.AddPermittedTLD(ctx, "moon"))
+	_, err := srv.OpenAuction(ctx, &types.MsgOpenAuction{Creator: alice, Name: "rocket.moon"})
+	require.NoError(t, err)
+	_, err = srv.PlaceBid(ctx, &types.MsgPlaceBid{Creator: alice, Name: "rocket.moon", SealedBid: []byte("sealed"), Deposit: sdk.NewCoins(udns(5))})
+	require.NoError(t, err)
+
+	exported, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, exported.Auctions, 1)
+	require.Len(t, exported.AuctionBids, 1)
+	require.Equal(t, []types.TLDLaunch{{Tld: "moon", AuctionOnlyUntil: 1_000_000 + types.DefaultTLDLaunchPeriod}}, exported.TldLaunches)
+	require.NoError(t, exported.Validate())
+
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported.Auctions, reexported.Auctions)
+	require.Equal(t, exported.AuctionBids, reexported.AuctionBids)
+	require.Equal(t, exported.TldLaunches, reexported.TldLaunches)
+
+	queue, err := g.keeper.AuctionQueue.Has(g.ctx, collections.Join(exported.Auctions[0].RevealEnd, exported.Auctions[0].Name))
+	require.NoError(t, err)
+	require.True(t, queue)
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// CommitDomain records a sealed commitment to register a name and escrows Params.CommitDeposit.
+func (k msgServer) CommitDomain(goCtx context.Context, msg *types.MsgCommitDomain) (*types.MsgCommitDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	if len(msg.Hash) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commitment hash cannot be empty")
+	}
+
+	_, exists, err := k.GetCommitment(ctx, msg.Creator, msg.Hash)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get commitment")
+	}
+	if exists {
+		return nil, errorsmod.Wrapf(types.ErrCommitmentExists, "commitment %X", msg.Hash)
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	deposit := params.CommitDeposit
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(creatorAddr), types.ModuleName, deposit); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to escrow commitment deposit from %s", msg.Creator)
+		}
+	}
+
+	commitment := types.Commitment{
+		Hash:    msg.Hash,
+		Creator: msg.Creator,
+		Deposit: deposit,
+		Height:  ctx.BlockHeight(),
+	}
+	if err := k.SetCommitment(ctx, commitment); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set commitment")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCommitDomain,
+		sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(msg.Hash)),
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
+	))
+	return &types.MsgCommitDomainResponse{}, nil
+}
+
+// RevealDomain registers the name of a commitment made between Params.CommitMinBlocks and
+// Params.CommitMaxBlocks ago by the signer, and refunds its deposit.
+func (k msgServer) RevealDomain(goCtx context.Context, msg *types.MsgRevealDomain) (*types.MsgRevealDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hash := types.CommitmentHash(msg.Name, msg.Owner, msg.Creator, msg.Salt)
+	commitment, found, err := k.GetCommitment(ctx, msg.Creator, hash)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get commitment")
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCommitmentNotFound, "no commitment by %s matches the revealed name, owner and salt", msg.Creator)
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	minBlocks, maxBlocks := params.CommitWindow()
+	age := ctx.BlockHeight() - commitment.Height
+	if age < int64(minBlocks) {
+		return nil, errorsmod.Wrapf(types.ErrCommitmentNotReady, "commitment made at height %d can be revealed from height %d", commitment.Height, commitment.Height+int64(minBlocks))
+	}
+	if age > int64(maxBlocks) {
+		return nil, errorsmod.Wrapf(types.ErrCommitmentExpired, "commitment made at height %d could be revealed until height %d", commitment.Height, commitment.Height+int64(maxBlocks))
+	}
+
+	// A name taken since the commitment was made is not the committer's fault: the deposit is
+	// refunded rather than left to be forfeited when the commitment goes stale.
+	availability, err := k.CheckNameAvailability(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	if nameTaken(availability.Reason) {
+		if err := k.RemoveCommitment(ctx, commitment); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove commitment")
+		}
+		if err := k.refundDeposit(ctx, commitment.Creator, commitment.Deposit); err != nil {
+			return nil, err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRevealDomain,
+			sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(hash)),
+			sdk.NewAttribute(types.AttributeKeyDomainName, availability.Name),
+			sdk.NewAttribute(types.AttributeKeyReason, availability.Reason.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, commitment.Deposit.String()),
+		))
+		return &types.MsgRevealDomainResponse{NameTaken: true}, nil
+	}
+
+	id, err := k.registerDomain(ctx, &types.MsgCreateDomain{
+		Creator:   msg.Creator,
+		Name:      msg.Name,
+		Owner:     msg.Owner,
+		NsRecords: msg.NsRecords,
+		Years:     msg.Years,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveCommitment(ctx, commitment); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove commitment")
+	}
+	if err := k.refundDeposit(ctx, commitment.Creator, commitment.Deposit); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevealDomain,
+		sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(hash)),
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, strings.ToLower(strings.Trim(msg.Name, "."))),
+		sdk.NewAttribute(types.AttributeKeyRefund, commitment.Deposit.String()),
+	))
+	return &types.MsgRevealDomainResponse{Id: id}, nil
+}
+
+// nameTaken reports whether a name cannot be registered because someone else holds it or it is up
+// for auction, as opposed to the reveal itself being invalid.
+func nameTaken(reason types.Availability) bool {
+	switch reason {
+	case types.Availability_AVAILABILITY_REGISTERED,
+		types.Availability_AVAILABILITY_EXPIRED_HELD,
+		types.Availability_AVAILABILITY_IN_AUCTION,
+		types.Availability_AVAILABILITY_AUCTION_ONLY:
+		return true
+	}
+	return false
+}
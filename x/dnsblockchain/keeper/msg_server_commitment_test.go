@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestCommitRevealDomain(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	deposit := params.CommitDeposit
+
+	creator, err := f.addressCodec.BytesToString([]byte("committer___________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("frontrunner_________________"))
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+	hash := types.CommitmentHash("Secret.web3", creator, creator, "salt")
+
+	// Copying a pending commitment does not keep its committer from making it.
+	_, err = srv.CommitDomain(ctx, &types.MsgCommitDomain{Creator: other, Hash: hash})
+	require.NoError(t, err)
+	_, err = srv.CommitDomain(ctx, &types.MsgCommitDomain{Creator: creator, Hash: hash})
+	require.NoError(t, err)
+	require.Equal(t, deposit.Add(deposit...), f.bankKeeper.sentToModule)
+
+	_, err = srv.CommitDomain(ctx, &types.MsgCommitDomain{Creator: creator, Hash: hash})
+	require.ErrorIs(t, err, types.ErrCommitmentExists)
+
+	reveal := &types.MsgRevealDomain{Creator: creator, Name: "secret.web3", Owner: creator, NsRecords: testNSRecords("secret.web3"), Salt: "salt"}
+
+	// Too early.
+	_, err = srv.RevealDomain(ctx.WithBlockHeight(101), reveal)
+	require.ErrorIs(t, err, types.ErrCommitmentNotReady)
+
+	// Only the committer can reveal, and only with the committed owner and salt.
+	stolen := *reveal
+	stolen.Creator = other
+	_, err = srv.RevealDomain(ctx.WithBlockHeight(102), &stolen)
+	require.ErrorIs(t, err, types.ErrCommitmentNotFound)
+	wrongSalt := *reveal
+	wrongSalt.Salt = "pepper"
+	_, err = srv.RevealDomain(ctx.WithBlockHeight(102), &wrongSalt)
+	require.ErrorIs(t, err, types.ErrCommitmentNotFound)
+
+	resp, err := srv.RevealDomain(ctx.WithBlockHeight(102), reveal)
+	require.NoError(t, err)
+	domain, err := f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, "secret.web3", domain.Name)
+	require.Equal(t, deposit, f.bankKeeper.toAccounts)
+
+	_, found, err := f.keeper.GetCommitment(ctx, creator, hash)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// A commitment past its window cannot be revealed.
+	late := types.CommitmentHash("late.web3", creator, creator, "salt")
+	_, err = srv.CommitDomain(ctx, &types.MsgCommitDomain{Creator: creator, Hash: late})
+	require.NoError(t, err)
+	reveal.Name = "late.web3"
+	_, err = srv.RevealDomain(ctx.WithBlockHeight(100+int64(types.DefaultCommitMaxBlocks)+1), reveal)
+	require.ErrorIs(t, err, types.ErrCommitmentExpired)
+}
+
+func TestRequireCommitment(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	params.RequireCommitment = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "direct.web3", Owner: creator, NsRecords: testNSRecords("direct.web3")})
+	require.ErrorIs(t, err, types.ErrCommitmentRequired)
+
+	// Availability checks report it, with the fee the reveal is charged.
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	check := func(ctx context.Context, name string) *types.QueryCheckAvailabilityResponse {
+		res, err := qs.CheckAvailability(ctx, &types.QueryCheckAvailabilityRequest{Name: name})
+		require.NoError(t, err)
+		return res
+	}
+	res := check(f.ctx, "direct.web3")
+	require.False(t, res.Available)
+	require.Equal(t, types.Availability_AVAILABILITY_COMMITMENT_REQUIRED, res.Reason)
+	require.Equal(t, params.DomainCreationFee, res.Fee)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	_, err = srv.CommitDomain(ctx, &types.MsgCommitDomain{Creator: creator, Hash: types.CommitmentHash("direct.web3", creator, creator, "s")})
+	require.NoError(t, err)
+	_, err = srv.RevealDomain(ctx.WithBlockHeight(20), &types.MsgRevealDomain{Creator: creator, Name: "direct.web3", Owner: creator, NsRecords: testNSRecords("direct.web3"), Salt: "s"})
+	require.NoError(t, err)
+
+	// Registered names are reported as such, and subdomains can only be created by the parent's
+	// owner and need no commitment.
+	require.Equal(t, types.Availability_AVAILABILITY_REGISTERED, check(ctx, "direct.web3").Reason)
+	require.True(t, check(ctx, "www.direct.web3").Available)
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "www.direct.web3", Owner: creator, NsRecords: testNSRecords("www.direct.web3")})
+	require.NoError(t, err)
+}
+
+func TestPruneStaleCommitments(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	params.CommitMaxBlocks = 10
+	params.CommitForfeitBps = 2500
+	params.CommitDeposit = sdk.NewCoins(sdk.NewInt64Coin("udns", 1000))
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("committer___________________"))
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	stale := types.CommitmentHash("stale.web3", creator, creator, "salt")
+	_, err = srv.CommitDomain(ctx.WithBlockHeight(5), &types.MsgCommitDomain{Creator: creator, Hash: stale})
+	require.NoError(t, err)
+	fresh := types.CommitmentHash("fresh.web3", creator, creator, "salt")
+	_, err = srv.CommitDomain(ctx.WithBlockHeight(8), &types.MsgCommitDomain{Creator: creator, Hash: fresh})
+	require.NoError(t, err)
+
+	// Still revealable at height 15.
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(15)))
+	_, found, err := f.keeper.GetCommitment(ctx, creator, stale)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(16)))
+	_, found, err = f.keeper.GetCommitment(ctx, creator, stale)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = f.keeper.GetCommitment(ctx, creator, fresh)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// A quarter of the deposit is forfeited through the fee split, the rest refunded.
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udns", 250)), f.bankKeeper.toFeeCollector)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udns", 750)), f.bankKeeper.toAccounts)
+}
+
+func TestGenesisCommitments(t *testing.T) {
+	f := initFixture(t)
+	creator, err := f.addressCodec.BytesToString([]byte("committer___________________"))
+	require.NoError(t, err)
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		Commitments: []types.Commitment{{
+			Hash:    types.CommitmentHash("a.web3", creator, creator, "salt"),
+			Creator: creator,
+			Deposit: sdk.NewCoins(sdk.NewInt64Coin("udns", 10)),
+			Height:  7,
+		}},
+	}
+	require.NoError(t, genesisState.Validate())
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesisState.Commitments, got.Commitments)
+}
+
+func TestRevealTakenNameRefundsDeposit(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	deposit := types.DefaultParams().CommitDeposit
+	addrs := bidders(t, f, 2)
+	creator, other := addrs[0], addrs[1]
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+	hash := types.CommitmentHash("taken.web3", creator, creator, "salt")
+	_, err := srv.CommitDomain(ctx, &types.MsgCommitDomain{Creator: creator, Hash: hash})
+	require.NoError(t, err)
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: other, Name: "taken.web3", Owner: other, NsRecords: testNSRecords("taken.web3")})
+	require.NoError(t, err)
+
+	// A premature reveal fails and keeps the commitment.
+	reveal := &types.MsgRevealDomain{Creator: creator, Name: "taken.web3", Owner: creator, Salt: "salt"}
+	_, err = srv.RevealDomain(ctx.WithBlockHeight(90), reveal)
+	require.ErrorIs(t, err, types.ErrCommitmentNotReady)
+
+	// Losing the name to someone else refunds the whole deposit.
+	resp, err := srv.RevealDomain(ctx.WithBlockHeight(102), reveal)
+	require.NoError(t, err)
+	require.True(t, resp.NameTaken)
+	require.Equal(t, deposit, f.bankKeeper.toAccounts)
+	_, found, err := f.keeper.GetCommitment(ctx, creator, hash)
+	require.NoError(t, err)
+	require.False(t, found)
+	domain, err := f.keeper.GetDomainByName(ctx, "taken.web3")
+	require.NoError(t, err)
+	require.Equal(t, other, domain.Owner)
+}
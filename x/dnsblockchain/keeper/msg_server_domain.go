@@ -17,108 +17,96 @@ import (
 
 func (k msgServer) CreateDomain(goCtx context.Context, msg *types.MsgCreateDomain) (*types.MsgCreateDomainResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	var err error
 
-	creatorAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	params, err := k.Keeper.Params.Get(ctx)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
 	}
-	if _, err = k.addressCodec.StringToBytes(msg.Owner); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid owner address: %s", err))
+	// Subdomains are not exposed to front-running: only the owner of their parent can create them.
+	if params.RequireCommitment && types.ParentName(strings.ToLower(strings.Trim(msg.Name, "."))) == "" {
+		return nil, errorsmod.Wrapf(types.ErrCommitmentRequired, "register %s with MsgCommitDomain and MsgRevealDomain", msg.Name)
 	}
 
-	// Get module parameters
-	params, err := k.Keeper.Params.Get(ctx) // Acceder a Params a través de k.Keeper
+	id, err := k.registerDomain(ctx, msg)
 	if err != nil {
-		k.Keeper.Logger(ctx).Error("Failed to get dnsblockchain module params", "error", err)
-		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+		return nil, err
 	}
+	return &types.MsgCreateDomainResponse{
+		Id: id,
+	}, nil
+}
 
-	domainCreationFee := params.DomainCreationFee
-
-	// Charge and burn the domain creation fee
-	if !domainCreationFee.IsZero() {
-		err = k.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(creatorAddr), types.ModuleName, domainCreationFee)
-		if err != nil {
-			k.Keeper.Logger(ctx).Error("Failed to send domain creation fee from creator to module", "creator", msg.Creator, "fee", domainCreationFee.String(), "error", err)
-			return nil, errorsmod.Wrapf(err, "failed to send domain creation fee from creator %s to module account", msg.Creator)
-		}
-
-		err = k.Keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, domainCreationFee)
-		if err != nil {
-			k.Keeper.Logger(ctx).Error("CRITICAL: Failed to burn domain creation fee from module account", "fee", domainCreationFee.String(), "error", err)
-			return nil, errorsmod.Wrapf(err, "failed to burn domain creation fee from module account")
-		}
-		k.Keeper.Logger(ctx).Info("Domain creation fee collected and burned", "creator", msg.Creator, "amount", domainCreationFee.String())
+// registerDomain validates and registers a new domain, charging its registration fee. It is shared
+// by CreateDomain and RevealDomain.
+func (k msgServer) registerDomain(ctx sdk.Context, msg *types.MsgCreateDomain) (uint64, error) {
+	var err error
 
-		// Emit an event for fee payment and burn
-		ctx.EventManager().EmitEvents(sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeDomainFeeCollected,
-				sdk.NewAttribute(types.AttributeKeyFeeCollector, msg.Creator),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, domainCreationFee.String()),
-			),
-			sdk.NewEvent(
-				types.EventTypeDomainFeeBurned,
-				sdk.NewAttribute(types.AttributeKeyBurnerModule, types.ModuleName),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, domainCreationFee.String()),
-			),
-			// EL EVENTO DE TRANSFERDOMAIN SE ELIMINÓ DE AQUÍ, ERA INCORRECTO
-		})
+	if _, err = k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	if _, err = k.addressCodec.StringToBytes(msg.Owner); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid owner address: %s", err))
+	}
+	if err = validateNSRecords(msg.NsRecords); err != nil {
+		return 0, err
 	}
 
-	normalizedName := strings.ToLower(strings.Trim(msg.Name, "."))
-	parts := strings.Split(normalizedName, ".")
-	if len(parts) != 2 {
-		return nil, errorsmod.Wrapf(types.ErrInvalidDomainName, "domain name '%s' must be in 'label.tld' format", msg.Name)
+	// Get module parameters
+	params, err := k.Keeper.Params.Get(ctx) // Acceder a Params a través de k.Keeper
+	if err != nil {
+		k.Keeper.Logger(ctx).Error("Failed to get dnsblockchain module params", "error", err)
+		return 0, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
 	}
-	extractedTLDFromMsgName := parts[1]
 
-	if extractedTLDFromMsgName == "" {
-		return nil, errorsmod.Wrapf(types.ErrInvalidDomainName, "TLD could not be extracted from domain name '%s'", msg.Name)
+	years := msg.Years
+	if years == 0 {
+		years = 1
+	}
+	if years > params.RegistrationYearsLimit() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidRegistrationYears, "cannot register for %d years; the maximum is %d", years, params.RegistrationYearsLimit())
 	}
 
-	isPermitted, err := k.Keeper.IsTLDPermitted(ctx, extractedTLDFromMsgName) // Acceder a IsTLDPermitted a través de k.Keeper
+	availability, err := k.Keeper.CheckNameAvailability(ctx, msg.Name)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to check if TLD is permitted")
+		return 0, err
 	}
-	if !isPermitted {
-		return nil, errorsmod.Wrapf(types.ErrTLDNotPermitted, "TLD '%s' from domain name '%s' is not permitted", extractedTLDFromMsgName, msg.Name)
+	if availability.Err != nil {
+		return 0, availability.Err
 	}
+	normalizedName := availability.Name
 
-	_, err = k.Keeper.DomainName.Get(ctx, normalizedName) // Acceder a DomainName a través de k.Keeper
-	if err == nil {
-		return nil, errorsmod.Wrapf(types.ErrDuplicateDomainName, "domain name '%s' already exists", normalizedName)
+	expiration := types.AddYears(uint64(ctx.BlockTime().Unix()), years)
+	var parentName string
+	if parent := availability.Parent; parent != nil {
+		if msg.Creator != parent.Owner {
+			return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the owner %s of %s can create its subdomains", parent.Owner, parent.Name)
+		}
+		parentName = parent.Name
+		// A subdomain cannot outlive its parent.
+		expiration = min(expiration, parent.Expiration)
 	}
-	if !errors.Is(err, collections.ErrNotFound) {
-		return nil, errorsmod.Wrap(err, "failed to check for duplicate domain name in index")
+
+	// The name's registration price covers the first year; each additional year costs its renewal price.
+	domainCreationFee, err := k.Keeper.RegistrationPrice(ctx, params, availability, years)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to get registration price")
 	}
 
-	if len(msg.NsRecords) == 0 {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one NS record (ns_records entry) must be provided")
+	// Charge the domain creation fee, distributed through the fee split
+	if err = k.Keeper.ChargeFee(ctx, msg.Creator, normalizedName, domainCreationFee, types.FeeTypeRegistration); err != nil {
+		return 0, err
 	}
-	for i, nsEntry := range msg.NsRecords {
-		if nsEntry == nil {
-			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "ns_records entry %d cannot be nil", i)
-		}
-		if strings.TrimSpace(nsEntry.Name) == "" {
-			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "name in ns_records entry %d cannot be empty", i)
-		}
-		for _, ipStr := range nsEntry.Ipv4Addresses {
-			if net.ParseIP(ipStr) == nil {
-				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid IPv4 address '%s' in ns_records entry %d", ipStr, i)
-			}
-		}
-		for _, ipStr := range nsEntry.Ipv6Addresses {
-			if net.ParseIP(ipStr) == nil {
-				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid IPv6 address '%s' in ns_records entry %d", ipStr, i)
-			}
+
+	if availability.Released != nil {
+		// The name passed its pending-delete period but the EndBlocker has not swept it yet: reclaim it inline.
+		if err = k.Keeper.ReclaimExpiredDomain(ctx, *availability.Released); err != nil {
+			return 0, errorsmod.Wrapf(err, "failed to reclaim expired domain '%s'", normalizedName)
 		}
 	}
 
 	nextID, err := k.Keeper.DomainSeq.Next(ctx) // Acceder a DomainSeq a través de k.Keeper
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
 	}
 
 	domain := types.Domain{
@@ -127,22 +115,15 @@ func (k msgServer) CreateDomain(goCtx context.Context, msg *types.MsgCreateDomai
 		Name:       normalizedName,
 		Owner:      msg.Owner,
 		NsRecords:  msg.NsRecords,
-		Expiration: uint64(ctx.BlockTime().AddDate(1, 0, 0).Unix()),
+		Expiration: expiration,
+		Parent:     parentName,
 	}
 
-	if err = k.Keeper.Domain.Set(ctx, nextID, domain); err != nil { // Acceder a Domain a través de k.Keeper
+	if err = k.Keeper.SetDomain(ctx, domain); err != nil {
 		if !domainCreationFee.IsZero() {
-			k.Keeper.Logger(ctx).Error("CRITICAL: Domain creation fee burned, but failed to set domain in store", "creator", msg.Creator, "fee", domainCreationFee.String(), "error", err)
+			k.Keeper.Logger(ctx).Error("CRITICAL: Domain creation fee charged, but failed to set domain in store; the transaction is reverted", "creator", msg.Creator, "fee", domainCreationFee.String(), "error", err)
 		}
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set domain by id")
-	}
-
-	if err = k.Keeper.DomainName.Set(ctx, domain.Name, domain.Id); err != nil { // Acceder a DomainName a través de k.Keeper
-		_ = k.Keeper.Domain.Remove(ctx, domain.Id) // Acceder a Domain a través de k.Keeper
-		if !domainCreationFee.IsZero() {
-			k.Keeper.Logger(ctx).Error("CRITICAL: Domain creation fee burned, domain removed, but failed to set domain name index", "creator", msg.Creator, "fee", domainCreationFee.String(), "error", err)
-		}
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set domain name index")
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set domain")
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -155,9 +136,34 @@ func (k msgServer) CreateDomain(goCtx context.Context, msg *types.MsgCreateDomai
 		),
 	})
 
-	return &types.MsgCreateDomainResponse{
-		Id: nextID,
-	}, nil
+	return nextID, nil
+}
+
+// validateNSRecords checks the name servers a domain is registered with: at least one, each named
+// and with well-formed addresses.
+func validateNSRecords(records []*types.NSRecordWithIP) error {
+	if len(records) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one NS record (ns_records entry) must be provided")
+	}
+	for i, nsEntry := range records {
+		if nsEntry == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "ns_records entry %d cannot be nil", i)
+		}
+		if strings.TrimSpace(nsEntry.Name) == "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "name in ns_records entry %d cannot be empty", i)
+		}
+		for _, ipStr := range nsEntry.Ipv4Addresses {
+			if net.ParseIP(ipStr) == nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid IPv4 address '%s' in ns_records entry %d", ipStr, i)
+			}
+		}
+		for _, ipStr := range nsEntry.Ipv6Addresses {
+			if net.ParseIP(ipStr) == nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid IPv6 address '%s' in ns_records entry %d", ipStr, i)
+			}
+		}
+	}
+	return nil
 }
 
 func (k msgServer) UpdateDomain(goCtx context.Context, msg *types.MsgUpdateDomain) (*types.MsgUpdateDomainResponse, error) {
@@ -176,10 +182,24 @@ func (k msgServer) UpdateDomain(goCtx context.Context, msg *types.MsgUpdateDomai
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get domain")
 	}
 
+	val, _, err = k.Keeper.EffectiveDomain(ctx, val)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to evaluate domain lifecycle")
+	}
+	if val.Status != types.DomainStatus_DOMAIN_STATUS_ACTIVE && val.Status != types.DomainStatus_DOMAIN_STATUS_GRACE {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDomainStatus, "domain %d cannot be updated while in %s", msg.Id, val.Status)
+	}
+
 	newOwner := val.Owner
 	newNsRecords := val.NsRecords
 
-	isCreator := msg.Creator == val.Creator
+	parentOwner, err := k.Keeper.ParentOwner(ctx, val)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get parent domain")
+	}
+
+	// The owner of a subdomain's parent has the same rights as its creator.
+	isCreator := msg.Creator == val.Creator || (parentOwner != "" && msg.Creator == parentOwner)
 	isOwner := msg.Creator == val.Owner
 
 	if !isCreator && !isOwner {
@@ -249,16 +269,19 @@ func (k msgServer) UpdateDomain(goCtx context.Context, msg *types.MsgUpdateDomai
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no fields to update were provided or new values are same as existing")
 	}
 
-	domain := types.Domain{
-		Id:         msg.Id,
-		Creator:    val.Creator,
-		Name:       val.Name,
-		Owner:      newOwner,
-		NsRecords:  newNsRecords,
-		Expiration: val.Expiration,
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	if err = k.Keeper.ChargeFee(ctx, msg.Creator, val.Name, params.DomainUpdateFee, types.FeeTypeUpdate); err != nil {
+		return nil, err
 	}
 
-	if err = k.Keeper.Domain.Set(ctx, msg.Id, domain); err != nil { // Acceder a Domain a través de k.Keeper
+	domain := val
+	domain.Owner = newOwner
+	domain.NsRecords = newNsRecords
+
+	if err = k.Keeper.SetDomain(ctx, domain); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update domain")
 	}
 
@@ -292,17 +315,16 @@ func (k msgServer) DeleteDomain(goCtx context.Context, msg *types.MsgDeleteDomai
 	}
 
 	// CORRECCIÓN: Solo el PROPIETARIO actual puede eliminar el dominio.
-	if msg.Creator != val.Owner {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the current owner %s of the domain", msg.Creator, val.Owner)
+	// The owner of a subdomain's parent can also revoke it.
+	parentOwner, err := k.Keeper.ParentOwner(ctx, val)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get parent domain")
 	}
-
-	domainNameToRemoveFromIndex := val.Name
-
-	if err = k.Keeper.DomainName.Remove(ctx, domainNameToRemoveFromIndex); err != nil {
-		k.Keeper.Logger(ctx).Error("failed to remove domain name from index during delete, but proceeding", "name", domainNameToRemoveFromIndex, "id", msg.Id, "error", err)
+	if msg.Creator != val.Owner && (parentOwner == "" || msg.Creator != parentOwner) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the current owner %s of the domain", msg.Creator, val.Owner)
 	}
 
-	if err = k.Keeper.Domain.Remove(ctx, msg.Id); err != nil {
+	if err = k.Keeper.RemoveDomain(ctx, val); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete domain by id")
 	}
 
@@ -320,41 +342,137 @@ func (k msgServer) DeleteDomain(goCtx context.Context, msg *types.MsgDeleteDomai
 
 func (k msgServer) HeartbeatDomain(goCtx context.Context, msg *types.MsgHeartbeatDomain) (*types.MsgHeartbeatDomainResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	var err error
 
-	if _, err = k.Keeper.addressCodec.StringToBytes(msg.Creator); err != nil { // Acceder a addressCodec a través de k.Keeper
+	if _, err := k.Keeper.addressCodec.StringToBytes(msg.Creator); err != nil { // Acceder a addressCodec a través de k.Keeper
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
-	domain, errGet := k.Keeper.Domain.Get(ctx, msg.Id) // Acceder a Domain a través de k.Keeper
-	if errGet != nil {
-		if errors.Is(errGet, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("domain id %d not found", msg.Id))
-		}
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get domain for heartbeat")
+	// A heartbeat is a one-year renewal.
+	domain, err := k.renewDomain(ctx, msg.Creator, msg.Id, 1)
+	if err != nil {
+		return nil, err
 	}
 
-	if msg.Creator != domain.Creator && msg.Creator != domain.Owner {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is neither the creator (%s) nor the owner (%s)", msg.Creator, domain.Creator, domain.Owner)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeHeartbeatDomain,
+			sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+			sdk.NewAttribute(types.AttributeKeyActor, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyNewExpiration, fmt.Sprintf("%d", domain.Expiration)),
+		),
+	})
+
+	return &types.MsgHeartbeatDomainResponse{}, nil
+}
+
+func (k msgServer) RenewDomain(goCtx context.Context, msg *types.MsgRenewDomain) (*types.MsgRenewDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Keeper.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
-	domain.Expiration = uint64(ctx.BlockTime().AddDate(1, 0, 0).Unix())
+	years := msg.Years
+	if years == 0 {
+		years = 1
+	}
 
-	if err = k.Keeper.Domain.Set(ctx, msg.Id, domain); err != nil { // Acceder a Domain a través de k.Keeper
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update domain expiration for heartbeat")
+	domain, err := k.renewDomain(ctx, msg.Creator, msg.Id, years)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeHeartbeatDomain,
+			types.EventTypeRenewDomain,
 			sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", msg.Id)),
 			sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
 			sdk.NewAttribute(types.AttributeKeyActor, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyYears, fmt.Sprintf("%d", years)),
 			sdk.NewAttribute(types.AttributeKeyNewExpiration, fmt.Sprintf("%d", domain.Expiration)),
 		),
 	})
 
-	return &types.MsgHeartbeatDomainResponse{}, nil
+	return &types.MsgRenewDomainResponse{Expiration: domain.Expiration}, nil
+}
+
+// renewDomain extends a domain's expiration by the given number of years, counted from its current
+// expiration, and charges the renewal fee for each year. Domains in their grace or redemption period
+// can only be renewed by the owner; a redemption renewal also pays the restore fee. The resulting
+// expiration cannot be further than Params.MaxRegistrationYears ahead of the current block time.
+func (k msgServer) renewDomain(ctx sdk.Context, signer string, id uint64, years uint64) (types.Domain, error) {
+	domain, err := k.Keeper.Domain.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return domain, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("domain id %d not found", id))
+		}
+		return domain, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get domain for renewal")
+	}
+
+	domain, _, err = k.Keeper.EffectiveDomain(ctx, domain)
+	if err != nil {
+		return domain, errorsmod.Wrap(err, "failed to evaluate domain lifecycle")
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return domain, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	fee := params.RenewalFee(domain.Name, years)
+	feeType := types.FeeTypeRenewal
+
+	parentOwner, err := k.Keeper.ParentOwner(ctx, domain)
+	if err != nil {
+		return domain, errorsmod.Wrap(err, "failed to get parent domain")
+	}
+
+	switch domain.Status {
+	case types.DomainStatus_DOMAIN_STATUS_ACTIVE:
+		if signer != domain.Creator && signer != domain.Owner && (parentOwner == "" || signer != parentOwner) {
+			return domain, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is neither the creator (%s) nor the owner (%s)", signer, domain.Creator, domain.Owner)
+		}
+	case types.DomainStatus_DOMAIN_STATUS_GRACE:
+		if signer != domain.Owner {
+			return domain, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the owner %s can renew domain %d during its grace period", domain.Owner, id)
+		}
+	case types.DomainStatus_DOMAIN_STATUS_REDEMPTION:
+		if signer != domain.Owner {
+			return domain, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the owner %s can restore domain %d during its redemption period", domain.Owner, id)
+		}
+		fee = fee.Add(params.RestoreFee...)
+		feeType = types.FeeTypeRestore
+	default:
+		return domain, errorsmod.Wrapf(types.ErrInvalidDomainStatus, "domain %d cannot be renewed while in %s", id, domain.Status)
+	}
+
+	newExpiration := types.AddYears(domain.Expiration, years)
+	maxExpiration := types.AddYears(uint64(ctx.BlockTime().Unix()), params.RegistrationYearsLimit())
+	if newExpiration > maxExpiration {
+		return domain, errorsmod.Wrapf(types.ErrInvalidRegistrationYears, "renewing domain %d for %d years would exceed the maximum registration period of %d years", id, years, params.RegistrationYearsLimit())
+	}
+	if domain.Parent != "" {
+		parent, err := k.Keeper.GetDomainByName(ctx, domain.Parent)
+		if err != nil {
+			return domain, errorsmod.Wrap(err, "failed to get parent domain")
+		}
+		if newExpiration > parent.Expiration {
+			return domain, errorsmod.Wrapf(types.ErrInvalidRegistrationYears, "subdomain %s cannot be renewed past the expiration of its parent %s", domain.Name, domain.Parent)
+		}
+	}
+
+	if err = k.Keeper.ChargeFee(ctx, signer, domain.Name, fee, feeType); err != nil {
+		return domain, err
+	}
+
+	domain.Status = types.DomainStatus_DOMAIN_STATUS_ACTIVE
+	domain.StatusDeadline = 0
+	domain.Expiration = newExpiration
+
+	if err = k.Keeper.SetDomain(ctx, domain); err != nil {
+		return domain, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update domain expiration for renewal")
+	}
+	return domain, nil
 }
 
 func (k msgServer) TransferDomain(goCtx context.Context, msg *types.MsgTransferDomain) (*types.MsgTransferDomainResponse, error) {
@@ -367,43 +485,58 @@ func (k msgServer) TransferDomain(goCtx context.Context, msg *types.MsgTransferD
 	if _, err = k.Keeper.addressCodec.StringToBytes(msg.NewOwner); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid new owner address: %s", err))
 	}
-
-	domain, errGet := k.Keeper.Domain.Get(ctx, msg.Id)
-	if errGet != nil {
-		if errors.Is(errGet, collections.ErrNotFound) {
-			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "domain with id %d not found", msg.Id)
-		}
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get domain for transfer")
+	// A mistyped address would receive the domain for good, so moving it without the new owner
+	// accepting an offer must be asked for explicitly.
+	if !msg.Direct {
+		return nil, errorsmod.Wrap(types.ErrDirectTransferDisabled, "offer the domain with offer-domain-transfer, or set direct to transfer it at once")
 	}
 
-	// CORRECCIÓN: Solo el CREADOR original puede transferir la "creatorship".
-	if domain.Creator != msg.Creator {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the creator %s; only the original creator can transfer the domain", msg.Creator, domain.Creator)
+	domain, err := k.transferableDomain(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
 	}
 
-	oldCreator := domain.Creator
-	oldOwner := domain.Owner // Guardar para el evento
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	if err = k.Keeper.ChargeFee(ctx, msg.Creator, domain.Name, params.DomainTransferFee, types.FeeTypeTransfer); err != nil {
+		return nil, err
+	}
 
-	// En una transferencia completa, tanto el creator como el owner se convierten en el nuevo dueño.
-	domain.Creator = msg.NewOwner
-	domain.Owner = msg.NewOwner
+	if err = k.Keeper.moveDomain(ctx, domain, msg.NewOwner, msg.Creator); err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferDomainResponse{}, nil
+}
 
-	if err = k.Keeper.Domain.Set(ctx, msg.Id, domain); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to transfer domain ownership and creatorship")
+// transferableDomain returns an active domain the signer may transfer: its creator or, for a
+// subdomain, its parent's owner.
+func (k Keeper) transferableDomain(ctx sdk.Context, id uint64, signer string) (types.Domain, error) {
+	domain, errGet := k.Domain.Get(ctx, id)
+	if errGet != nil {
+		if errors.Is(errGet, collections.ErrNotFound) {
+			return types.Domain{}, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "domain with id %d not found", id)
+		}
+		return types.Domain{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get domain for transfer")
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeTransferDomain,
-			sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", msg.Id)),
-			sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
-			sdk.NewAttribute(types.AttributeKeyOldOwner, oldOwner),
-			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner),
-			sdk.NewAttribute("old_creator", oldCreator),   // Evento adicional para claridad
-			sdk.NewAttribute("new_creator", msg.NewOwner), // Evento adicional para claridad
-			sdk.NewAttribute(types.AttributeKeyActor, msg.Creator),
-		),
-	})
+	domain, _, err := k.EffectiveDomain(ctx, domain)
+	if err != nil {
+		return types.Domain{}, errorsmod.Wrap(err, "failed to evaluate domain lifecycle")
+	}
+	if domain.Status != types.DomainStatus_DOMAIN_STATUS_ACTIVE {
+		return types.Domain{}, errorsmod.Wrapf(types.ErrInvalidDomainStatus, "domain %d cannot be transferred while in %s", id, domain.Status)
+	}
 
-	return &types.MsgTransferDomainResponse{}, nil
+	// CORRECCIÓN: Solo el CREADOR original puede transferir la "creatorship".
+	// The owner of a subdomain's parent has the same rights as its creator.
+	parentOwner, err := k.ParentOwner(ctx, domain)
+	if err != nil {
+		return types.Domain{}, errorsmod.Wrap(err, "failed to get parent domain")
+	}
+	if domain.Creator != signer && (parentOwner == "" || signer != parentOwner) {
+		return types.Domain{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the creator %s; only the original creator can transfer the domain", signer, domain.Creator)
+	}
+	return domain, nil
 }
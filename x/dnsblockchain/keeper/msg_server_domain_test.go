@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
@@ -11,6 +14,10 @@ import (
 	"dnsblockchain/x/dnsblockchain/types"
 )
 
+func testNSRecords(name string) []*types.NSRecordWithIP {
+	return []*types.NSRecordWithIP{{Name: "ns1." + name, Ipv4Addresses: []string{"192.0.2.1"}}}
+}
+
 func TestDomainMsgServerCreate(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
@@ -18,20 +25,28 @@ func TestDomainMsgServerCreate(t *testing.T) {
 	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
 	require.NoError(t, err)
 
+	// Invalid name servers are rejected before any fee is charged.
+	badNS := []*types.NSRecordWithIP{{Name: "ns1.test.web3", Ipv4Addresses: []string{"not-an-ip"}}}
+	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "test.web3", Owner: creator, NsRecords: badNS})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "test.web3", Owner: creator})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.True(t, f.bankKeeper.sentToModule.IsZero())
+
 	// Create first domain
-	resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "test.com", Owner: creator, Ns: "ns1.test.com"})
+	resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "test.web3", Owner: creator, NsRecords: testNSRecords("test.web3")})
 	require.NoError(t, err)
 	require.Equal(t, 0, int(resp.Id))
 
 	// Try to create domain with the same name
-	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "test.com", Owner: creator, Ns: "ns2.test.com"})
+	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "test.web3", Owner: creator, NsRecords: testNSRecords("test.web3")})
 	require.Error(t, err)
 	require.ErrorIs(t, err, types.ErrDuplicateDomainName)
 
 	// Create a few more unique domains
 	for i := 0; i < 5; i++ {
-		name := fmt.Sprintf("test%d.com", i)
-		resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: name, Owner: creator, Ns: "ns1." + name})
+		name := fmt.Sprintf("test%d.web3", i)
+		resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: name, Owner: creator, NsRecords: testNSRecords(name)})
 		require.NoError(t, err)
 		// ID will be i+1 because the first domain had ID 0
 		require.Equal(t, i+1, int(resp.Id))
@@ -48,7 +63,7 @@ func TestDomainMsgServerUpdate(t *testing.T) {
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator})
+	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "test.web3", Owner: creator, NsRecords: testNSRecords("test.web3")})
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -73,7 +88,7 @@ func TestDomainMsgServerUpdate(t *testing.T) {
 		},
 		{
 			desc:    "completed",
-			request: &types.MsgUpdateDomain{Creator: creator},
+			request: &types.MsgUpdateDomain{Creator: creator, NsRecords: testNSRecords("other.web3")},
 		},
 	}
 	for _, tc := range tests {
@@ -98,7 +113,7 @@ func TestDomainMsgServerDelete(t *testing.T) {
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator})
+	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "test.web3", Owner: creator, NsRecords: testNSRecords("test.web3")})
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -137,3 +152,455 @@ func TestDomainMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDomainMsgServerCreateReclaimsExpiredName(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "lapsed.web3", Owner: creator, NsRecords: testNSRecords("lapsed.web3")})
+	require.NoError(t, err)
+	oldID := resp.Id
+
+	// Still live: the name is taken.
+	_, err = srv.CreateDomain(ctx.WithBlockTime(now.AddDate(0, 6, 0)), &types.MsgCreateDomain{Creator: other, Name: "lapsed.web3", Owner: other, NsRecords: testNSRecords("lapsed.web3")})
+	require.ErrorIs(t, err, types.ErrDuplicateDomainName)
+
+	// Expired but still in its grace period: the name is still taken.
+	_, err = srv.CreateDomain(ctx.WithBlockTime(now.AddDate(1, 0, 1)), &types.MsgCreateDomain{Creator: other, Name: "lapsed.web3", Owner: other, NsRecords: testNSRecords("lapsed.web3")})
+	require.ErrorIs(t, err, types.ErrDuplicateDomainName)
+
+	// Past the whole lifecycle, before any sweep: the name is reclaimed inline.
+	expiredCtx := ctx.WithBlockTime(now.AddDate(1, 0, 70))
+	resp, err = srv.CreateDomain(expiredCtx, &types.MsgCreateDomain{Creator: other, Name: "lapsed.web3", Owner: other, NsRecords: testNSRecords("lapsed.web3")})
+	require.NoError(t, err)
+	require.NotEqual(t, oldID, resp.Id)
+
+	has, err := f.keeper.Domain.Has(expiredCtx, oldID)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	id, err := f.keeper.DomainName.Get(expiredCtx, "lapsed.web3")
+	require.NoError(t, err)
+	require.Equal(t, resp.Id, id)
+}
+
+func TestDomainLifecycleEnforcement(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr_______________"))
+	require.NoError(t, err)
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "cycle.web3", Owner: owner, NsRecords: testNSRecords("cycle.web3")})
+	require.NoError(t, err)
+	id := resp.Id
+
+	// Grace: transfers are rejected, only the owner can renew.
+	graceCtx := ctx.WithBlockTime(now.AddDate(1, 0, 1))
+	_, err = srv.TransferDomain(graceCtx, &types.MsgTransferDomain{Creator: creator, Id: id, NewOwner: newOwner, Direct: true})
+	require.ErrorIs(t, err, types.ErrInvalidDomainStatus)
+	_, err = srv.HeartbeatDomain(graceCtx, &types.MsgHeartbeatDomain{Creator: creator, Id: id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	byName, err := keeper.NewQueryServerImpl(f.keeper).GetDomainByName(graceCtx, &types.QueryGetDomainByNameRequest{Name: "cycle.web3"})
+	require.NoError(t, err)
+	require.True(t, byName.Expired)
+	require.Equal(t, types.DomainStatus_DOMAIN_STATUS_GRACE, byName.Domain.Status)
+
+	_, err = srv.HeartbeatDomain(graceCtx, &types.MsgHeartbeatDomain{Creator: owner, Id: id})
+	require.NoError(t, err)
+	domain, err := f.keeper.Domain.Get(graceCtx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.DomainStatus_DOMAIN_STATUS_ACTIVE, domain.Status)
+
+	// Redemption: updates are rejected and restoring charges the restore fee.
+	redemptionCtx := ctx.WithBlockTime(time.Unix(int64(domain.Expiration+params.GracePeriod+1), 0))
+	_, err = srv.UpdateDomain(redemptionCtx, &types.MsgUpdateDomain{Creator: owner, Id: id, NsRecords: testNSRecords("other.web3")})
+	require.ErrorIs(t, err, types.ErrInvalidDomainStatus)
+
+	burnedBefore := f.bankKeeper.sentToModule
+	_, err = srv.HeartbeatDomain(redemptionCtx, &types.MsgHeartbeatDomain{Creator: owner, Id: id})
+	require.NoError(t, err)
+	require.Equal(t, burnedBefore.Add(params.RestoreFee...).Add(params.RenewalFee("cycle.web3", 1)...), f.bankKeeper.sentToModule)
+
+	// Pending delete: nothing can bring the domain back.
+	domain, err = f.keeper.Domain.Get(redemptionCtx, id)
+	require.NoError(t, err)
+	pendingCtx := ctx.WithBlockTime(time.Unix(int64(domain.Expiration+params.GracePeriod+params.RedemptionPeriod+1), 0))
+	_, err = srv.HeartbeatDomain(pendingCtx, &types.MsgHeartbeatDomain{Creator: owner, Id: id})
+	require.ErrorIs(t, err, types.ErrInvalidDomainStatus)
+}
+
+func TestDomainMultiYearRegistrationAndRenewal(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MaxRegistrationYears = 5
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	// Registering beyond the maximum term is rejected.
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "long.web3", Owner: creator, NsRecords: testNSRecords("long.web3"), Years: 6})
+	require.ErrorIs(t, err, types.ErrInvalidRegistrationYears)
+
+	// A three-year registration pays the creation fee plus two years of renewal.
+	resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "long.web3", Owner: creator, NsRecords: testNSRecords("long.web3"), Years: 3})
+	require.NoError(t, err)
+	require.Equal(t, params.DomainCreationFee.Add(params.DomainRenewalFee...).Add(params.DomainRenewalFee...), params.RegistrationFee("long.web3", 3))
+	require.Equal(t, params.RegistrationFee("long.web3", 3), f.bankKeeper.sentToModule)
+
+	domain, err := f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(now.AddDate(3, 0, 0).Unix()), domain.Expiration)
+
+	// Renewal extends from the current expiration, not from the block time.
+	later := ctx.WithBlockTime(now.AddDate(0, 6, 0))
+	burnedBefore := f.bankKeeper.sentToModule
+	renewResp, err := srv.RenewDomain(later, &types.MsgRenewDomain{Creator: creator, Id: resp.Id, Years: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(now.AddDate(5, 0, 0).Unix()), renewResp.Expiration)
+	require.Equal(t, burnedBefore.Add(params.RenewalFee("long.web3", 2)...), f.bankKeeper.sentToModule)
+
+	// Five and a half years of remaining term exceeds the cap.
+	_, err = srv.RenewDomain(later, &types.MsgRenewDomain{Creator: creator, Id: resp.Id, Years: 1})
+	require.ErrorIs(t, err, types.ErrInvalidRegistrationYears)
+
+	// Strangers cannot renew.
+	_, err = srv.RenewDomain(ctx.WithBlockTime(now.AddDate(1, 0, 0)), &types.MsgRenewDomain{Creator: other, Id: resp.Id, Years: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Heartbeats are paid one-year renewals.
+	burnedBefore = f.bankKeeper.sentToModule
+	_, err = srv.HeartbeatDomain(ctx.WithBlockTime(now.AddDate(1, 0, 0)), &types.MsgHeartbeatDomain{Creator: creator, Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, burnedBefore.Add(params.RenewalFee("long.web3", 1)...), f.bankKeeper.sentToModule)
+	domain, err = f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(now.AddDate(6, 0, 0).Unix()), domain.Expiration)
+}
+
+func TestDomainMsgServerCreateChargesPricingSchedule(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.PremiumNames = []types.PremiumName{{
+		Name:            "bank.web3",
+		RegistrationFee: sdk.NewCoins(sdk.NewInt64Coin("udns", 9000000000)),
+		RenewalFee:      sdk.NewCoins(sdk.NewInt64Coin("udns", 1000000000)),
+	}}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	for _, tc := range []struct {
+		name string
+		fee  sdk.Coins
+	}{
+		{name: "ab.web3", fee: params.PriceTiers[0].RegistrationFee},
+		{name: "abc.web3", fee: params.PriceTiers[1].RegistrationFee},
+		{name: "abcd.web3", fee: params.DomainCreationFee},
+		{name: "bank.web3", fee: params.PremiumNames[0].RegistrationFee},
+	} {
+		burnedBefore := f.bankKeeper.sentToModule
+		_, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: tc.name, Owner: creator, NsRecords: testNSRecords(tc.name)})
+		require.NoError(t, err, tc.name)
+		require.Equal(t, burnedBefore.Add(tc.fee...), f.bankKeeper.sentToModule, tc.name)
+	}
+}
+
+func TestDomainMsgServerTransferAndUpdateFees(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr_______________"))
+	require.NoError(t, err)
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "fees.web3", Owner: creator, NsRecords: testNSRecords("fees.web3")})
+	require.NoError(t, err)
+
+	charged := f.bankKeeper.sentToModule
+	_, err = srv.UpdateDomain(f.ctx, &types.MsgUpdateDomain{Creator: creator, Id: resp.Id, NsRecords: testNSRecords("other.web3")})
+	require.NoError(t, err)
+	require.Equal(t, charged.Add(params.DomainUpdateFee...), f.bankKeeper.sentToModule)
+
+	charged = f.bankKeeper.sentToModule
+	_, err = srv.TransferDomain(f.ctx, &types.MsgTransferDomain{Creator: creator, Id: resp.Id, NewOwner: newOwner, Direct: true})
+	require.NoError(t, err)
+	require.Equal(t, charged.Add(params.DomainTransferFee...), f.bankKeeper.sentToModule)
+
+	// Zero fees make updates free.
+	params.DomainUpdateFee = sdk.NewCoins()
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	charged = f.bankKeeper.sentToModule
+	_, err = srv.UpdateDomain(f.ctx, &types.MsgUpdateDomain{Creator: newOwner, Id: resp.Id, NsRecords: testNSRecords("fees.web3")})
+	require.NoError(t, err)
+	require.Equal(t, charged, f.bankKeeper.sentToModule)
+}
+
+func TestDomainMsgServerSubdomains(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	parentOwner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	holder, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	// A subdomain needs a registered parent.
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: parentOwner, Name: "www.example.web3", Owner: holder, NsRecords: testNSRecords("www.example.web3")})
+	require.ErrorIs(t, err, types.ErrInvalidParentDomain)
+
+	parentResp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: parentOwner, Name: "example.web3", Owner: parentOwner, NsRecords: testNSRecords("example.web3"), Years: 2})
+	require.NoError(t, err)
+	parent, err := f.keeper.Domain.Get(ctx, parentResp.Id)
+	require.NoError(t, err)
+
+	// Only the parent's owner may create children.
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: holder, Name: "www.example.web3", Owner: holder, NsRecords: testNSRecords("www.example.web3")})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Subdomains are free and cannot outlive their parent.
+	charged := f.bankKeeper.sentToModule
+	childResp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: parentOwner, Name: "www.example.web3", Owner: holder, NsRecords: testNSRecords("www.example.web3"), Years: 5})
+	require.NoError(t, err)
+	require.Equal(t, charged, f.bankKeeper.sentToModule)
+	child, err := f.keeper.Domain.Get(ctx, childResp.Id)
+	require.NoError(t, err)
+	require.Equal(t, "example.web3", child.Parent)
+	require.Equal(t, parent.Expiration, child.Expiration)
+
+	grandchildResp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: holder, Name: "api.www.example.web3", Owner: holder, NsRecords: testNSRecords("api.www.example.web3")})
+	require.NoError(t, err)
+
+	// Renewing a child past its parent's expiration is rejected.
+	_, err = srv.RenewDomain(ctx, &types.MsgRenewDomain{Creator: holder, Id: childResp.Id, Years: 1})
+	require.ErrorIs(t, err, types.ErrInvalidRegistrationYears)
+
+	// The parent's owner can update and revoke the child even though it does not own it.
+	_, err = srv.UpdateDomain(ctx, &types.MsgUpdateDomain{Creator: parentOwner, Id: childResp.Id, Owner: parentOwner, NsRecords: testNSRecords("www.example.web3")})
+	require.NoError(t, err)
+	_, err = srv.TransferDomain(ctx, &types.MsgTransferDomain{Creator: parentOwner, Id: childResp.Id, NewOwner: holder, Direct: true})
+	require.NoError(t, err)
+
+	// Lookups fall back to the longest registered suffix.
+	byName, err := qs.GetDomainByName(ctx, &types.QueryGetDomainByNameRequest{Name: "deep.api.www.example.web3"})
+	require.NoError(t, err)
+	require.True(t, byName.Found)
+	require.False(t, byName.Exact)
+	require.Equal(t, "api.www.example.web3", byName.Domain.Name)
+	byName, err = qs.GetDomainByName(ctx, &types.QueryGetDomainByNameRequest{Name: "www.example.web3"})
+	require.NoError(t, err)
+	require.True(t, byName.Exact)
+	byName, err = qs.GetDomainByName(ctx, &types.QueryGetDomainByNameRequest{Name: "other.web3"})
+	require.NoError(t, err)
+	require.False(t, byName.Found)
+
+	_, err = srv.DeleteDomain(ctx, &types.MsgDeleteDomain{Creator: parentOwner, Id: grandchildResp.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.DeleteDomain(ctx, &types.MsgDeleteDomain{Creator: holder, Id: grandchildResp.Id})
+	require.NoError(t, err)
+	grandchildResp, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: holder, Name: "api.www.example.web3", Owner: holder, NsRecords: testNSRecords("api.www.example.web3")})
+	require.NoError(t, err)
+
+	// Deleting the parent removes the whole subtree.
+	_, err = srv.DeleteDomain(ctx, &types.MsgDeleteDomain{Creator: parentOwner, Id: parentResp.Id})
+	require.NoError(t, err)
+	for _, id := range []uint64{parentResp.Id, childResp.Id, grandchildResp.Id} {
+		has, err := f.keeper.Domain.Has(ctx, id)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+	has, err := f.keeper.DomainName.Has(ctx, "www.example.web3")
+	require.NoError(t, err)
+	require.False(t, has)
+	stats, err := f.keeper.GetTLDStats(ctx, "web3")
+	require.NoError(t, err)
+	require.Zero(t, stats.DomainCount)
+}
+
+func TestSubdomainsReclaimedWithParent(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	parentResp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "parent.web3", Owner: creator, NsRecords: testNSRecords("parent.web3")})
+	require.NoError(t, err)
+	childResp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "child.parent.web3", Owner: creator, NsRecords: testNSRecords("child.parent.web3")})
+	require.NoError(t, err)
+
+	sweep := ctx.WithBlockTime(now.AddDate(1, 0, 70)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.EndBlocker(sweep))
+	require.NoError(t, f.keeper.EndBlocker(sweep))
+
+	for _, id := range []uint64{parentResp.Id, childResp.Id} {
+		has, err := f.keeper.Domain.Has(sweep, id)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+	var removed int
+	for _, ev := range sweep.EventManager().Events() {
+		if ev.Type == types.EventTypeRemoveSubdomain {
+			removed++
+		}
+	}
+	require.Equal(t, 1, removed)
+	stats, err := f.keeper.GetTLDStats(sweep, "web3")
+	require.NoError(t, err)
+	require.Zero(t, stats.DomainCount)
+}
+
+func TestDomainMsgServerSetRecords(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MaxRecordsPerDomain = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "records.web3", Owner: owner, NsRecords: testNSRecords("records.web3")})
+	require.NoError(t, err)
+
+	records := []*types.ResourceRecord{
+		{Name: "@", Type: types.RecordType_RECORD_TYPE_A, Value: "192.0.2.10", Ttl: 300},
+		{Name: "WWW", Type: types.RecordType_RECORD_TYPE_CNAME, Value: "records.web3"},
+	}
+
+	// Only the owner can publish records.
+	_, err = srv.SetDomainRecords(f.ctx, &types.MsgSetDomainRecords{Creator: creator, Id: resp.Id, Records: records})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The per-domain limit and per-type validation are enforced.
+	extra := append([]*types.ResourceRecord{{Type: types.RecordType_RECORD_TYPE_TXT, Value: "x"}}, records...)
+	_, err = srv.SetDomainRecords(f.ctx, &types.MsgSetDomainRecords{Creator: owner, Id: resp.Id, Records: extra})
+	require.ErrorIs(t, err, types.ErrTooManyRecords)
+	_, err = srv.SetDomainRecords(f.ctx, &types.MsgSetDomainRecords{Creator: owner, Id: resp.Id, Records: []*types.ResourceRecord{{Type: types.RecordType_RECORD_TYPE_A, Value: "::1"}}})
+	require.ErrorIs(t, err, types.ErrInvalidResourceRecord)
+
+	charged := f.bankKeeper.sentToModule
+	_, err = srv.SetDomainRecords(f.ctx, &types.MsgSetDomainRecords{Creator: owner, Id: resp.Id, Records: records, ClearNsRecords: true})
+	require.NoError(t, err)
+	require.Equal(t, charged.Add(params.DomainUpdateFee...), f.bankKeeper.sentToModule)
+
+	domain, err := f.keeper.Domain.Get(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Empty(t, domain.NsRecords)
+	require.Len(t, domain.Records, 2)
+	require.Equal(t, "", domain.Records[0].Name)
+	require.Equal(t, "www", domain.Records[1].Name)
+
+	// Queries return the records published for the requested name.
+	recs, err := qs.DomainRecords(f.ctx, &types.QueryDomainRecordsRequest{Name: "www.records.web3"})
+	require.NoError(t, err)
+	require.True(t, recs.Found)
+	require.Equal(t, "records.web3", recs.Domain)
+	require.Len(t, recs.Records, 1)
+	require.Equal(t, types.RecordType_RECORD_TYPE_CNAME, recs.Records[0].Type)
+	require.Empty(t, recs.NsRecords)
+
+	recs, err = qs.DomainRecords(f.ctx, &types.QueryDomainRecordsRequest{Name: "records.web3", Type: types.RecordType_RECORD_TYPE_AAAA})
+	require.NoError(t, err)
+	require.True(t, recs.Found)
+	require.Empty(t, recs.Records)
+
+	recs, err = qs.DomainRecords(f.ctx, &types.QueryDomainRecordsRequest{Name: "missing.web3"})
+	require.NoError(t, err)
+	require.False(t, recs.Found)
+
+	byName, err := qs.GetDomainByName(f.ctx, &types.QueryGetDomainByNameRequest{Name: "records.web3"})
+	require.NoError(t, err)
+	require.Len(t, byName.Domain.Records, 2)
+
+	// An empty set removes every record.
+	_, err = srv.SetDomainRecords(f.ctx, &types.MsgSetDomainRecords{Creator: owner, Id: resp.Id})
+	require.NoError(t, err)
+	domain, err = f.keeper.Domain.Get(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Empty(t, domain.Records)
+}
+
+func TestDomainMsgServerSetDSRecords(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: owner, Name: "secure.web3", Owner: owner, NsRecords: testNSRecords("secure.web3")})
+	require.NoError(t, err)
+
+	ds := []*types.DSRecord{{KeyTag: 2371, Algorithm: 13, DigestType: 2, Digest: strings.Repeat("ab", 32)}}
+
+	_, err = srv.SetDomainDSRecords(f.ctx, &types.MsgSetDomainDSRecords{Creator: other, Id: resp.Id, DsRecords: ds})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetDomainDSRecords(f.ctx, &types.MsgSetDomainDSRecords{Creator: owner, Id: resp.Id, DsRecords: []*types.DSRecord{{KeyTag: 1, Algorithm: 13, DigestType: 2, Digest: "abcd"}}})
+	require.ErrorIs(t, err, types.ErrInvalidDSRecord)
+
+	_, err = srv.SetDomainDSRecords(f.ctx, &types.MsgSetDomainDSRecords{Creator: owner, Id: resp.Id, DsRecords: ds})
+	require.NoError(t, err)
+
+	// Queries expose the DS records with the delegation, digests normalized to upper case.
+	recs, err := qs.DomainRecords(f.ctx, &types.QueryDomainRecordsRequest{Name: "host.secure.web3"})
+	require.NoError(t, err)
+	require.Len(t, recs.DsRecords, 1)
+	require.Equal(t, strings.Repeat("AB", 32), recs.DsRecords[0].Digest)
+	require.NotEmpty(t, recs.NsRecords)
+
+	// Removing the delegation removes the DS records with it, and they cannot be set again without one.
+	_, err = srv.SetDomainRecords(f.ctx, &types.MsgSetDomainRecords{Creator: owner, Id: resp.Id, ClearNsRecords: true})
+	require.NoError(t, err)
+	domain, err := f.keeper.Domain.Get(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Empty(t, domain.DsRecords)
+	_, err = srv.SetDomainDSRecords(f.ctx, &types.MsgSetDomainDSRecords{Creator: owner, Id: resp.Id, DsRecords: ds})
+	require.ErrorIs(t, err, types.ErrInvalidDSRecord)
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// ListDomain puts a domain up for sale at a fixed price. Whoever may transfer the domain directly
+// may list it and receives the proceeds.
+func (k msgServer) ListDomain(goCtx context.Context, msg *types.MsgListDomain) (*types.MsgListDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	if !msg.Price.IsValid() || !msg.Price.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrice, "invalid price %s", msg.Price)
+	}
+
+	domain, err := k.transferableDomain(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	listing := types.Listing{
+		DomainId: domain.Id,
+		Name:     domain.Name,
+		Seller:   msg.Creator,
+		Price:    msg.Price,
+	}
+	if err := k.Listings.Set(ctx, domain.Id, listing); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set listing")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeListDomain,
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", domain.Id)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+		sdk.NewAttribute(types.AttributeKeySeller, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+	))
+	return &types.MsgListDomainResponse{}, nil
+}
+
+// UnlistDomain withdraws a domain from sale. Its seller and whoever may transfer the domain may
+// unlist it.
+func (k msgServer) UnlistDomain(goCtx context.Context, msg *types.MsgUnlistDomain) (*types.MsgUnlistDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	listing, err := k.getListing(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Creator != listing.Seller {
+		if _, err := k.transferableDomain(ctx, msg.Id, msg.Creator); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s cannot unlist domain %d", msg.Creator, msg.Id)
+		}
+	}
+	if err := k.RemoveListing(ctx, msg.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove listing")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnlistDomain,
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", listing.DomainId)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, listing.Name),
+		sdk.NewAttribute(types.AttributeKeyActor, msg.Creator),
+	))
+	return &types.MsgUnlistDomainResponse{}, nil
+}
+
+// BuyDomain buys a listed domain at its listed price: the payment reaches the seller and the
+// domain the buyer in the same transaction. The listing only stands while its seller may still
+// transfer the domain.
+func (k msgServer) BuyDomain(goCtx context.Context, msg *types.MsgBuyDomain) (*types.MsgBuyDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	buyerAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	listing, err := k.getListing(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Price.Denom != listing.Price.Denom || !msg.Price.Amount.Equal(listing.Price.Amount) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrice, "domain %d is listed at %s, not %s", msg.Id, listing.Price, msg.Price)
+	}
+	if msg.Creator == listing.Seller {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s cannot buy its own listing", msg.Creator)
+	}
+	domain, err := k.transferableDomain(ctx, msg.Id, listing.Seller)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "listing no longer stands")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(buyerAddr), types.ModuleName, sdk.NewCoins(listing.Price)); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to collect payment from %s", msg.Creator)
+	}
+	// Moving the domain clears the listing.
+	if err := k.Keeper.sellDomain(ctx, domain, listing.Seller, msg.Creator, listing.Price, msg.Creator); err != nil {
+		return nil, err
+	}
+	return &types.MsgBuyDomainResponse{}, nil
+}
+
+// PlaceMarketOffer escrows an offer to buy an active domain, replacing and refunding the signer's
+// previous offer for it.
+func (k msgServer) PlaceMarketOffer(goCtx context.Context, msg *types.MsgPlaceMarketOffer) (*types.MsgPlaceMarketOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	buyerAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrice, "invalid offer amount %s", msg.Amount)
+	}
+
+	domain, err := k.Keeper.Domain.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "domain with id %d not found", msg.Id)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get domain")
+	}
+	if domain, _, err = k.Keeper.EffectiveDomain(ctx, domain); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to evaluate domain lifecycle")
+	}
+	if domain.Status != types.DomainStatus_DOMAIN_STATUS_ACTIVE {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDomainStatus, "domain %d cannot be bought while in %s", msg.Id, domain.Status)
+	}
+	if domain.Creator == msg.Creator {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "domain %d is already held by %s", msg.Id, msg.Creator)
+	}
+
+	if prev, found, err := k.GetMarketOffer(ctx, msg.Id, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get market offer")
+	} else if found {
+		if err := k.withdrawMarketOffer(ctx, prev); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(buyerAddr), types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to escrow offer from %s", msg.Creator)
+	}
+	offer := types.MarketOffer{
+		DomainId:        domain.Id,
+		Name:            domain.Name,
+		Buyer:           msg.Creator,
+		Amount:          msg.Amount,
+		DomainTransfers: domain.Transfers,
+	}
+	if err := k.MarketOffers.Set(ctx, collections.Join(domain.Id, msg.Creator), offer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set market offer")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePlaceMarketOffer,
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", domain.Id)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+		sdk.NewAttribute(types.AttributeKeyBuyer, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPrice, msg.Amount.String()),
+	))
+	return &types.MsgPlaceMarketOfferResponse{}, nil
+}
+
+// WithdrawMarketOffer withdraws the signer's offer for a domain and refunds it.
+func (k msgServer) WithdrawMarketOffer(goCtx context.Context, msg *types.MsgWithdrawMarketOffer) (*types.MsgWithdrawMarketOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	offer, err := k.getMarketOffer(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.withdrawMarketOffer(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawMarketOffer,
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", offer.DomainId)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, offer.Name),
+		sdk.NewAttribute(types.AttributeKeyBuyer, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyRefund, offer.Amount.String()),
+	))
+	return &types.MsgWithdrawMarketOfferResponse{}, nil
+}
+
+// AcceptMarketOffer sells a domain to the maker of an offer for its escrowed amount. Whoever may
+// transfer the domain directly may accept offers for it, except those that lapsed when the domain
+// last changed hands.
+func (k msgServer) AcceptMarketOffer(goCtx context.Context, msg *types.MsgAcceptMarketOffer) (*types.MsgAcceptMarketOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	domain, err := k.transferableDomain(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	offer, err := k.getMarketOffer(ctx, msg.Id, msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+	if offer.DomainTransfers != domain.Transfers {
+		return nil, errorsmod.Wrapf(types.ErrMarketOfferLapsed, "offer of %s was made before domain %d last changed hands", msg.Buyer, msg.Id)
+	}
+	if err := k.MarketOffers.Remove(ctx, collections.Join(offer.DomainId, offer.Buyer)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove market offer")
+	}
+	if err := k.Keeper.sellDomain(ctx, domain, msg.Creator, offer.Buyer, offer.Amount, msg.Creator); err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptMarketOfferResponse{}, nil
+}
+
+// getListing returns the listing of a domain, or ErrListingNotFound.
+func (k msgServer) getListing(ctx context.Context, id uint64) (types.Listing, error) {
+	listing, found, err := k.GetListing(ctx, id)
+	if err != nil {
+		return types.Listing{}, errorsmod.Wrap(err, "failed to get listing")
+	}
+	if !found {
+		return types.Listing{}, errorsmod.Wrapf(types.ErrListingNotFound, "domain %d is not listed", id)
+	}
+	return listing, nil
+}
+
+// getMarketOffer returns the offer of a buyer for a domain, or ErrMarketOfferNotFound.
+func (k msgServer) getMarketOffer(ctx context.Context, id uint64, buyer string) (types.MarketOffer, error) {
+	offer, found, err := k.GetMarketOffer(ctx, id, buyer)
+	if err != nil {
+		return types.MarketOffer{}, errorsmod.Wrap(err, "failed to get market offer")
+	}
+	if !found {
+		return types.MarketOffer{}, errorsmod.Wrapf(types.ErrMarketOfferNotFound, "%s has no offer for domain %d", buyer, id)
+	}
+	return offer, nil
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestMarketplaceListing(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	addrs := bidders(t, f, 3)
+	registrant, seller, buyer := addrs[0], addrs[1], addrs[2]
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	params := types.DefaultParams()
+	params.MarketRoyaltyBps = 1000
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: registrant, Name: "market.web3", Owner: registrant, NsRecords: testNSRecords("market.web3")})
+	require.NoError(t, err)
+	_, err = srv.TransferDomain(ctx, &types.MsgTransferDomain{Creator: registrant, Id: resp.Id, NewOwner: seller, Direct: true})
+	require.NoError(t, err)
+
+	_, err = srv.ListDomain(ctx, &types.MsgListDomain{Creator: buyer, Id: resp.Id, Price: udns(1000)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ListDomain(ctx, &types.MsgListDomain{Creator: seller, Id: resp.Id, Price: udns(1000)})
+	require.NoError(t, err)
+	listings, err := qs.ListListings(ctx, &types.QueryListListingsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Listing{{DomainId: resp.Id, Name: "market.web3", Seller: seller, Price: udns(1000)}}, listings.Listings)
+
+	// A listing repriced while the purchase is in flight is not bought.
+	_, err = srv.BuyDomain(ctx, &types.MsgBuyDomain{Creator: buyer, Id: resp.Id, Price: udns(900)})
+	require.ErrorIs(t, err, types.ErrInvalidPrice)
+
+	charged := f.bankKeeper.sentToModule
+	_, err = srv.BuyDomain(ctx, &types.MsgBuyDomain{Creator: buyer, Id: resp.Id, Price: udns(1000)})
+	require.NoError(t, err)
+	require.Equal(t, charged.Add(udns(1000)).Add(params.DomainTransferFee...), f.bankKeeper.sentToModule)
+	// The original creator receives the royalty and the seller the rest.
+	require.Equal(t, sdk.NewCoins(udns(100)), f.bankKeeper.paidTo[registrant])
+	require.Equal(t, sdk.NewCoins(udns(900)), f.bankKeeper.paidTo[seller])
+
+	domain, err := f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, buyer, domain.Creator)
+	require.Equal(t, buyer, domain.Owner)
+	require.Equal(t, registrant, domain.OriginalCreator)
+	_, found, err := f.keeper.GetListing(ctx, resp.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// Listings are cleared when the domain is transferred, expires or is deleted.
+	_, err = srv.ListDomain(ctx, &types.MsgListDomain{Creator: buyer, Id: resp.Id, Price: udns(5000)})
+	require.NoError(t, err)
+	_, err = srv.TransferDomain(ctx, &types.MsgTransferDomain{Creator: buyer, Id: resp.Id, NewOwner: seller, Direct: true})
+	require.NoError(t, err)
+	_, found, err = f.keeper.GetListing(ctx, resp.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, err = srv.ListDomain(ctx, &types.MsgListDomain{Creator: seller, Id: resp.Id, Price: udns(5000)})
+	require.NoError(t, err)
+	expired := ctx.WithBlockTime(time.Unix(int64(domain.Expiration)+1, 0))
+	_, err = srv.BuyDomain(expired, &types.MsgBuyDomain{Creator: buyer, Id: resp.Id, Price: udns(5000)})
+	require.ErrorIs(t, err, types.ErrInvalidDomainStatus)
+	require.NoError(t, f.keeper.EndBlocker(expired))
+	_, found, err = f.keeper.GetListing(expired, resp.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	other, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: seller, Name: "other.web3", Owner: seller, NsRecords: testNSRecords("other.web3")})
+	require.NoError(t, err)
+	_, err = srv.ListDomain(ctx, &types.MsgListDomain{Creator: seller, Id: other.Id, Price: udns(5000)})
+	require.NoError(t, err)
+	_, err = srv.DeleteDomain(ctx, &types.MsgDeleteDomain{Creator: seller, Id: other.Id})
+	require.NoError(t, err)
+	_, found, err = f.keeper.GetListing(ctx, other.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestMarketplaceOffers(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	addrs := bidders(t, f, 4)
+	alice, bob, carol, dave := addrs[0], addrs[1], addrs[2], addrs[3]
+
+	params := types.DefaultParams()
+	params.MarketRoyaltyBps = 1000
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: alice, Name: "offers.web3", Owner: alice, NsRecords: testNSRecords("offers.web3")})
+	require.NoError(t, err)
+
+	// A new offer replaces and refunds the previous one.
+	_, err = srv.PlaceMarketOffer(f.ctx, &types.MsgPlaceMarketOffer{Creator: bob, Id: resp.Id, Amount: udns(50)})
+	require.NoError(t, err)
+	_, err = srv.PlaceMarketOffer(f.ctx, &types.MsgPlaceMarketOffer{Creator: bob, Id: resp.Id, Amount: udns(70)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(udns(50)), f.bankKeeper.paidTo[bob])
+	_, err = srv.PlaceMarketOffer(f.ctx, &types.MsgPlaceMarketOffer{Creator: carol, Id: resp.Id, Amount: udns(60)})
+	require.NoError(t, err)
+	_, err = srv.PlaceMarketOffer(f.ctx, &types.MsgPlaceMarketOffer{Creator: alice, Id: resp.Id, Amount: udns(60)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	offers, err := qs.MarketOffers(f.ctx, &types.QueryMarketOffersRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.MarketOffer{
+		{DomainId: resp.Id, Name: "offers.web3", Buyer: bob, Amount: udns(70)},
+		{DomainId: resp.Id, Name: "offers.web3", Buyer: carol, Amount: udns(60)},
+	}, offers.Offers)
+
+	_, err = srv.AcceptMarketOffer(f.ctx, &types.MsgAcceptMarketOffer{Creator: carol, Id: resp.Id, Buyer: bob})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.AcceptMarketOffer(f.ctx, &types.MsgAcceptMarketOffer{Creator: alice, Id: resp.Id, Buyer: dave})
+	require.ErrorIs(t, err, types.ErrMarketOfferNotFound)
+
+	// Selling as the original creator pays no royalty.
+	_, err = srv.AcceptMarketOffer(f.ctx, &types.MsgAcceptMarketOffer{Creator: alice, Id: resp.Id, Buyer: bob})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(udns(70)), f.bankKeeper.paidTo[alice])
+	domain, err := f.keeper.Domain.Get(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, bob, domain.Creator)
+
+	require.Equal(t, uint64(1), domain.Transfers)
+
+	// Other offers were made to the previous creator: they lapse with the sale and can only be
+	// withdrawn.
+	_, err = srv.AcceptMarketOffer(f.ctx, &types.MsgAcceptMarketOffer{Creator: bob, Id: resp.Id, Buyer: carol})
+	require.ErrorIs(t, err, types.ErrMarketOfferLapsed)
+	_, err = srv.WithdrawMarketOffer(f.ctx, &types.MsgWithdrawMarketOffer{Creator: carol, Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(udns(60)), f.bankKeeper.paidTo[carol])
+	_, err = srv.WithdrawMarketOffer(f.ctx, &types.MsgWithdrawMarketOffer{Creator: carol, Id: resp.Id})
+	require.ErrorIs(t, err, types.ErrMarketOfferNotFound)
+
+	// So do they with any other change of hands, even one back to a previous creator.
+	_, err = srv.PlaceMarketOffer(f.ctx, &types.MsgPlaceMarketOffer{Creator: carol, Id: resp.Id, Amount: udns(90)})
+	require.NoError(t, err)
+	_, err = srv.TransferDomain(f.ctx, &types.MsgTransferDomain{Creator: bob, Id: resp.Id, NewOwner: alice, Direct: true})
+	require.NoError(t, err)
+	_, err = srv.TransferDomain(f.ctx, &types.MsgTransferDomain{Creator: alice, Id: resp.Id, NewOwner: bob, Direct: true})
+	require.NoError(t, err)
+	_, err = srv.AcceptMarketOffer(f.ctx, &types.MsgAcceptMarketOffer{Creator: bob, Id: resp.Id, Buyer: carol})
+	require.ErrorIs(t, err, types.ErrMarketOfferLapsed)
+
+	// Deleting the domain leaves its offers to be withdrawn.
+	_, err = srv.PlaceMarketOffer(f.ctx, &types.MsgPlaceMarketOffer{Creator: dave, Id: resp.Id, Amount: udns(80)})
+	require.NoError(t, err)
+	_, err = srv.DeleteDomain(f.ctx, &types.MsgDeleteDomain{Creator: bob, Id: resp.Id})
+	require.NoError(t, err)
+	require.Empty(t, f.bankKeeper.paidTo[dave])
+	for _, buyer := range []string{carol, dave} {
+		_, err = srv.WithdrawMarketOffer(f.ctx, &types.MsgWithdrawMarketOffer{Creator: buyer, Id: resp.Id})
+		require.NoError(t, err)
+	}
+	require.Equal(t, sdk.NewCoins(udns(150)), f.bankKeeper.paidTo[carol])
+	require.Equal(t, sdk.NewCoins(udns(80)), f.bankKeeper.paidTo[dave])
+	offers, err = qs.MarketOffers(f.ctx, &types.QueryMarketOffersRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Empty(t, offers.Offers)
+}
+
+func TestGenesisMarketplace(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	addrs := bidders(t, f, 2)
+	alice, bob := addrs[0], addrs[1]
+
+	resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: alice, Name: "genesis.web3", Owner: alice, NsRecords: testNSRecords("genesis.web3")})
+	require.NoError(t, err)
+	_, err = srv.ListDomain(f.ctx, &types.MsgListDomain{Creator: alice, Id: resp.Id, Price: udns(1000)})
+	require.NoError(t, err)
+	_, err = srv.PlaceMarketOffer(f.ctx, &types.MsgPlaceMarketOffer{Creator: bob, Id: resp.Id, Amount: udns(500)})
+	require.NoError(t, err)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Len(t, exported.Listings, 1)
+	require.Len(t, exported.MarketOffers, 1)
+	require.Equal(t, alice, exported.DomainList[0].OriginalCreator)
+	require.NoError(t, exported.Validate())
+
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported.Listings, reexported.Listings)
+	require.Equal(t, exported.MarketOffers, reexported.MarketOffers)
+	require.Equal(t, exported.DomainList, reexported.DomainList)
+}
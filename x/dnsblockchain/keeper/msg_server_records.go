@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// SetDomainRecords replaces the resource records a domain publishes on chain. Only the owner can
+// set them, while the domain is active or in its grace period, and the update fee is charged.
+func (k msgServer) SetDomainRecords(goCtx context.Context, msg *types.MsgSetDomainRecords) (*types.MsgSetDomainRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Keeper.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	domain, err := k.ownedMutableDomain(ctx, msg.Creator, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	if uint64(len(msg.Records)) > params.RecordsPerDomainLimit() {
+		return nil, errorsmod.Wrapf(types.ErrTooManyRecords, "%d records exceed the limit of %d per domain", len(msg.Records), params.RecordsPerDomainLimit())
+	}
+	if err := types.ValidateResourceRecords(msg.Records); err != nil {
+		return nil, err
+	}
+
+	records := make([]*types.ResourceRecord, 0, len(msg.Records))
+	for _, r := range msg.Records {
+		record := *r
+		record.Name = types.NormalizeRecordName(r.Name)
+		records = append(records, &record)
+	}
+
+	if err := k.Keeper.ChargeFee(ctx, msg.Creator, domain.Name, params.DomainUpdateFee, types.FeeTypeUpdate); err != nil {
+		return nil, err
+	}
+
+	domain.Records = records
+	if msg.ClearNsRecords {
+		// DS records only make sense at a delegation point.
+		domain.NsRecords = nil
+		domain.DsRecords = nil
+	}
+	if err := k.Keeper.SetDomain(ctx, domain); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update domain records")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetDomainRecords,
+			sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+			sdk.NewAttribute(types.AttributeKeyUpdater, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyRecordCount, fmt.Sprintf("%d", len(records))),
+		),
+	})
+
+	return &types.MsgSetDomainRecordsResponse{}, nil
+}
+
+// SetDomainDSRecords replaces the DNSSEC delegation signer records of a domain. Only the owner can
+// set them, while the domain is active or in its grace period, and only on a domain delegated
+// through NS records. The update fee is charged.
+func (k msgServer) SetDomainDSRecords(goCtx context.Context, msg *types.MsgSetDomainDSRecords) (*types.MsgSetDomainDSRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Keeper.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	domain, err := k.ownedMutableDomain(ctx, msg.Creator, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateDSRecords(msg.DsRecords); err != nil {
+		return nil, err
+	}
+	if len(msg.DsRecords) > 0 && len(domain.NsRecords) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDSRecord, "domain %s has no NS delegation to secure", domain.Name)
+	}
+
+	dsRecords := make([]*types.DSRecord, 0, len(msg.DsRecords))
+	for _, r := range msg.DsRecords {
+		record := *r
+		record.Digest = strings.ToUpper(r.Digest)
+		dsRecords = append(dsRecords, &record)
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	if err := k.Keeper.ChargeFee(ctx, msg.Creator, domain.Name, params.DomainUpdateFee, types.FeeTypeUpdate); err != nil {
+		return nil, err
+	}
+
+	domain.DsRecords = dsRecords
+	if err := k.Keeper.SetDomain(ctx, domain); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update domain DS records")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetDomainDSRecords,
+			sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+			sdk.NewAttribute(types.AttributeKeyUpdater, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyRecordCount, fmt.Sprintf("%d", len(dsRecords))),
+		),
+	})
+
+	return &types.MsgSetDomainDSRecordsResponse{}, nil
+}
+
+// ownedMutableDomain returns the domain with the given id, advanced to its current lifecycle
+// status, after checking that signer owns it and that it is still active or in its grace period.
+func (k msgServer) ownedMutableDomain(ctx sdk.Context, signer string, id uint64) (types.Domain, error) {
+	domain, err := k.Keeper.Domain.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return domain, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "key %d doesn't exist", id)
+		}
+		return domain, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get domain")
+	}
+	if signer != domain.Owner {
+		return domain, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the current owner %s of the domain", signer, domain.Owner)
+	}
+
+	domain, _, err = k.Keeper.EffectiveDomain(ctx, domain)
+	if err != nil {
+		return domain, errorsmod.Wrap(err, "failed to evaluate domain lifecycle")
+	}
+	if domain.Status != types.DomainStatus_DOMAIN_STATUS_ACTIVE && domain.Status != types.DomainStatus_DOMAIN_STATUS_GRACE {
+		return domain, errorsmod.Wrapf(types.ErrInvalidDomainStatus, "records of domain %d cannot be changed while in %s", id, domain.Status)
+	}
+	return domain, nil
+}
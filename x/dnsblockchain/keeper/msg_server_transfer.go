@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// OfferDomainTransfer offers a domain to a new owner, who takes it over by accepting the offer
+// before it expires. Whoever may transfer the domain directly may offer it.
+func (k msgServer) OfferDomainTransfer(goCtx context.Context, msg *types.MsgOfferDomainTransfer) (*types.MsgOfferDomainTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.NewOwner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid new owner address: %s", err))
+	}
+
+	domain, err := k.transferableDomain(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if msg.NewOwner == domain.Creator {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "domain %d is already held by %s", msg.Id, msg.NewOwner)
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	offer := types.TransferOffer{
+		DomainId: domain.Id,
+		Name:     domain.Name,
+		From:     msg.Creator,
+		To:       msg.NewOwner,
+		Expires:  uint64(ctx.BlockTime().Unix()) + params.TransferOfferWindow(),
+	}
+	if err := k.SetTransferOffer(ctx, offer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set transfer offer")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOfferDomainTransfer,
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", domain.Id)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+		sdk.NewAttribute(types.AttributeKeyOwner, domain.Owner),
+		sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner),
+		sdk.NewAttribute(types.AttributeKeyExpiration, fmt.Sprintf("%d", offer.Expires)),
+		sdk.NewAttribute(types.AttributeKeyActor, msg.Creator),
+	))
+	return &types.MsgOfferDomainTransferResponse{Expires: offer.Expires}, nil
+}
+
+// AcceptDomainTransfer completes a pending offer: the recipient pays the transfer fee and becomes
+// both creator and owner of the domain. The offer only stands while its sender may still transfer
+// the domain.
+func (k msgServer) AcceptDomainTransfer(goCtx context.Context, msg *types.MsgAcceptDomainTransfer) (*types.MsgAcceptDomainTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	offer, err := k.getTransferOffer(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if offer.To != msg.Creator {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "domain %d is offered to %s, not %s", msg.Id, offer.To, msg.Creator)
+	}
+	if uint64(ctx.BlockTime().Unix()) >= offer.Expires {
+		return nil, errorsmod.Wrapf(types.ErrTransferOfferExpired, "offer of domain %d expired at %d", msg.Id, offer.Expires)
+	}
+
+	domain, err := k.transferableDomain(ctx, msg.Id, offer.From)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "offer no longer stands")
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	if err = k.Keeper.ChargeFee(ctx, msg.Creator, domain.Name, params.DomainTransferFee, types.FeeTypeTransfer); err != nil {
+		return nil, err
+	}
+
+	// Changing the creator clears the offer.
+	if err := k.Keeper.moveDomain(ctx, domain, msg.Creator, msg.Creator); err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptDomainTransferResponse{}, nil
+}
+
+// CancelDomainTransfer withdraws or declines a pending offer. Its sender, anyone who may transfer
+// the domain, and its recipient may cancel it.
+func (k msgServer) CancelDomainTransfer(goCtx context.Context, msg *types.MsgCancelDomainTransfer) (*types.MsgCancelDomainTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	offer, err := k.getTransferOffer(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Creator != offer.From && msg.Creator != offer.To {
+		if _, err := k.transferableDomain(ctx, msg.Id, msg.Creator); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s cannot cancel the offer of domain %d", msg.Creator, msg.Id)
+		}
+	}
+
+	if err := k.RemoveTransferOffer(ctx, msg.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove transfer offer")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelDomainTransfer,
+		sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", offer.DomainId)),
+		sdk.NewAttribute(types.AttributeKeyDomainName, offer.Name),
+		sdk.NewAttribute(types.AttributeKeyNewOwner, offer.To),
+		sdk.NewAttribute(types.AttributeKeyActor, msg.Creator),
+	))
+	return &types.MsgCancelDomainTransferResponse{}, nil
+}
+
+// getTransferOffer returns the pending offer of a domain, or ErrTransferOfferNotFound.
+func (k msgServer) getTransferOffer(ctx context.Context, id uint64) (types.TransferOffer, error) {
+	offer, found, err := k.GetTransferOffer(ctx, id)
+	if err != nil {
+		return types.TransferOffer{}, errorsmod.Wrap(err, "failed to get transfer offer")
+	}
+	if !found {
+		return types.TransferOffer{}, errorsmod.Wrapf(types.ErrTransferOfferNotFound, "no transfer offer for domain %d", id)
+	}
+	return offer, nil
+}
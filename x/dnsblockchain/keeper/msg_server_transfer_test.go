@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestTwoStepDomainTransfer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr_______________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "offer.web3", Owner: creator, NsRecords: testNSRecords("offer.web3")})
+	require.NoError(t, err)
+
+	// The one-step path must be asked for explicitly.
+	_, err = srv.TransferDomain(ctx, &types.MsgTransferDomain{Creator: creator, Id: resp.Id, NewOwner: newOwner})
+	require.ErrorIs(t, err, types.ErrDirectTransferDisabled)
+
+	_, err = srv.OfferDomainTransfer(ctx, &types.MsgOfferDomainTransfer{Creator: other, Id: resp.Id, NewOwner: newOwner})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	offered, err := srv.OfferDomainTransfer(ctx, &types.MsgOfferDomainTransfer{Creator: creator, Id: resp.Id, NewOwner: newOwner})
+	require.NoError(t, err)
+	require.Equal(t, uint64(now.Unix())+types.DefaultTransferOfferPeriod, offered.Expires)
+
+	pending, err := qs.PendingTransferOffers(ctx, &types.QueryPendingTransferOffersRequest{Address: newOwner})
+	require.NoError(t, err)
+	require.Equal(t, []types.TransferOffer{{DomainId: resp.Id, Name: "offer.web3", From: creator, To: newOwner, Expires: offered.Expires}}, pending.Offers)
+	pending, err = qs.PendingTransferOffers(ctx, &types.QueryPendingTransferOffersRequest{Address: other})
+	require.NoError(t, err)
+	require.Empty(t, pending.Offers)
+
+	// Offering does not move the domain; only its recipient can accept it.
+	domain, err := f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, creator, domain.Owner)
+	_, err = srv.AcceptDomainTransfer(ctx, &types.MsgAcceptDomainTransfer{Creator: other, Id: resp.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	charged := f.bankKeeper.sentToModule
+	_, err = srv.AcceptDomainTransfer(ctx, &types.MsgAcceptDomainTransfer{Creator: newOwner, Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, charged.Add(params.DomainTransferFee...), f.bankKeeper.sentToModule)
+
+	domain, err = f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, newOwner, domain.Creator)
+	require.Equal(t, newOwner, domain.Owner)
+	_, err = srv.AcceptDomainTransfer(ctx, &types.MsgAcceptDomainTransfer{Creator: newOwner, Id: resp.Id})
+	require.ErrorIs(t, err, types.ErrTransferOfferNotFound)
+	pending, err = qs.PendingTransferOffers(ctx, &types.QueryPendingTransferOffersRequest{Address: newOwner})
+	require.NoError(t, err)
+	require.Empty(t, pending.Offers)
+}
+
+func TestTransferOfferExpiryAndCancel(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr_______________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	params := types.DefaultParams()
+	params.TransferOfferPeriod = 60 * 60
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "expiry.web3", Owner: creator, NsRecords: testNSRecords("expiry.web3")})
+	require.NoError(t, err)
+	_, err = srv.OfferDomainTransfer(ctx, &types.MsgOfferDomainTransfer{Creator: creator, Id: resp.Id, NewOwner: newOwner})
+	require.NoError(t, err)
+
+	// Once expired, the offer is no longer listed nor accepted, and the EndBlocker drops it.
+	expired := ctx.WithBlockTime(now.Add(time.Hour))
+	pending, err := qs.PendingTransferOffers(expired, &types.QueryPendingTransferOffersRequest{Address: newOwner})
+	require.NoError(t, err)
+	require.Empty(t, pending.Offers)
+	_, err = srv.AcceptDomainTransfer(expired, &types.MsgAcceptDomainTransfer{Creator: newOwner, Id: resp.Id})
+	require.ErrorIs(t, err, types.ErrTransferOfferExpired)
+	require.NoError(t, f.keeper.EndBlocker(expired))
+	_, found, err := f.keeper.GetTransferOffer(expired, resp.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// Only the parties to an offer, or whoever may transfer the domain, can cancel it.
+	_, err = srv.OfferDomainTransfer(ctx, &types.MsgOfferDomainTransfer{Creator: creator, Id: resp.Id, NewOwner: newOwner})
+	require.NoError(t, err)
+	_, err = srv.CancelDomainTransfer(ctx, &types.MsgCancelDomainTransfer{Creator: other, Id: resp.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CancelDomainTransfer(ctx, &types.MsgCancelDomainTransfer{Creator: newOwner, Id: resp.Id})
+	require.NoError(t, err)
+	_, err = srv.AcceptDomainTransfer(ctx, &types.MsgAcceptDomainTransfer{Creator: newOwner, Id: resp.Id})
+	require.ErrorIs(t, err, types.ErrTransferOfferNotFound)
+
+	// A new offer replaces the pending one, and a direct transfer clears it.
+	_, err = srv.OfferDomainTransfer(ctx, &types.MsgOfferDomainTransfer{Creator: creator, Id: resp.Id, NewOwner: newOwner})
+	require.NoError(t, err)
+	_, err = srv.OfferDomainTransfer(ctx, &types.MsgOfferDomainTransfer{Creator: creator, Id: resp.Id, NewOwner: other})
+	require.NoError(t, err)
+	pending, err = qs.PendingTransferOffers(ctx, &types.QueryPendingTransferOffersRequest{Address: newOwner})
+	require.NoError(t, err)
+	require.Empty(t, pending.Offers)
+	_, err = srv.TransferDomain(ctx, &types.MsgTransferDomain{Creator: creator, Id: resp.Id, NewOwner: newOwner, Direct: true})
+	require.NoError(t, err)
+	_, err = srv.AcceptDomainTransfer(ctx, &types.MsgAcceptDomainTransfer{Creator: other, Id: resp.Id})
+	require.ErrorIs(t, err, types.ErrTransferOfferNotFound)
+}
+
+func TestGenesisTransferOffers(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr_______________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: creator, Name: "genesis.web3", Owner: creator, NsRecords: testNSRecords("genesis.web3")})
+	require.NoError(t, err)
+	_, err = srv.OfferDomainTransfer(f.ctx, &types.MsgOfferDomainTransfer{Creator: creator, Id: resp.Id, NewOwner: newOwner})
+	require.NoError(t, err)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Len(t, exported.TransferOffers, 1)
+	require.NoError(t, exported.Validate())
+
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported.TransferOffers, reexported.TransferOffers)
+
+	pending, err := keeper.NewQueryServerImpl(g.keeper).PendingTransferOffers(g.ctx, &types.QueryPendingTransferOffersRequest{Address: newOwner})
+	require.NoError(t, err)
+	require.Equal(t, exported.TransferOffers, pending.Offers)
+}
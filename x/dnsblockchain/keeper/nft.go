@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// ensureNFTClass creates the x/nft class of a TLD unless it already exists.
+func (k Keeper) ensureNFTClass(ctx context.Context, tld string) error {
+	if k.nftKeeper.HasClass(ctx, types.NFTClassID(tld)) {
+		return nil
+	}
+	return k.nftKeeper.SaveClass(ctx, types.NewNFTClass(tld))
+}
+
+// syncDomainNFT mints the NFT of a domain to its creator, or moves it there when held by someone
+// else, so that the NFT is always held by Domain.Creator, who may transfer and sell the domain.
+func (k Keeper) syncDomainNFT(ctx context.Context, domain types.Domain) error {
+	holder, err := k.addressCodec.StringToBytes(domain.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address of domain %s: %s", domain.Name, err)
+	}
+	token := types.NewDomainNFT(domain.Name)
+	if err := k.ensureNFTClass(ctx, types.ExtractTLD(domain.Name)); err != nil {
+		return err
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, token.ClassId, token.Id) {
+		return k.nftKeeper.Mint(ctx, token, holder)
+	}
+	if sdk.AccAddress(holder).Equals(k.nftKeeper.GetOwner(ctx, token.ClassId, token.Id)) {
+		return nil
+	}
+	return k.nftKeeper.Transfer(ctx, token.ClassId, token.Id, holder)
+}
+
+// burnDomainNFT burns the NFT of a domain, if any.
+func (k Keeper) burnDomainNFT(ctx context.Context, domain types.Domain) error {
+	token := types.NewDomainNFT(domain.Name)
+	if !k.nftKeeper.HasNFT(ctx, token.ClassId, token.Id) {
+		return nil
+	}
+	return k.nftKeeper.Burn(ctx, token.ClassId, token.Id)
+}
+
+// TransferDomainByNFT moves a domain to the receiver of an x/nft MsgSend of its NFT, which the nft
+// module has already executed. The sender must be the domain's creator, who held the NFT, and be
+// allowed to transfer the domain as for a direct transfer, and pays the transfer fee. Sends of NFTs of other classes are ignored.
+func (k Keeper) TransferDomainByNFT(ctx sdk.Context, msg *nft.MsgSend) error {
+	if _, ok := types.TLDFromNFTClassID(msg.ClassId); !ok {
+		return nil
+	}
+	id, err := k.DomainName.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no domain for nft %s of class %s", msg.Id, msg.ClassId)
+		}
+		return err
+	}
+	domain, err := k.transferableDomain(ctx, id, msg.Sender)
+	if err != nil {
+		return err
+	}
+	if domain.Creator != msg.Sender {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "sender %s is not the creator %s of domain %s", msg.Sender, domain.Creator, domain.Name)
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Receiver); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	if err := k.ChargeFee(ctx, msg.Sender, domain.Name, params.DomainTransferFee, types.FeeTypeTransfer); err != nil {
+		return err
+	}
+	return k.moveDomain(ctx, domain, msg.Receiver, msg.Sender)
+}
+
+// NewNFTPostHandler returns a post handler that replays, in order, the top-level x/nft MsgSend
+// messages of a successful transaction as transfers of the domains whose NFTs they moved. Sends
+// nested in other messages are routed through NFTSendRouter instead. A send that cannot transfer
+// its domain fails the whole transaction.
+func NewNFTPostHandler(k Keeper) sdk.PostHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, _, success bool) (sdk.Context, error) {
+		if !success {
+			return ctx, nil
+		}
+		for _, msg := range tx.GetMsgs() {
+			if send, ok := msg.(*nft.MsgSend); ok {
+				if err := k.TransferDomainByNFT(ctx, send); err != nil {
+					return ctx, err
+				}
+			}
+		}
+		return ctx, nil
+	}
+}
+
+// NFTSendRouter is the message router handed to the modules that execute messages on behalf of
+// others, such as authz, group, gov and the interchain accounts host. It transfers the domain of
+// every domain NFT they send right after the nft module moved it, so no send path leaves a
+// domain behind its NFT.
+type NFTSendRouter struct {
+	router baseapp.MessageRouter
+	keeper Keeper
+}
+
+var _ baseapp.MessageRouter = NFTSendRouter{}
+
+// NewNFTSendRouter wraps the app's message router.
+func NewNFTSendRouter(router baseapp.MessageRouter, k Keeper) NFTSendRouter {
+	return NFTSendRouter{router: router, keeper: k}
+}
+
+// Handler implements baseapp.MessageRouter.
+func (r NFTSendRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.wrap(r.router.Handler(msg))
+}
+
+// HandlerByTypeURL implements baseapp.MessageRouter.
+func (r NFTSendRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	return r.wrap(r.router.HandlerByTypeURL(typeURL))
+}
+
+// wrap follows the handling of an x/nft MsgSend with the transfer of its domain.
+func (r NFTSendRouter) wrap(handler baseapp.MsgServiceHandler) baseapp.MsgServiceHandler {
+	if handler == nil {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return res, err
+		}
+		if send, ok := msg.(*nft.MsgSend); ok {
+			if err := r.keeper.TransferDomainByNFT(ctx, send); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	}
+}
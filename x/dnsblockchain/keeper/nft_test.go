@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/x/nft"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// testTx is a transaction carrying only messages, as seen by the post handler.
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+// nftRouter routes x/nft sends to the mock nft keeper the way the app's router routes them to the
+// nft module.
+type nftRouter struct {
+	f *fixture
+}
+
+func (r nftRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+}
+
+func (r nftRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	if typeURL != sdk.MsgTypeURL(&nft.MsgSend{}) {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		send := msg.(*nft.MsgSend)
+		if r.f.nftKeeper.GetOwner(ctx, send.ClassId, send.Id).String() != send.Sender {
+			return nil, sdkerrors.ErrUnauthorized
+		}
+		receiver, err := r.f.addressCodec.StringToBytes(send.Receiver)
+		if err != nil {
+			return nil, err
+		}
+		return &sdk.Result{}, r.f.nftKeeper.Transfer(ctx, send.ClassId, send.Id, receiver)
+	}
+}
+
+// sendNFT delivers a transaction of x/nft sends: the nft module executes them, then the post
+// handler transfers their domains.
+func sendNFT(ctx sdk.Context, f *fixture, msgs ...sdk.Msg) error {
+	for _, msg := range msgs {
+		if _, err := (nftRouter{f}).Handler(msg)(ctx, msg); err != nil {
+			return err
+		}
+	}
+	_, err := keeper.NewNFTPostHandler(f.keeper)(ctx, testTx{msgs: msgs}, false, true)
+	return err
+}
+
+// execNFT executes an x/nft send on behalf of someone else, as authz, group and gov proposals
+// and the interchain accounts host do through the app's NFTSendRouter.
+func execNFT(ctx sdk.Context, f *fixture, msg sdk.Msg) error {
+	_, err := keeper.NewNFTSendRouter(nftRouter{f}, f.keeper).Handler(msg)(ctx, msg)
+	return err
+}
+
+func requireNFTHolder(t *testing.T, f *fixture, name, holder string) {
+	t.Helper()
+	token := types.NewDomainNFT(name)
+	require.True(t, f.nftKeeper.HasNFT(f.ctx, token.ClassId, token.Id))
+	require.Equal(t, holder, f.nftKeeper.GetOwner(f.ctx, token.ClassId, token.Id).String())
+}
+
+func TestDomainNFTLockstep(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	addrs := bidders(t, f, 3)
+	alice, bob, carol := addrs[0], addrs[1], addrs[2]
+
+	resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: alice, Name: "nft.web3", Owner: alice, NsRecords: testNSRecords("nft.web3")})
+	require.NoError(t, err)
+	require.Equal(t, types.NewNFTClass("web3"), f.nftKeeper.classes["dns-web3"])
+	requireNFTHolder(t, f, "nft.web3", alice)
+
+	// The NFT is held by the domain's creator, who may transfer it, whoever the owner is.
+	sub, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: alice, Name: "www.nft.web3", Owner: carol, NsRecords: testNSRecords("www.nft.web3")})
+	require.NoError(t, err)
+	requireNFTHolder(t, f, "www.nft.web3", alice)
+	_, err = srv.UpdateDomain(f.ctx, &types.MsgUpdateDomain{Creator: alice, Id: resp.Id, Owner: bob})
+	require.NoError(t, err)
+	requireNFTHolder(t, f, "nft.web3", alice)
+
+	// It follows the domain through transfers.
+	_, err = srv.TransferDomain(f.ctx, &types.MsgTransferDomain{Creator: alice, Id: resp.Id, NewOwner: carol, Direct: true})
+	require.NoError(t, err)
+	requireNFTHolder(t, f, "nft.web3", carol)
+
+	// Deleting a domain burns its NFT and those of its subdomains.
+	_, err = srv.DeleteDomain(f.ctx, &types.MsgDeleteDomain{Creator: carol, Id: resp.Id})
+	require.NoError(t, err)
+	require.False(t, f.nftKeeper.HasNFT(f.ctx, "dns-web3", "nft.web3"))
+	require.False(t, f.nftKeeper.HasNFT(f.ctx, "dns-web3", "www.nft.web3"))
+	_, err = f.keeper.Domain.Get(f.ctx, sub.Id)
+	require.Error(t, err)
+
+	// New TLDs get their class as soon as they are permitted.
+	require.NoError(t, f.keeper.AddPermittedTLD(f.ctx, "chain"))
+	require.True(t, f.nftKeeper.HasClass(f.ctx, "dns-chain"))
+}
+
+func TestNFTSendTransfersDomain(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	addrs := bidders(t, f, 3)
+	alice, bob, carol := addrs[0], addrs[1], addrs[2]
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: alice, Name: "send.web3", Owner: alice, NsRecords: testNSRecords("send.web3")})
+	require.NoError(t, err)
+	_, err = srv.ListDomain(ctx, &types.MsgListDomain{Creator: alice, Id: resp.Id, Price: udns(1000)})
+	require.NoError(t, err)
+
+	charged := f.bankKeeper.sentToModule
+	require.NoError(t, sendNFT(ctx, f, &nft.MsgSend{ClassId: "dns-web3", Id: "send.web3", Sender: alice, Receiver: bob}))
+	require.Equal(t, charged.Add(params.DomainTransferFee...), f.bankKeeper.sentToModule)
+	domain, err := f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, bob, domain.Creator)
+	require.Equal(t, bob, domain.Owner)
+	requireNFTHolder(t, f, "send.web3", bob)
+	_, found, err := f.keeper.GetListing(ctx, resp.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// Sends executed on behalf of the holder, e.g. by a group proposal, transfer the domain at once.
+	require.NoError(t, execNFT(ctx, f, &nft.MsgSend{ClassId: "dns-web3", Id: "send.web3", Sender: bob, Receiver: alice}))
+	domain, err = f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, alice, domain.Owner)
+	requireNFTHolder(t, f, "send.web3", alice)
+	require.NoError(t, execNFT(ctx, f, &nft.MsgSend{ClassId: "dns-web3", Id: "send.web3", Sender: alice, Receiver: carol}))
+	require.NoError(t, sendNFT(ctx, f, &nft.MsgSend{ClassId: "dns-web3", Id: "send.web3", Sender: carol, Receiver: bob}))
+	require.NoError(t, execNFT(ctx, f, &nft.MsgSend{ClassId: "dns-web3", Id: "send.web3", Sender: bob, Receiver: carol}))
+	domain, err = f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, carol, domain.Creator)
+	require.Equal(t, carol, domain.Owner)
+	requireNFTHolder(t, f, "send.web3", carol)
+
+	// Setting another owner leaves the NFT with the creator, who can still send it, while the owner
+	// cannot.
+	_, err = srv.UpdateDomain(ctx, &types.MsgUpdateDomain{Creator: carol, Id: resp.Id, Owner: bob})
+	require.NoError(t, err)
+	requireNFTHolder(t, f, "send.web3", carol)
+	err = execNFT(ctx, f, &nft.MsgSend{ClassId: "dns-web3", Id: "send.web3", Sender: bob, Receiver: alice})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.NoError(t, sendNFT(ctx, f, &nft.MsgSend{ClassId: "dns-web3", Id: "send.web3", Sender: carol, Receiver: bob}))
+	domain, err = f.keeper.Domain.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, bob, domain.Creator)
+	require.Equal(t, bob, domain.Owner)
+	requireNFTHolder(t, f, "send.web3", bob)
+
+	// Expired domains cannot be sent, and NFTs of other classes are left alone.
+	expired := ctx.WithBlockTime(time.Unix(int64(domain.Expiration)+1, 0))
+	err = sendNFT(expired, f, &nft.MsgSend{ClassId: "dns-web3", Id: "send.web3", Sender: bob, Receiver: alice})
+	require.ErrorIs(t, err, types.ErrInvalidDomainStatus)
+	_, err = keeper.NewNFTPostHandler(f.keeper)(ctx, testTx{msgs: []sdk.Msg{&nft.MsgSend{ClassId: "art", Id: "send.web3", Sender: carol, Receiver: alice}}}, false, true)
+	require.NoError(t, err)
+	require.Nil(t, keeper.NewNFTSendRouter(nftRouter{f}, f.keeper).Handler(&types.MsgCreateDomain{}))
+}
+
+func TestGenesisKeepsImportedNFTs(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	addrs := bidders(t, f, 1)
+
+	_, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: addrs[0], Name: "genesis.web3", Owner: addrs[0], NsRecords: testNSRecords("genesis.web3")})
+	require.NoError(t, err)
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+
+	// The nft module imports its tokens before this module's genesis runs.
+	g := initFixture(t)
+	require.NoError(t, g.nftKeeper.SaveClass(g.ctx, types.NewNFTClass("web3")))
+	owner, err := g.addressCodec.StringToBytes(addrs[0])
+	require.NoError(t, err)
+	require.NoError(t, g.nftKeeper.Mint(g.ctx, types.NewDomainNFT("genesis.web3"), owner))
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	requireNFTHolder(t, g, "genesis.web3", addrs[0])
+
+	// Without them, the NFTs are minted from the domains.
+	h := initFixture(t)
+	require.NoError(t, h.keeper.InitGenesis(h.ctx, *exported))
+	requireNFTHolder(t, h, "genesis.web3", addrs[0])
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// Auction returns an open auction and its bids. Sealed bids show their deposit but not their
+// amount until revealed.
+func (q queryServer) Auction(ctx context.Context, req *types.QueryAuctionRequest) (*types.QueryAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	name := strings.ToLower(strings.Trim(req.Name, "."))
+	auction, found, err := q.k.GetAuction(ctx, name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no auction for '%s'", name)
+	}
+	bids, err := q.k.GetAuctionBids(ctx, name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAuctionResponse{Auction: auction, Bids: bids}, nil
+}
+
+// ListAuctions lists the open auctions, those settling soonest first. Auctions set aside after
+// failing to settle are listed again once they are queued again.
+func (q queryServer) ListAuctions(ctx context.Context, req *types.QueryListAuctionsRequest) (*types.QueryListAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	auctions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.AuctionQueue,
+		req.Pagination,
+		func(key collections.Pair[uint64, string], _ collections.NoValue) (types.Auction, error) {
+			return q.k.Auctions.Get(ctx, key.K2())
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryListAuctionsResponse{Auctions: auctions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// CheckAvailability runs the CreateDomain name checks on a candidate name without registering it,
+// and reports the fee a registration for the requested years would be charged. Names that can only
+// be registered through a commitment are reported as such, with the fee their reveal is charged.
+func (q queryServer) CheckAvailability(ctx context.Context, req *types.QueryCheckAvailabilityRequest) (*types.QueryCheckAvailabilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	years := req.Years
+	if years == 0 {
+		years = 1
+	}
+	if years > params.RegistrationYearsLimit() {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot register for %d years; the maximum is %d", years, params.RegistrationYearsLimit())
+	}
+
+	availability, err := q.k.checkCreateAvailability(ctx, params, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryCheckAvailabilityResponse{
+		Name:      availability.Name,
+		Available: availability.Err == nil,
+		Reason:    availability.Reason,
+	}
+	if availability.Err != nil {
+		res.Message = availability.Err.Error()
+	}
+	if availability.Reason != types.Availability_AVAILABILITY_INVALID_NAME {
+		if res.Fee, err = q.k.RegistrationPrice(ctx, params, availability, years); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return res, nil
+}
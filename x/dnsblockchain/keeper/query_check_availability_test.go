@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestCheckAvailabilityQuery(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "taken.web3", Owner: creator, NsRecords: testNSRecords("taken.web3")})
+	require.NoError(t, err)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc      string
+		ctx       sdk.Context
+		name      string
+		available bool
+		reason    types.Availability
+		// releasing is set for lapsed names priced by their descending-price release.
+		releasing bool
+	}{
+		{desc: "available", ctx: ctx, name: "Free.web3.", available: true, reason: types.Availability_AVAILABILITY_AVAILABLE},
+		{desc: "invalid format", ctx: ctx, name: "a..web3", reason: types.Availability_AVAILABILITY_INVALID_NAME},
+		{desc: "subdomain", ctx: ctx, name: "www.taken.web3", available: true, reason: types.Availability_AVAILABILITY_AVAILABLE},
+		{desc: "parent not registered", ctx: ctx, name: "www.free.web3", reason: types.Availability_AVAILABILITY_PARENT_NOT_REGISTERED},
+		{desc: "empty label", ctx: ctx, name: ".web3", reason: types.Availability_AVAILABILITY_INVALID_NAME},
+		{desc: "icann reserved", ctx: ctx, name: "example.com", reason: types.Availability_AVAILABILITY_TLD_RESERVED},
+		{desc: "tld not permitted", ctx: ctx, name: "example.nope", reason: types.Availability_AVAILABILITY_TLD_NOT_PERMITTED},
+		{desc: "registered", ctx: ctx, name: "taken.web3", reason: types.Availability_AVAILABILITY_REGISTERED},
+		{desc: "expired but held", ctx: ctx.WithBlockTime(now.AddDate(1, 0, 1)), name: "taken.web3", reason: types.Availability_AVAILABILITY_EXPIRED_HELD},
+		{desc: "released", ctx: ctx.WithBlockTime(now.AddDate(1, 0, 70)), name: "taken.web3", available: true, reason: types.Availability_AVAILABILITY_AVAILABLE, releasing: true},
+		{desc: "release ended", ctx: ctx.WithBlockTime(now.AddDate(1, 0, 80)), name: "taken.web3", available: true, reason: types.Availability_AVAILABILITY_AVAILABLE},
+	}
+	releaseStart := types.AddYears(uint64(now.Unix()), 1) + params.GracePeriod + params.RedemptionPeriod + params.PendingDeletePeriod
+	release := types.NameRelease{Name: "taken.web3", Start: releaseStart, End: releaseStart + params.ReleaseWindow()}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := qs.CheckAvailability(tc.ctx, &types.QueryCheckAvailabilityRequest{Name: tc.name, Years: 2})
+			require.NoError(t, err)
+			require.Equal(t, tc.available, resp.Available)
+			require.Equal(t, tc.reason, resp.Reason)
+			if tc.available {
+				require.Empty(t, resp.Message)
+				fee := params.RegistrationFee(resp.Name, 2)
+				if tc.releasing {
+					registration, _, _ := params.NamePrice(resp.Name)
+					premium := params.ReleasePremium(registration, release, uint64(tc.ctx.BlockTime().Unix()))
+					require.False(t, premium.IsZero())
+					fee = fee.Add(premium...)
+				}
+				require.Equal(t, fee, resp.Fee)
+			} else {
+				require.NotEmpty(t, resp.Message)
+			}
+		})
+	}
+
+	// The query agrees with CreateDomain.
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "example.com", Owner: creator, NsRecords: testNSRecords("example.com")})
+	require.ErrorIs(t, err, types.ErrTLDReservedByICANN)
+}
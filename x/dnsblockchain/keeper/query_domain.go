@@ -67,32 +67,27 @@ func (q queryServer) GetDomainByName(goCtx context.Context, req *types.QueryGetD
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	normalizedName := strings.ToLower(strings.Trim(req.Name, "."))
 
-	domainID, err := q.k.DomainName.Get(ctx, normalizedName)
+	// Fall back to the longest registered suffix so names below a delegation resolve to it.
+	domain, exact, found, err := q.k.LookupDomain(ctx, normalizedName)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			q.k.Logger(ctx).Info("Domain not found by name in index", "name", normalizedName)
-			return &types.QueryGetDomainByNameResponse{Found: false, Expired: false}, nil
-		}
-		q.k.Logger(ctx).Error("Error getting domain ID from name index", "name", normalizedName, "error", err)
-		return nil, status.Errorf(codes.Internal, "internal error getting domain ID by name: %v", err)
+		q.k.Logger(ctx).Error("Error looking up domain by name", "name", normalizedName, "error", err)
+		return nil, status.Errorf(codes.Internal, "internal error getting domain by name: %v", err)
+	}
+	if !found {
+		q.k.Logger(ctx).Info("Domain not found by name in index", "name", normalizedName)
+		return &types.QueryGetDomainByNameResponse{Found: false, Expired: false}, nil
 	}
 
-	domain, err := q.k.Domain.Get(ctx, domainID)
+	// Report the lifecycle status due at this block even if the EndBlocker has not persisted it yet.
+	domain, _, err = q.k.EffectiveDomain(ctx, domain)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			q.k.Logger(ctx).Error("Data inconsistency: Domain ID found in name index, but domain object not found by ID", "name", normalizedName, "id", domainID)
-			// Opcional: Limpiar la entrada del índice si se detecta esta inconsistencia
-			// _ = q.k.DomainName.Remove(ctx, normalizedName)
-			return &types.QueryGetDomainByNameResponse{Found: false, Expired: false}, nil
-		}
-		q.k.Logger(ctx).Error("Error getting domain object by ID after lookup from name index", "name", normalizedName, "id", domainID, "error", err)
-		return nil, status.Errorf(codes.Internal, "internal error getting domain by ID: %v", err)
+		return nil, status.Errorf(codes.Internal, "internal error evaluating domain lifecycle: %v", err)
 	}
 
-	isExpired := uint64(ctx.BlockTime().Unix()) >= domain.Expiration
+	isExpired := domain.Status != types.DomainStatus_DOMAIN_STATUS_ACTIVE
 	if isExpired {
-		q.k.Logger(ctx).Info("Domain found by name, but is expired", "name", normalizedName, "expiration", domain.Expiration)
+		q.k.Logger(ctx).Info("Domain found by name, but is expired", "name", normalizedName, "expiration", domain.Expiration, "status", domain.Status.String())
 	}
 
-	return &types.QueryGetDomainByNameResponse{Domain: domain, Found: true, Expired: isExpired}, nil
+	return &types.QueryGetDomainByNameResponse{Domain: domain, Found: true, Expired: isExpired, Exact: exact}, nil
 }
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func (q queryServer) DomainPrice(ctx context.Context, req *types.QueryDomainPriceRequest) (*types.QueryDomainPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	name := strings.ToLower(strings.Trim(req.Name, "."))
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name cannot be empty")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	years := req.Years
+	if years == 0 {
+		years = 1
+	}
+	if years > params.RegistrationYearsLimit() {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot register for %d years; the maximum is %d", years, params.RegistrationYearsLimit())
+	}
+
+	availability, err := q.k.CheckNameAvailability(ctx, name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	fee, err := q.k.RegistrationPrice(ctx, params, availability, years)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_, renewal, premium := params.NamePrice(name)
+	return &types.QueryDomainPriceResponse{
+		RegistrationFee: fee,
+		RenewalFee:      renewal,
+		Premium:         premium,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestDomainPriceQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.PremiumNames = []types.PremiumName{{
+		Name:            "bank.web3",
+		RegistrationFee: sdk.NewCoins(sdk.NewInt64Coin("udns", 9000000000)),
+		RenewalFee:      sdk.NewCoins(sdk.NewInt64Coin("udns", 1000000000)),
+	}}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryDomainPriceRequest
+		response *types.QueryDomainPriceResponse
+		err      error
+	}{
+		{
+			desc:    "short label",
+			request: &types.QueryDomainPriceRequest{Name: "ab.web3"},
+			response: &types.QueryDomainPriceResponse{
+				RegistrationFee: params.PriceTiers[0].RegistrationFee,
+				RenewalFee:      params.PriceTiers[0].RenewalFee,
+			},
+		},
+		{
+			desc:    "base price for several years",
+			request: &types.QueryDomainPriceRequest{Name: "Example.web3.", Years: 3},
+			response: &types.QueryDomainPriceResponse{
+				RegistrationFee: params.DomainCreationFee.Add(params.DomainRenewalFee...).Add(params.DomainRenewalFee...),
+				RenewalFee:      params.DomainRenewalFee,
+			},
+		},
+		{
+			desc:    "premium",
+			request: &types.QueryDomainPriceRequest{Name: "bank.web3"},
+			response: &types.QueryDomainPriceResponse{
+				RegistrationFee: params.PremiumNames[0].RegistrationFee,
+				RenewalFee:      params.PremiumNames[0].RenewalFee,
+				Premium:         true,
+			},
+		},
+		{
+			desc:    "too many years",
+			request: &types.QueryDomainPriceRequest{Name: "example.web3", Years: params.MaxRegistrationYears + 1},
+			err:     status.Errorf(codes.InvalidArgument, "cannot register for %d years; the maximum is %d", params.MaxRegistrationYears+1, params.MaxRegistrationYears),
+		},
+		{
+			desc: "invalid request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.DomainPrice(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
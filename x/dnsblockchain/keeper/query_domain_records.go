@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func (q queryServer) DomainRecords(ctx context.Context, req *types.QueryDomainRecordsRequest) (*types.QueryDomainRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	name := strings.ToLower(strings.Trim(req.Name, "."))
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name cannot be empty")
+	}
+
+	domain, _, found, err := q.k.LookupDomain(ctx, name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return &types.QueryDomainRecordsResponse{}, nil
+	}
+	domain, _, err = q.k.EffectiveDomain(ctx, domain)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDomainRecordsResponse{
+		Found:     true,
+		Domain:    domain.Name,
+		Records:   domain.RecordsAt(name, req.Type),
+		NsRecords: domain.NsRecords,
+		Status:    domain.Status,
+		DsRecords: domain.DsRecords,
+	}, nil
+}
@@ -23,7 +23,7 @@ func createNDomain(keeper keeper.Keeper, ctx context.Context, n int) []types.Dom
 		items[i].Name = strconv.Itoa(i)
 		items[i].Owner = strconv.Itoa(i)
 		items[i].Expiration = uint64(i)
-		items[i].Ns = strconv.Itoa(i)
+		items[i].NsRecords = []*types.NSRecordWithIP{{Name: "ns" + strconv.Itoa(i)}}
 		_ = keeper.Domain.Set(ctx, iu, items[i])
 		_ = keeper.DomainSeq.Set(ctx, iu)
 	}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// ListDomainsByOwner lists the domains owned by an address through the owner index.
+func (q queryServer) ListDomainsByOwner(ctx context.Context, req *types.QueryListDomainsByOwnerRequest) (*types.QueryListDomainsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := q.k.addressCodec.StringToBytes(req.Owner); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %v", err)
+	}
+
+	domains, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.DomainsByOwner,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Domain, error) {
+			return q.k.Domain.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListDomainsByOwnerResponse{Domain: domains, Pagination: pageRes}, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestListDomainsByOwnerQuery(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr__________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bobAddr____________________"))
+	require.NoError(t, err)
+
+	var aliceIDs []uint64
+	for i := 0; i < 5; i++ {
+		name := fmt.Sprintf("alice%d.web3", i)
+		resp, err := srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: alice, Name: name, Owner: alice, NsRecords: testNSRecords(name)})
+		require.NoError(t, err)
+		aliceIDs = append(aliceIDs, resp.Id)
+	}
+	_, err = srv.CreateDomain(f.ctx, &types.MsgCreateDomain{Creator: bob, Name: "bob.web3", Owner: bob, NsRecords: testNSRecords("bob.web3")})
+	require.NoError(t, err)
+
+	listIDs := func(owner string) []uint64 {
+		var ids []uint64
+		var next []byte
+		for {
+			resp, err := qs.ListDomainsByOwner(f.ctx, &types.QueryListDomainsByOwnerRequest{Owner: owner, Pagination: &query.PageRequest{Key: next, Limit: 2}})
+			require.NoError(t, err)
+			for _, d := range resp.Domain {
+				require.Equal(t, owner, d.Owner)
+				ids = append(ids, d.Id)
+			}
+			if next = resp.Pagination.NextKey; next == nil {
+				return ids
+			}
+		}
+	}
+	require.Equal(t, aliceIDs, listIDs(alice))
+	require.Len(t, listIDs(bob), 1)
+
+	// Transfers move the domain between owners.
+	_, err = srv.TransferDomain(f.ctx, &types.MsgTransferDomain{Creator: alice, Id: aliceIDs[0], NewOwner: bob, Direct: true})
+	require.NoError(t, err)
+	require.Equal(t, aliceIDs[1:], listIDs(alice))
+	require.Len(t, listIDs(bob), 2)
+
+	// Deleted domains leave the index.
+	_, err = srv.DeleteDomain(f.ctx, &types.MsgDeleteDomain{Creator: alice, Id: aliceIDs[1]})
+	require.NoError(t, err)
+	require.Equal(t, aliceIDs[2:], listIDs(alice))
+
+	_, err = qs.ListDomainsByOwner(f.ctx, &types.QueryListDomainsByOwnerRequest{Owner: "invalid"})
+	require.Error(t, err)
+}
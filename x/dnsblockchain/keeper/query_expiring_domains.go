@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// maxExpiringDomainsLimit bounds the number of domains returned by a single ListExpiringDomains page.
+const maxExpiringDomainsLimit = 1000
+
+// ListExpiringDomains lists live domains expiring between the current block time and req.Before,
+// soonest first, by range-scanning the expiration index.
+func (q queryServer) ListExpiringDomains(goCtx context.Context, req *types.QueryListExpiringDomainsRequest) (*types.QueryListExpiringDomainsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	keyCodec := q.k.DomainExpirationQueue.KeyCodec()
+
+	start := collections.Join(uint64(ctx.BlockTime().Unix()), uint64(0))
+	limit := uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) > 0 {
+			_, key, err := keyCodec.Decode(req.Pagination.Key)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid pagination key: %v", err)
+			}
+			start = key
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+	if limit > maxExpiringDomainsLimit {
+		limit = maxExpiringDomainsLimit
+	}
+
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		StartInclusive(start).
+		EndInclusive(collections.Join(req.Before, uint64(math.MaxUint64)))
+
+	var (
+		domains []types.Domain
+		nextKey []byte
+	)
+	err := q.k.DomainExpirationQueue.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		if uint64(len(domains)) == limit {
+			nextKey = make([]byte, keyCodec.Size(key))
+			_, err := keyCodec.Encode(nextKey, key)
+			return true, err
+		}
+		domain, err := q.k.Domain.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		// Lapsed domains are queued by the end of their grace, redemption or pending-delete period.
+		if domain.Status == types.DomainStatus_DOMAIN_STATUS_ACTIVE {
+			domains = append(domains, domain)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListExpiringDomainsResponse{
+		Domain:     domains,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestListExpiringDomains(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	now := uint64(ctx.BlockTime().Unix())
+	owner := sdk.AccAddress("owner_______________").String()
+
+	for i, exp := range []uint64{now + 50, now + 10, now + 30, now + 500} {
+		require.NoError(t, f.keeper.SetDomain(ctx, types.Domain{Id: uint64(i), Name: string(rune('a'+i)) + ".web3", Owner: owner, Creator: owner, Expiration: exp}))
+	}
+
+	resp, err := qs.ListExpiringDomains(ctx, &types.QueryListExpiringDomainsRequest{Before: now + 100})
+	require.NoError(t, err)
+	require.Len(t, resp.Domain, 3)
+	require.Equal(t, []uint64{1, 2, 0}, []uint64{resp.Domain[0].Id, resp.Domain[1].Id, resp.Domain[2].Id})
+	require.Nil(t, resp.Pagination.NextKey)
+
+	t.Run("ByKey", func(t *testing.T) {
+		page, err := qs.ListExpiringDomains(ctx, &types.QueryListExpiringDomainsRequest{Before: now + 100, Pagination: &query.PageRequest{Limit: 2}})
+		require.NoError(t, err)
+		require.Len(t, page.Domain, 2)
+		require.NotNil(t, page.Pagination.NextKey)
+
+		page, err = qs.ListExpiringDomains(ctx, &types.QueryListExpiringDomainsRequest{Before: now + 100, Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Limit: 2}})
+		require.NoError(t, err)
+		require.Len(t, page.Domain, 1)
+		require.Equal(t, uint64(0), page.Domain[0].Id)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.ListExpiringDomains(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// ListListings lists the domains listed for sale, by domain id.
+func (q queryServer) ListListings(ctx context.Context, req *types.QueryListListingsRequest) (*types.QueryListListingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	listings, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Listings,
+		req.Pagination,
+		func(_ uint64, listing types.Listing) (types.Listing, error) {
+			return listing, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryListListingsResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+// MarketOffers lists the escrowed offers to buy a domain, by buyer.
+func (q queryServer) MarketOffers(ctx context.Context, req *types.QueryMarketOffersRequest) (*types.QueryMarketOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	offers, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.MarketOffers,
+		req.Pagination,
+		func(_ collections.Pair[uint64, string], offer types.MarketOffer) (types.MarketOffer, error) {
+			return offer, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, string](req.Id),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryMarketOffersResponse{Offers: offers, Pagination: pageRes}, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// ListReleases lists the lapsed names in their release window with the one-year registration fee
+// they cost at the current block time, those reaching the normal fee soonest first.
+func (q queryServer) ListReleases(ctx context.Context, req *types.QueryListReleasesRequest) (*types.QueryListReleasesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+
+	releases, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ReleaseQueue,
+		req.Pagination,
+		func(key collections.Pair[uint64, string], _ collections.NoValue) (types.ReleasedName, error) {
+			release, err := q.k.Releases.Get(ctx, key.K2())
+			if err != nil {
+				return types.ReleasedName{}, err
+			}
+			registration, _, _ := params.NamePrice(release.Name)
+			return types.ReleasedName{
+				Release: release,
+				Price:   registration.Add(params.ReleasePremium(registration, release, now)...),
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryListReleasesResponse{Releases: releases, Pagination: pageRes}, nil
+}
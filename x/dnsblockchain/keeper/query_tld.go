@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// TLDStatsExpiringWindow is how far ahead of the block time TLDStats counts expiring domains (30 days).
+const TLDStatsExpiringWindow uint64 = 30 * 24 * 60 * 60
+
+// ListDomainsByTLD lists the domains registered under a TLD through the TLD index.
+func (q queryServer) ListDomainsByTLD(ctx context.Context, req *types.QueryListDomainsByTLDRequest) (*types.QueryListDomainsByTLDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	tld := strings.ToLower(strings.Trim(req.Tld, "."))
+	if tld == "" {
+		return nil, status.Error(codes.InvalidArgument, "tld cannot be empty")
+	}
+
+	domains, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.DomainsByTLD,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Domain, error) {
+			return q.k.Domain.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](tld),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListDomainsByTLDResponse{Domain: domains, Pagination: pageRes}, nil
+}
+
+// TLDStats returns the running statistics of a TLD together with the number of its domains
+// expiring within the next 30 days.
+func (q queryServer) TLDStats(ctx context.Context, req *types.QueryTLDStatsRequest) (*types.QueryTLDStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	tld := strings.ToLower(strings.Trim(req.Tld, "."))
+	if tld == "" {
+		return nil, status.Error(codes.InvalidArgument, "tld cannot be empty")
+	}
+
+	stats, err := q.k.GetTLDStats(ctx, tld)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	expiring, err := q.k.CountTLDExpirations(ctx, tld, now, now+TLDStatsExpiringWindow)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTLDStatsResponse{
+		Tld:          tld,
+		DomainCount:  stats.DomainCount,
+		ExpiringSoon: expiring,
+		TotalFees:    stats.TotalFees,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestTLDQueries(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	require.NoError(t, f.keeper.PermittedTLDs.Set(f.ctx, "dweb"))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	var web3IDs []uint64
+	for i := 0; i < 3; i++ {
+		name := fmt.Sprintf("name%d.web3", i)
+		resp, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: name, Owner: creator, NsRecords: testNSRecords(name)})
+		require.NoError(t, err)
+		web3IDs = append(web3IDs, resp.Id)
+	}
+	// Registered later so it expires outside the 30-day window checked below.
+	later := ctx.WithBlockTime(now.AddDate(0, 2, 0))
+	_, err = srv.CreateDomain(later, &types.MsgCreateDomain{Creator: creator, Name: "later.web3", Owner: creator, NsRecords: testNSRecords("later.web3")})
+	require.NoError(t, err)
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "other.dweb", Owner: creator, NsRecords: testNSRecords("other.dweb")})
+	require.NoError(t, err)
+
+	listResp, err := qs.ListDomainsByTLD(ctx, &types.QueryListDomainsByTLDRequest{Tld: "web3", Pagination: &query.PageRequest{Limit: 10}})
+	require.NoError(t, err)
+	require.Len(t, listResp.Domain, 4)
+	for _, d := range listResp.Domain {
+		require.Equal(t, "web3", types.ExtractTLD(d.Name))
+	}
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	web3Fees := params.RegistrationFee("name0.web3", 1).Add(params.RegistrationFee("name1.web3", 1)...).
+		Add(params.RegistrationFee("name2.web3", 1)...).Add(params.RegistrationFee("later.web3", 1)...)
+
+	// Eleven months later the first three names expire within 30 days.
+	statsCtx := ctx.WithBlockTime(now.AddDate(0, 11, 15))
+	stats, err := qs.TLDStats(statsCtx, &types.QueryTLDStatsRequest{Tld: ".WEB3"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryTLDStatsResponse{Tld: "web3", DomainCount: 4, ExpiringSoon: 3, TotalFees: web3Fees}, stats)
+
+	// Renewals move the name out of the window and add to the fee total.
+	_, err = srv.RenewDomain(statsCtx, &types.MsgRenewDomain{Creator: creator, Id: web3IDs[0], Years: 1})
+	require.NoError(t, err)
+	_, err = srv.DeleteDomain(statsCtx, &types.MsgDeleteDomain{Creator: creator, Id: web3IDs[1]})
+	require.NoError(t, err)
+
+	stats, err = qs.TLDStats(statsCtx, &types.QueryTLDStatsRequest{Tld: "web3"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), stats.DomainCount)
+	require.Equal(t, uint64(1), stats.ExpiringSoon)
+	require.Equal(t, web3Fees.Add(params.RenewalFee("name0.web3", 1)...), stats.TotalFees)
+
+	stats, err = qs.TLDStats(statsCtx, &types.QueryTLDStatsRequest{Tld: "dweb"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.DomainCount)
+}
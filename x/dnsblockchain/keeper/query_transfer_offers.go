@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// PendingTransferOffers lists the transfer offers made to an address through the recipient index,
+// leaving out those expired but not pruned yet.
+func (q queryServer) PendingTransferOffers(ctx context.Context, req *types.QueryPendingTransferOffersRequest) (*types.QueryPendingTransferOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := q.k.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+
+	offers, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.TransferOffersByTo,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (bool, error) {
+			offer, err := q.k.TransferOffers.Get(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			return now < offer.Expires, nil
+		},
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.TransferOffer, error) {
+			return q.k.TransferOffers.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Address),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPendingTransferOffersResponse{Offers: offers, Pagination: pageRes}, nil
+}
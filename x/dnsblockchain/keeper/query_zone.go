@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dnsblockchain/x/dnsblockchain/dnsserver"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// ExportZone renders the active domains of a TLD as a master file whose SOA serial is the block
+// height the query runs at, so secondaries see a new serial whenever the registry may have changed.
+// It is paginated over the TLD index: the first page carries the SOA and apex NS records and the
+// rest only the records of their domains. Domains occluded by a delegation above them are left
+// out of every page, so the pages need not be rendered together.
+func (q queryServer) ExportZone(ctx context.Context, req *types.QueryExportZoneRequest) (*types.QueryExportZoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	tld := strings.ToLower(strings.Trim(req.Tld, "."))
+	if tld == "" {
+		return nil, status.Error(codes.InvalidArgument, "tld cannot be empty")
+	}
+	permitted, err := q.k.IsTLDPermitted(ctx, tld)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !permitted {
+		return nil, status.Errorf(codes.NotFound, "tld %s is not permitted", tld)
+	}
+
+	domains, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.DomainsByTLD,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (bool, error) {
+			_, include, err := q.k.zoneDomain(ctx, key.K2())
+			return include, err
+		},
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Domain, error) {
+			domain, _, err := q.k.zoneDomain(ctx, key.K2())
+			return domain, err
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](tld),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	serial := uint32(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	var (
+		zone  string
+		count int
+	)
+	if page := req.Pagination; page == nil || (len(page.Key) == 0 && page.Offset == 0) {
+		cfg := dnsserver.DefaultConfig()
+		if req.Nameserver != "" {
+			cfg.Nameserver = req.Nameserver
+		}
+		cfg.Hostmaster = req.Hostmaster
+		zone, count = dnsserver.ExportZone(tld, serial, cfg, domains)
+	} else {
+		zone, count = dnsserver.ExportZoneRecords(tld, domains)
+	}
+
+	return &types.QueryExportZoneResponse{Zone: zone, Serial: serial, DomainCount: uint64(count), Pagination: pageRes}, nil
+}
+
+// zoneDomain returns a domain as of the current block time and whether its records belong in its
+// TLD's zone: it must be active and no active domain above it may delegate it away.
+func (k Keeper) zoneDomain(ctx context.Context, id uint64) (types.Domain, bool, error) {
+	domain, err := k.Domain.Get(ctx, id)
+	if err != nil {
+		return domain, false, err
+	}
+	// Names past expiration no longer resolve, even before the EndBlocker persists their status.
+	if domain, _, err = k.EffectiveDomain(ctx, domain); err != nil {
+		return domain, false, err
+	}
+	if domain.Status != types.DomainStatus_DOMAIN_STATUS_ACTIVE {
+		return domain, false, nil
+	}
+	for name := domain.Parent; name != ""; name = types.ParentName(name) {
+		ancestor, err := k.GetDomainByName(ctx, name)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return domain, false, err
+		}
+		if ancestor, _, err = k.EffectiveDomain(ctx, ancestor); err != nil {
+			return domain, false, err
+		}
+		if ancestor.Status == types.DomainStatus_DOMAIN_STATUS_ACTIVE && len(ancestor.NsRecords) > 0 {
+			return domain, false, nil
+		}
+	}
+	return domain, true, nil
+}
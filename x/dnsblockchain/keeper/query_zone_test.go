@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestExportZone(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	start := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "old.web3", Owner: creator, NsRecords: testNSRecords("old.web3")})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(start.AddDate(0, 11, 0))
+	delegated, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "delegated.web3", Owner: creator, NsRecords: []*types.NSRecordWithIP{
+		{Name: "ns1.delegated.web3", Ipv4Addresses: []string{"192.0.2.1"}, Ipv6Addresses: []string{"2001:db8::1"}},
+		{Name: "ns.provider.org", Ipv4Addresses: []string{"198.51.100.1"}},
+	}})
+	require.NoError(t, err)
+	_, err = srv.SetDomainDSRecords(ctx, &types.MsgSetDomainDSRecords{Creator: creator, Id: delegated.Id, DsRecords: []*types.DSRecord{
+		{KeyTag: 2371, Algorithm: 13, DigestType: 2, Digest: strings.Repeat("ab", 32)},
+	}})
+	require.NoError(t, err)
+
+	onchain, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "onchain.web3", Owner: creator, NsRecords: testNSRecords("onchain.web3")})
+	require.NoError(t, err)
+	_, err = srv.SetDomainRecords(ctx, &types.MsgSetDomainRecords{Creator: creator, Id: onchain.Id, ClearNsRecords: true, Records: []*types.ResourceRecord{
+		{Type: types.RecordType_RECORD_TYPE_A, Value: "192.0.2.10"},
+		{Name: "www", Type: types.RecordType_RECORD_TYPE_CNAME, Value: "onchain.web3"},
+	}})
+	require.NoError(t, err)
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "sub.onchain.web3", Owner: creator, NsRecords: testNSRecords("sub.onchain.web3")})
+	require.NoError(t, err)
+
+	// Thirteen months in, old.web3 has expired and is left out.
+	ctx = ctx.WithBlockTime(start.AddDate(0, 13, 0)).WithBlockHeight(4242)
+	resp, err := qs.ExportZone(ctx, &types.QueryExportZoneRequest{Tld: "WEB3.", Nameserver: "ns.registry.example"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(4242), resp.Serial)
+	require.Equal(t, uint64(3), resp.DomainCount)
+	require.NotContains(t, resp.Zone, "old.web3")
+
+	// The export is a master file standard tools can load.
+	var got []string
+	parser := dns.NewZoneParser(strings.NewReader(resp.Zone), "", "")
+	for rr, ok := parser.Next(); ok; rr, ok = parser.Next() {
+		got = append(got, strings.Join(strings.Fields(rr.String()), " "))
+	}
+	require.NoError(t, parser.Err())
+	require.Equal(t, []string{
+		"web3. 300 IN SOA ns.registry.example. hostmaster.web3. 4242 3600 600 86400 300",
+		"web3. 3600 IN NS ns.registry.example.",
+		"delegated.web3. 3600 IN NS ns1.delegated.web3.",
+		"delegated.web3. 3600 IN NS ns.provider.org.",
+		"delegated.web3. 3600 IN DS 2371 13 2 " + strings.Repeat("AB", 32),
+		"ns1.delegated.web3. 3600 IN A 192.0.2.1",
+		"ns1.delegated.web3. 3600 IN AAAA 2001:db8::1",
+		"onchain.web3. 3600 IN A 192.0.2.10",
+		"www.onchain.web3. 3600 IN CNAME onchain.web3.",
+		"sub.onchain.web3. 3600 IN NS ns1.sub.onchain.web3.",
+		"ns1.sub.onchain.web3. 3600 IN A 192.0.2.1",
+	}, got)
+
+	_, err = qs.ExportZone(ctx, &types.QueryExportZoneRequest{Tld: "nope"})
+	require.Error(t, err)
+}
+
+func TestExportZonePaginated(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	for _, name := range []string{"a.web3", "b.web3", "sub.a.web3", "c.web3"} {
+		_, err := srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: name, Owner: creator, NsRecords: testNSRecords(name)})
+		require.NoError(t, err)
+	}
+	ctx = ctx.WithBlockHeight(77)
+
+	parse := func(zone string) []string {
+		var rrs []string
+		parser := dns.NewZoneParser(strings.NewReader(zone), "", "")
+		for rr, ok := parser.Next(); ok; rr, ok = parser.Next() {
+			rrs = append(rrs, strings.Join(strings.Fields(rr.String()), " "))
+		}
+		require.NoError(t, parser.Err())
+		return rrs
+	}
+
+	whole, err := qs.ExportZone(ctx, &types.QueryExportZoneRequest{Tld: "web3"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), whole.DomainCount)
+
+	// One domain per page. sub.a.web3 is occluded by the delegation of a.web3 on another page.
+	var (
+		zone    strings.Builder
+		domains uint64
+		pages   int
+	)
+	req := &types.QueryExportZoneRequest{Tld: "web3", Pagination: &query.PageRequest{Limit: 1}}
+	for {
+		resp, err := qs.ExportZone(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, uint32(77), resp.Serial)
+		zone.WriteString(resp.Zone)
+		domains += resp.DomainCount
+		pages++
+		if len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}
+	}
+	require.Equal(t, 3, pages)
+	require.Equal(t, whole.DomainCount, domains)
+	require.ElementsMatch(t, parse(whole.Zone), parse(zone.String()))
+	require.NotContains(t, zone.String(), "sub.a.web3")
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// SetRelease stores the release of a lapsed name and queues it for pruning at its end.
+func (k Keeper) SetRelease(ctx context.Context, release types.NameRelease) error {
+	if err := k.Releases.Set(ctx, release.Name, release); err != nil {
+		return err
+	}
+	return k.ReleaseQueue.Set(ctx, collections.Join(release.End, release.Name))
+}
+
+// RemoveRelease deletes the release of a name, if any.
+func (k Keeper) RemoveRelease(ctx context.Context, name string) error {
+	release, err := k.Releases.Get(ctx, name)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.Releases.Remove(ctx, name); err != nil {
+		return err
+	}
+	return k.ReleaseQueue.Remove(ctx, collections.Join(release.End, name))
+}
+
+// releaseOf returns the release a domain due for release starts, and false when its name is
+// released at the normal fee: subdomains, and every name while Params.ReleaseStartMultiplier is
+// below two.
+func (k Keeper) releaseOf(ctx context.Context, domain types.Domain) (types.NameRelease, bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.NameRelease{}, false, errorsmod.Wrap(err, "failed to get dnsblockchain module params")
+	}
+	if params.ReleaseStartMultiplier <= 1 || types.ParentName(domain.Name) != "" {
+		return types.NameRelease{}, false, nil
+	}
+	// The release starts when the pending-delete period ended, however late the name is swept.
+	effective, _ := domain.AdvanceLifecycle(uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()), params)
+	return types.NameRelease{
+		Name:  domain.Name,
+		Start: effective.StatusDeadline,
+		End:   effective.StatusDeadline + params.ReleaseWindow(),
+	}, true, nil
+}
+
+// RegistrationPrice returns the fee for registering an available name for the given number of
+// years: its registration fee, plus the release premium while the name is in its release window.
+func (k Keeper) RegistrationPrice(ctx context.Context, params types.Params, availability NameAvailability, years uint64) (sdk.Coins, error) {
+	fee := params.RegistrationFee(availability.Name, years)
+
+	var (
+		release   types.NameRelease
+		releasing bool
+		err       error
+	)
+	if availability.Released != nil {
+		// Not swept yet: the release it is due for has not been recorded.
+		release, releasing, err = k.releaseOf(ctx, *availability.Released)
+	} else {
+		release, err = k.Releases.Get(ctx, availability.Name)
+		releasing = err == nil
+		if errors.Is(err, collections.ErrNotFound) {
+			err = nil
+		}
+	}
+	if err != nil || !releasing {
+		return fee, err
+	}
+
+	registration, _, _ := params.NamePrice(availability.Name)
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	return fee.Add(params.ReleasePremium(registration, release, now)...), nil
+}
+
+// PruneReleases removes at most limit releases whose window has ended, earliest first. It returns
+// how many releases it removed.
+func (k Keeper) PruneReleases(ctx context.Context, limit uint64) (uint64, error) {
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+
+	var ended []string
+	rng := new(collections.Range[collections.Pair[uint64, string]]).
+		EndExclusive(collections.PairPrefix[uint64, string](now + 1))
+	err := k.ReleaseQueue.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		ended = append(ended, key.K2())
+		return uint64(len(ended)) >= limit, nil
+	})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to iterate release queue")
+	}
+	for _, name := range ended {
+		if err := k.RemoveRelease(ctx, name); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(ended)), nil
+}
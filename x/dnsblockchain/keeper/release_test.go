@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/keeper"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestDutchAuctionRelease(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	params := types.DefaultParams()
+	params.ReleaseStartMultiplier = 5
+	params.ReleasePeriod = 100 * 60 * 60
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	for _, name := range []string{"lapsed.web3", "quiet.web3"} {
+		_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: name, Owner: creator, NsRecords: testNSRecords(name)})
+		require.NoError(t, err)
+	}
+	_, err = srv.CreateDomain(ctx, &types.MsgCreateDomain{Creator: creator, Name: "www.lapsed.web3", Owner: creator, NsRecords: testNSRecords("www.lapsed.web3")})
+	require.NoError(t, err)
+
+	// Swept a while after its pending-delete period ended, the release still starts at its end.
+	start := types.AddYears(uint64(now.Unix()), 1) + params.GracePeriod + params.RedemptionPeriod + params.PendingDeletePeriod
+	sweep := ctx.WithBlockTime(time.Unix(int64(start)+25*60*60, 0))
+	require.NoError(t, f.keeper.EndBlocker(sweep))
+
+	release, err := f.keeper.Releases.Get(sweep, "lapsed.web3")
+	require.NoError(t, err)
+	require.Equal(t, types.NameRelease{Name: "lapsed.web3", Start: start, End: start + params.ReleasePeriod}, release)
+	// Subdomains are not auctioned.
+	has, err := f.keeper.Releases.Has(sweep, "www.lapsed.web3")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// A quarter into the window, the premium of four times the fee has decayed to three times it.
+	registration, _, _ := params.NamePrice("lapsed.web3")
+	live := registration.Add(registration.MulInt(math.NewInt(3))...)
+	list, err := qs.ListReleases(sweep, &types.QueryListReleasesRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Releases, 2)
+	require.Equal(t, release, list.Releases[0].Release)
+	require.Equal(t, live, list.Releases[0].Price)
+
+	price, err := qs.DomainPrice(sweep, &types.QueryDomainPriceRequest{Name: "lapsed.web3"})
+	require.NoError(t, err)
+	require.Equal(t, live, price.RegistrationFee)
+
+	// CreateDomain charges the live price, and the registration ends the release.
+	f.bankKeeper.sentToModule = nil
+	_, err = srv.CreateDomain(sweep, &types.MsgCreateDomain{Creator: other, Name: "lapsed.web3", Owner: other, NsRecords: testNSRecords("lapsed.web3")})
+	require.NoError(t, err)
+	require.Equal(t, live, f.bankKeeper.sentToModule)
+	has, err = f.keeper.Releases.Has(sweep, "lapsed.web3")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	exported, err := f.keeper.ExportGenesis(sweep)
+	require.NoError(t, err)
+	require.Len(t, exported.Releases, 1)
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported.Releases, reexported.Releases)
+
+	// Past its window the release is pruned and the name costs its normal fee.
+	ended := ctx.WithBlockTime(time.Unix(int64(start+params.ReleasePeriod), 0))
+	require.NoError(t, f.keeper.EndBlocker(ended))
+	list, err = qs.ListReleases(ended, &types.QueryListReleasesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, list.Releases)
+	price, err = qs.DomainPrice(ended, &types.QueryDomainPriceRequest{Name: "quiet.web3"})
+	require.NoError(t, err)
+	require.Equal(t, registration, price.RegistrationFee)
+}
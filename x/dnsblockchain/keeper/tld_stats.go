@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// GetTLDStats returns the statistics of a TLD, or empty statistics if none were recorded yet.
+func (k Keeper) GetTLDStats(ctx context.Context, tld string) (types.TLDStats, error) {
+	stats, err := k.TLDStats.Get(ctx, tld)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TLDStats{Tld: tld}, nil
+	}
+	return stats, err
+}
+
+// adjustTLDDomainCount adds delta to the domain count of a TLD.
+func (k Keeper) adjustTLDDomainCount(ctx context.Context, tld string, delta int64) error {
+	if tld == "" {
+		return nil
+	}
+	stats, err := k.GetTLDStats(ctx, tld)
+	if err != nil {
+		return err
+	}
+	if delta < 0 && stats.DomainCount < uint64(-delta) {
+		stats.DomainCount = 0
+	} else {
+		stats.DomainCount = uint64(int64(stats.DomainCount) + delta)
+	}
+	return k.TLDStats.Set(ctx, tld, stats)
+}
+
+// addTLDFees adds a charged fee to the running fee total of a TLD.
+func (k Keeper) addTLDFees(ctx context.Context, tld string, fee sdk.Coins) error {
+	stats, err := k.GetTLDStats(ctx, tld)
+	if err != nil {
+		return err
+	}
+	stats.TotalFees = stats.TotalFees.Add(fee...)
+	return k.TLDStats.Set(ctx, tld, stats)
+}
+
+// CountTLDExpirations counts the domains under a TLD whose expiration falls within [from, to].
+// Only that window of the TLD's expiration index is scanned.
+func (k Keeper) CountTLDExpirations(ctx context.Context, tld string, from, to uint64) (uint64, error) {
+	rng := new(collections.Range[collections.Triple[string, uint64, uint64]]).
+		StartInclusive(collections.Join3(tld, from, uint64(0))).
+		EndInclusive(collections.Join3(tld, to, uint64(math.MaxUint64)))
+
+	var count uint64
+	err := k.TLDExpirations.Walk(ctx, rng, func(collections.Triple[string, uint64, uint64]) (bool, error) {
+		count++
+		return false, nil
+	})
+	return count, err
+}
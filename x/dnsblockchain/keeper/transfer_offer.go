@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+// SetTransferOffer stores the transfer offer of a domain, replacing any previous one, and indexes
+// it by recipient and expiry.
+func (k Keeper) SetTransferOffer(ctx context.Context, offer types.TransferOffer) error {
+	if err := k.RemoveTransferOffer(ctx, offer.DomainId); err != nil {
+		return err
+	}
+	if err := k.TransferOffers.Set(ctx, offer.DomainId, offer); err != nil {
+		return err
+	}
+	if err := k.TransferOffersByTo.Set(ctx, collections.Join(offer.To, offer.DomainId)); err != nil {
+		return err
+	}
+	return k.TransferOfferQueue.Set(ctx, collections.Join(offer.Expires, offer.DomainId))
+}
+
+// RemoveTransferOffer deletes the transfer offer of a domain, if any.
+func (k Keeper) RemoveTransferOffer(ctx context.Context, id uint64) error {
+	offer, found, err := k.GetTransferOffer(ctx, id)
+	if err != nil || !found {
+		return err
+	}
+	if err := k.TransferOffers.Remove(ctx, id); err != nil {
+		return err
+	}
+	if err := k.TransferOffersByTo.Remove(ctx, collections.Join(offer.To, id)); err != nil {
+		return err
+	}
+	return k.TransferOfferQueue.Remove(ctx, collections.Join(offer.Expires, id))
+}
+
+// GetTransferOffer returns the transfer offer of a domain, expired or not.
+func (k Keeper) GetTransferOffer(ctx context.Context, id uint64) (types.TransferOffer, bool, error) {
+	offer, err := k.TransferOffers.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TransferOffer{}, false, nil
+	}
+	if err != nil {
+		return types.TransferOffer{}, false, err
+	}
+	return offer, true, nil
+}
+
+// PruneTransferOffers removes at most limit expired transfer offers, earliest first. It returns how
+// many offers it removed.
+func (k Keeper) PruneTransferOffers(ctx context.Context, limit uint64) (uint64, error) {
+	now := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+
+	var expired []uint64
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndExclusive(collections.PairPrefix[uint64, uint64](now + 1))
+	err := k.TransferOfferQueue.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		expired = append(expired, key.K2())
+		return uint64(len(expired)) >= limit, nil
+	})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to iterate transfer offer queue")
+	}
+	for _, id := range expired {
+		if err := k.RemoveTransferOffer(ctx, id); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(expired)), nil
+}
+
+// moveDomain makes newOwner both the creator and the owner of a domain and emits the
+// transfer_domain event on behalf of actor.
+func (k Keeper) moveDomain(ctx context.Context, domain types.Domain, newOwner, actor string) error {
+	oldCreator := domain.Creator
+	oldOwner := domain.Owner // Guardar para el evento
+
+	// En una transferencia completa, tanto el creator como el owner se convierten en el nuevo dueño.
+	domain.Creator = newOwner
+	domain.Owner = newOwner
+
+	if err := k.SetDomain(ctx, domain); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to transfer domain ownership and creatorship")
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferDomain,
+			sdk.NewAttribute(types.AttributeKeyDomainID, fmt.Sprintf("%d", domain.Id)),
+			sdk.NewAttribute(types.AttributeKeyDomainName, domain.Name),
+			sdk.NewAttribute(types.AttributeKeyOldOwner, oldOwner),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner),
+			sdk.NewAttribute("old_creator", oldCreator), // Evento adicional para claridad
+			sdk.NewAttribute("new_creator", newOwner),   // Evento adicional para claridad
+			sdk.NewAttribute(types.AttributeKeyActor, actor),
+		),
+	})
+	return nil
+}
@@ -37,10 +37,91 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod:      "GetDomainByName",
 					Use:            "get-domain-by-name [name]",
-					Short:          "Gets a domain by its FQDN (e.g., example.dweb)",
+					Short:          "Gets a domain by its FQDN (e.g., example.dweb), or the longest registered suffix of it",
 					Alias:          []string{"show-domain-by-name"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
 				},
+				{
+					RpcMethod:      "ListExpiringDomains",
+					Use:            "list-expiring-domains [before]",
+					Short:          "List live domains expiring up to a unix timestamp, soonest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "before"}},
+				},
+				{
+					RpcMethod:      "ListDomainsByOwner",
+					Use:            "list-domains-by-owner [owner]",
+					Short:          "List the domains owned by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "ListDomainsByTLD",
+					Use:            "list-domains-by-tld [tld]",
+					Short:          "List the domains registered under a TLD",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tld"}},
+				},
+				{
+					RpcMethod:      "TLDStats",
+					Use:            "tld-stats [tld]",
+					Short:          "Shows the domain count, upcoming expirations and collected fees of a TLD",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tld"}},
+				},
+				{
+					RpcMethod:      "CheckAvailability",
+					Use:            "check-availability [name]",
+					Short:          "Checks whether a domain name can be registered and the fee it would cost",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "DomainPrice",
+					Use:            "domain-price [name]",
+					Short:          "Shows the registration and renewal price of a domain name",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "DomainRecords",
+					Use:            "domain-records [name]",
+					Short:          "Shows the on-chain resource records of a name and the NS and DS records covering it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "ExportZone",
+					Use:            "export-zone [tld]",
+					Short:          "Shows a page of the active domains of a TLD as an RFC 1035 zone file, with the block height as SOA serial",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tld"}},
+				},
+				{
+					RpcMethod:      "Auction",
+					Use:            "auction [name]",
+					Short:          "Shows an open auction and its bids",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod: "ListAuctions",
+					Use:       "list-auctions",
+					Short:     "List the open auctions, those settling soonest first",
+				},
+				{
+					RpcMethod: "ListReleases",
+					Use:       "list-releases",
+					Short:     "List the lapsed names in their descending-price release window with their live price",
+				},
+				{
+					RpcMethod:      "PendingTransferOffers",
+					Use:            "pending-transfer-offers [address]",
+					Short:          "List the unexpired transfer offers made to an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ListListings",
+					Use:       "list-listings",
+					Short:     "List the domains listed for sale",
+				},
+				{
+					RpcMethod:      "MarketOffers",
+					Use:            "market-offers [id]",
+					Short:          "List the escrowed offers to buy a domain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -68,6 +149,9 @@ ns_records.json content:
   {"name":"ns1.example.web3","ipv4_addresses":["1.2.3.4"],"ipv6_addresses":["2001:db8::1"]},
   {"name":"ns2.example.web3","ipv4_addresses":["5.6.7.8"]}
 ]
+
+Subdomains such as www.example.web3 can only be created by the owner of their parent. They are
+free, expire no later than the parent and are removed together with it.
 `,
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "name"},
@@ -104,15 +188,137 @@ and/or new NS records as a JSON string or path to a file via --ns-records-json.
 				{
 					RpcMethod:      "HeartbeatDomain",
 					Use:            "heartbeat-domain [id]",
-					Short:          "Send a heartbeat to a domain to extend its expiration",
+					Short:          "Send a heartbeat to a domain to renew it for one year",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "RenewDomain",
+					Use:            "renew-domain [id] [years]",
+					Short:          "Renew a domain for a number of years, paying the renewal fee per year",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "years"}},
+				},
 				{
 					RpcMethod:      "TransferDomain",
-					Use:            "transfer-domain [id] [new-owner]",
-					Short:          "Transfer a domain to a new owner",
+					Use:            "transfer-domain [id] [new-owner] --direct",
+					Short:          "Transfer a domain to a new owner at once, without an offer to accept (for automated tooling)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "OfferDomainTransfer",
+					Use:            "offer-domain-transfer [id] [new-owner]",
+					Short:          "Offer a domain to a new owner, who must accept it before the offer expires",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "new_owner"}},
 				},
+				{
+					RpcMethod:      "AcceptDomainTransfer",
+					Use:            "accept-domain-transfer [id]",
+					Short:          "Accept the transfer offer of a domain, paying the transfer fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "CancelDomainTransfer",
+					Use:            "cancel-domain-transfer [id]",
+					Short:          "Withdraw or decline the transfer offer of a domain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "SetDomainRecords",
+					Use:       "set-domain-records [id] --records <json> [--records <json>...]",
+					Short:     "Replace the resource records a domain publishes on chain (owner only)",
+					Long: `Replace every resource record of a domain. Each --records flag takes one record as JSON;
+omitting the flag removes every record. Supported types are A, AAAA, TXT, MX, CNAME, SRV and CAA.
+Example:
+dnsblockchaind tx dnsblockchain set-domain-records 7 \
+  --records '{"name":"@","type":"RECORD_TYPE_A","value":"192.0.2.10","ttl":300}' \
+  --records '{"name":"@","type":"RECORD_TYPE_MX","value":"mail.example.web3","priority":10}' \
+  --records '{"name":"www","type":"RECORD_TYPE_CNAME","value":"example.web3"}' --from mykey
+`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "SetDomainDSRecords",
+					Use:       "set-domain-ds-records [id] --ds-records <json> [--ds-records <json>...]",
+					Short:     "Replace the DNSSEC DS records of a delegated domain (owner only)",
+					Long: `Replace every DS record of a domain delegated through NS records. Each --ds-records flag takes
+one record as JSON; omitting the flag removes every DS record. The digest is hex encoded and must
+match the digest type: 1 (SHA-1, 20 bytes), 2 (SHA-256, 32 bytes) or 4 (SHA-384, 48 bytes).
+Example:
+dnsblockchaind tx dnsblockchain set-domain-ds-records 7 \
+  --ds-records '{"key_tag":2371,"algorithm":13,"digest_type":2,"digest":"1F987CC6583E92DF0890718C42E4E9B4E5D3A3F5E1F6B1A7D0C9E8F7A6B5C4D3"}' --from mykey
+`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					// commit-domain is hand-written so the hash is computed from the name locally.
+					RpcMethod: "CommitDomain",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "RevealDomain",
+					Use:       "reveal-domain [name] [owner] [salt] --ns-records <json> [--ns-records <json>...]",
+					Short:     "Register a committed name, between the minimum and maximum number of blocks after its commitment",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "owner"},
+						{ProtoField: "salt"},
+					},
+				},
+				{
+					RpcMethod:      "OpenAuction",
+					Use:            "open-auction [name]",
+					Short:          "Open a sealed-bid auction for a premium name or a name under a launching TLD, escrowing its minimum price",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod: "PlaceBid",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "RevealBid",
+					Use:       "reveal-bid [name] [amount] [salt] --ns-records <json> [--ns-records <json>...]",
+					Short:     "Reveal a sealed bid once bidding has closed, with the name servers to register the name with if it wins",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "amount"},
+						{ProtoField: "salt"},
+					},
+				},
+				{
+					RpcMethod:      "ListDomain",
+					Use:            "list-domain [id] [price]",
+					Short:          "List a domain for sale at a fixed price",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "price"}},
+				},
+				{
+					RpcMethod:      "UnlistDomain",
+					Use:            "unlist-domain [id]",
+					Short:          "Withdraw a domain from sale",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "BuyDomain",
+					Use:            "buy-domain [id] [price]",
+					Short:          "Buy a listed domain at its listed price, plus the transfer fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "price"}},
+				},
+				{
+					RpcMethod:      "PlaceMarketOffer",
+					Use:            "place-market-offer [id] [amount]",
+					Short:          "Offer to buy a domain, escrowing the amount until the offer is accepted or withdrawn",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "WithdrawMarketOffer",
+					Use:            "withdraw-market-offer [id]",
+					Short:          "Withdraw your offer for a domain and get it refunded",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "AcceptMarketOffer",
+					Use:            "accept-market-offer [id] [buyer]",
+					Short:          "Sell a domain to the maker of an offer, paying the transfer fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "buyer"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types" // Asegúrate que este sea el GovModuleName correcto
 
@@ -33,8 +34,12 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper // Esto es AccountKeeper
-	BankKeeper types.BankKeeper // Este es el que necesitamos pasar
+	AuthKeeper  types.AuthKeeper // Esto es AccountKeeper
+	BankKeeper  types.BankKeeper // Este es el que necesitamos pasar
+	DistrKeeper types.DistributionKeeper
+	NFTKeeper   types.NFTKeeper
+
+	MsgServiceRouter *baseapp.MsgServiceRouter
 }
 
 type ModuleOutputs struct {
@@ -42,6 +47,8 @@ type ModuleOutputs struct {
 
 	DnsblockchainKeeper keeper.Keeper
 	Module              appmodule.AppModule
+	// NFTSendRouter is bound as the baseapp.MessageRouter of the other modules in app.go.
+	NFTSendRouter keeper.NFTSendRouter
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -55,9 +62,15 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 		authority,
 		in.BankKeeper, // <--- PASAR EL BANKKEEPER
+		in.DistrKeeper,
+		in.NFTKeeper,
 	)
 	// El AppModule también necesita BankKeeper si lo va a usar para simulación
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{DnsblockchainKeeper: k, Module: m}
+	return ModuleOutputs{
+		DnsblockchainKeeper: k,
+		Module:              m,
+		NFTSendRouter:       keeper.NewNFTSendRouter(in.MsgServiceRouter, k),
+	}
 }
@@ -0,0 +1,325 @@
+// Package resolver implements the hybrid resolver of section 5 of the whitepaper. Names under
+// ICANN-reserved TLDs are forwarded to ICANN resolvers; names under chain TLDs are looked up in the
+// on-chain registry and, when delegated, resolved by following the NS delegation to the owner's
+// authoritative servers. Names the chain does not know either get NXDOMAIN or fall back to ICANN.
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/miekg/dns"
+
+	"dnsblockchain/x/dnsblockchain/dnsserver"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+const (
+	// DefaultTimeout bounds the resolution of a single query, including every referral.
+	DefaultTimeout = 10 * time.Second
+	// maxReferrals caps the referrals followed below an on-chain delegation.
+	maxReferrals = 8
+	// maxDepth caps nested resolutions for CNAME targets and glue-less name servers.
+	maxDepth = 8
+)
+
+// NotFoundPolicy decides how names under non-ICANN TLDs that the chain does not know are answered.
+type NotFoundPolicy int
+
+const (
+	// NotFoundNXDOMAIN answers NXDOMAIN.
+	NotFoundNXDOMAIN NotFoundPolicy = iota
+	// NotFoundICANN forwards the query to the ICANN upstream.
+	NotFoundICANN
+)
+
+// ParseNotFoundPolicy parses "nxdomain" or "icann".
+func ParseNotFoundPolicy(s string) (NotFoundPolicy, error) {
+	switch strings.ToLower(s) {
+	case "nxdomain":
+		return NotFoundNXDOMAIN, nil
+	case "icann":
+		return NotFoundICANN, nil
+	default:
+		return 0, fmt.Errorf("unknown not-found policy %q: must be nxdomain or icann", s)
+	}
+}
+
+// String implements fmt.Stringer.
+func (p NotFoundPolicy) String() string {
+	if p == NotFoundICANN {
+		return "icann"
+	}
+	return "nxdomain"
+}
+
+// Config configures the resolver's upstreams and fallback behavior.
+type Config struct {
+	// ICANN receives queries for ICANN-reserved TLDs, and for unknown names under NotFoundICANN.
+	// Without it those queries are refused.
+	ICANN Upstream
+	// NotFound decides how names the chain does not know are answered.
+	NotFound NotFoundPolicy
+	// Delegation returns the upstream querying the given authoritative server addresses of a
+	// delegated domain. Nil uses NewDNSUpstream, which queries them on port 53.
+	Delegation func(addrs []string) Upstream
+	// Timeout bounds the resolution of a single query.
+	Timeout time.Duration
+}
+
+// Resolver is a recursive resolver for both ICANN and chain names.
+type Resolver struct {
+	chain  dnsserver.Answerer
+	cfg    Config
+	logger log.Logger
+}
+
+// New returns a resolver looking chain names up in registry.
+func New(registry dnsserver.Registry, cfg Config, logger log.Logger) *Resolver {
+	if cfg.Delegation == nil {
+		cfg.Delegation = func(addrs []string) Upstream { return NewDNSUpstream(addrs...) }
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	return &Resolver{
+		chain:  dnsserver.NewServer(registry, dnsserver.DefaultConfig(), logger),
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// ServeDNS implements dns.Handler.
+func (r *Resolver) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	dnsserver.NewHandler(r, r.logger).ServeDNS(w, req)
+}
+
+// Answer implements dnsserver.Answerer.
+func (r *Resolver) Answer(ctx context.Context, req *dns.Msg) *dns.Msg {
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	resp.RecursionAvailable = true
+
+	switch {
+	case req.Opcode != dns.OpcodeQuery:
+		resp.Rcode = dns.RcodeNotImplemented
+	case len(req.Question) != 1:
+		resp.Rcode = dns.RcodeFormatError
+	default:
+		ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+		defer cancel()
+		result, err := r.resolve(ctx, req.Question[0], 0)
+		if err != nil {
+			r.logger.Error("Failed to resolve DNS query", "name", req.Question[0].Name, "error", err)
+			resp.Rcode = dns.RcodeServerFailure
+			break
+		}
+		resp.Rcode = result.Rcode
+		resp.Answer = result.Answer
+		resp.Ns = result.Ns
+		for _, rr := range result.Extra {
+			if rr.Header().Rrtype != dns.TypeOPT {
+				resp.Extra = append(resp.Extra, rr)
+			}
+		}
+	}
+
+	if opt := req.IsEdns0(); opt != nil {
+		resp.SetEdns0(dnsserver.EDNSUDPSize, opt.Do())
+	}
+	return resp
+}
+
+// resolve answers a single question, following delegations and CNAME chains.
+func (r *Resolver) resolve(ctx context.Context, q dns.Question, depth int) (*dns.Msg, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("resolution of %s nested too deeply", q.Name)
+	}
+
+	labels := dns.SplitDomainName(q.Name)
+	if len(labels) == 0 || types.IsReservedTLD(strings.ToLower(labels[len(labels)-1])) {
+		return r.forward(ctx, q)
+	}
+
+	resp := r.chain.Answer(ctx, query(q, false))
+	switch {
+	case resp.Rcode == dns.RcodeServerFailure:
+		return nil, fmt.Errorf("registry lookup of %s failed", q.Name)
+	case resp.Rcode == dns.RcodeRefused || resp.Rcode == dns.RcodeNameError:
+		// The TLD is not on chain, or the name is not registered.
+		return r.notFound(ctx, q, resp)
+	case isReferral(resp):
+		var err error
+		if resp, err = r.followDelegation(ctx, q, resp, depth); err != nil {
+			return nil, err
+		}
+	}
+	return r.chaseCNAME(ctx, q, resp, depth)
+}
+
+// forward sends a question to the ICANN upstream.
+func (r *Resolver) forward(ctx context.Context, q dns.Question) (*dns.Msg, error) {
+	if r.cfg.ICANN == nil {
+		resp := new(dns.Msg)
+		resp.Rcode = dns.RcodeRefused
+		return resp, nil
+	}
+	return r.cfg.ICANN.Exchange(ctx, query(q, true))
+}
+
+// notFound applies the not-found policy to a name the chain does not know.
+func (r *Resolver) notFound(ctx context.Context, q dns.Question, chainResp *dns.Msg) (*dns.Msg, error) {
+	if r.cfg.NotFound == NotFoundICANN {
+		return r.forward(ctx, q)
+	}
+	resp := new(dns.Msg)
+	resp.Rcode = dns.RcodeNameError
+	if chainResp.Rcode == dns.RcodeNameError {
+		resp.Ns = chainResp.Ns
+	}
+	return resp, nil
+}
+
+// followDelegation queries the authoritative servers named by a referral, following further
+// referrals until an answer is returned.
+func (r *Resolver) followDelegation(ctx context.Context, q dns.Question, referral *dns.Msg, depth int) (*dns.Msg, error) {
+	for hop := 0; hop < maxReferrals; hop++ {
+		addrs, err := r.nameserverAddrs(ctx, referral, depth)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := r.cfg.Delegation(addrs).Exchange(ctx, query(q, false))
+		if err != nil {
+			return nil, fmt.Errorf("query to delegated servers of %s failed: %w", q.Name, err)
+		}
+		if !isReferral(resp) {
+			return resp, nil
+		}
+		referral = resp
+	}
+	return nil, fmt.Errorf("too many referrals resolving %s", q.Name)
+}
+
+// nameserverAddrs returns the addresses of the servers named by a referral, from its glue or,
+// when it has none, by resolving the server names.
+func (r *Resolver) nameserverAddrs(ctx context.Context, referral *dns.Msg, depth int) ([]string, error) {
+	var hosts []string
+	for _, rr := range referral.Ns {
+		if ns, ok := rr.(*dns.NS); ok {
+			hosts = append(hosts, strings.ToLower(ns.Ns))
+		}
+	}
+
+	var addrs []string
+	for _, rr := range referral.Extra {
+		switch rr := rr.(type) {
+		case *dns.A:
+			if containsName(hosts, rr.Hdr.Name) {
+				addrs = append(addrs, rr.A.String())
+			}
+		case *dns.AAAA:
+			if containsName(hosts, rr.Hdr.Name) {
+				addrs = append(addrs, rr.AAAA.String())
+			}
+		}
+	}
+	if len(addrs) > 0 {
+		return addrs, nil
+	}
+
+	var errs []error
+	for _, host := range hosts {
+		resp, err := r.resolve(ctx, dns.Question{Name: host, Qtype: dns.TypeA, Qclass: dns.ClassINET}, depth+1)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, rr := range resp.Answer {
+			if a, ok := rr.(*dns.A); ok {
+				addrs = append(addrs, a.A.String())
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, errors.Join(append([]error{errors.New("no reachable name servers in referral")}, errs...)...)
+	}
+	return addrs, nil
+}
+
+// chaseCNAME resolves the target of a CNAME answer that does not already include the requested
+// type, appending the target's records.
+func (r *Resolver) chaseCNAME(ctx context.Context, q dns.Question, resp *dns.Msg, depth int) (*dns.Msg, error) {
+	if q.Qtype == dns.TypeCNAME || q.Qtype == dns.TypeANY || len(resp.Answer) == 0 {
+		return resp, nil
+	}
+
+	// Follow the chain as far as the answer itself goes.
+	current := strings.ToLower(dns.Fqdn(q.Name))
+	for range resp.Answer {
+		next := ""
+		for _, rr := range resp.Answer {
+			if !strings.EqualFold(rr.Header().Name, current) {
+				continue
+			}
+			if rr.Header().Rrtype == q.Qtype {
+				return resp, nil
+			}
+			if cname, ok := rr.(*dns.CNAME); ok {
+				next = strings.ToLower(cname.Target)
+			}
+		}
+		if next == "" {
+			break
+		}
+		current = next
+	}
+	if strings.EqualFold(current, dns.Fqdn(q.Name)) {
+		return resp, nil
+	}
+
+	target, err := r.resolve(ctx, dns.Question{Name: current, Qtype: q.Qtype, Qclass: q.Qclass}, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	out := resp.Copy()
+	out.Rcode = target.Rcode
+	out.Answer = append(out.Answer, target.Answer...)
+	out.Ns = target.Ns
+	return out, nil
+}
+
+// query builds an outgoing query for q.
+func query(q dns.Question, recursionDesired bool) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(q.Name), q.Qtype)
+	m.Question[0].Qclass = q.Qclass
+	m.RecursionDesired = recursionDesired
+	m.SetEdns0(dnsserver.EDNSUDPSize, false)
+	return m
+}
+
+// isReferral reports whether a response delegates the name to other servers.
+func isReferral(m *dns.Msg) bool {
+	if m.Rcode != dns.RcodeSuccess || len(m.Answer) > 0 || m.Authoritative {
+		return false
+	}
+	for _, rr := range m.Ns {
+		if rr.Header().Rrtype == dns.TypeNS {
+			return true
+		}
+	}
+	return false
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
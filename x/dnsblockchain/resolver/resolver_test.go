@@ -0,0 +1,173 @@
+package resolver_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"dnsblockchain/x/dnsblockchain/dnsserver"
+	"dnsblockchain/x/dnsblockchain/resolver"
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+type fakeRegistry map[string]types.Domain
+
+func (r fakeRegistry) GetDomainByName(_ context.Context, in *types.QueryGetDomainByNameRequest, _ ...grpc.CallOption) (*types.QueryGetDomainByNameResponse, error) {
+	candidate := strings.ToLower(strings.Trim(in.Name, "."))
+	for exact := true; strings.Contains(candidate, "."); exact = false {
+		if domain, ok := r[candidate]; ok {
+			return &types.QueryGetDomainByNameResponse{Domain: domain, Found: true, Exact: exact}, nil
+		}
+		_, candidate, _ = strings.Cut(candidate, ".")
+	}
+	return &types.QueryGetDomainByNameResponse{}, nil
+}
+
+func (r fakeRegistry) ListPermittedTLDs(context.Context, *types.QueryListPermittedTLDsRequest, ...grpc.CallOption) (*types.QueryListPermittedTLDsResponse, error) {
+	return &types.QueryListPermittedTLDsResponse{Tlds: []string{"web3"}}, nil
+}
+
+// stubServer serves fixed A and CNAME records on localhost and returns its address.
+func stubServer(t *testing.T, records map[string]dns.RR) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	require.NoError(t, err)
+
+	handler := dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		resp.Authoritative = true
+		if rr, ok := records[strings.ToLower(req.Question[0].Name)]; ok {
+			resp.Answer = []dns.RR{rr}
+		} else {
+			resp.Rcode = dns.RcodeNameError
+		}
+		_ = w.WriteMsg(resp)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- dnsserver.Serve(ctx, handler, pc, l) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+	return pc.LocalAddr().String()
+}
+
+func mustRR(t *testing.T, s string) dns.RR {
+	t.Helper()
+	rr, err := dns.NewRR(s)
+	require.NoError(t, err)
+	return rr
+}
+
+func newTestResolver(t *testing.T, policy resolver.NotFoundPolicy) *resolver.Resolver {
+	t.Helper()
+	icann := stubServer(t, map[string]dns.RR{
+		"example.com.":      mustRR(t, "example.com. 60 IN A 93.184.216.34"),
+		"unknown.web3.":     mustRR(t, "unknown.web3. 60 IN A 198.51.100.1"),
+		"ns.provider.org.":  mustRR(t, "ns.provider.org. 60 IN A 127.0.0.1"),
+		"cdn.provider.org.": mustRR(t, "cdn.provider.org. 60 IN A 198.51.100.7"),
+	})
+	authoritative := stubServer(t, map[string]dns.RR{
+		"www.delegated.web3.": mustRR(t, "www.delegated.web3. 60 IN A 203.0.113.5"),
+		"www.glueless.web3.":  mustRR(t, "www.glueless.web3. 60 IN A 203.0.113.6"),
+	})
+	_, port, err := net.SplitHostPort(authoritative)
+	require.NoError(t, err)
+
+	registry := fakeRegistry{
+		"delegated.web3": {
+			Name:      "delegated.web3",
+			NsRecords: []*types.NSRecordWithIP{{Name: "ns1.delegated.web3", Ipv4Addresses: []string{"127.0.0.1"}}},
+		},
+		"glueless.web3": {
+			Name:      "glueless.web3",
+			NsRecords: []*types.NSRecordWithIP{{Name: "ns.provider.org"}},
+		},
+		"onchain.web3": {
+			Name: "onchain.web3",
+			Records: []*types.ResourceRecord{
+				{Type: types.RecordType_RECORD_TYPE_A, Value: "192.0.2.10"},
+				{Name: "cdn", Type: types.RecordType_RECORD_TYPE_CNAME, Value: "cdn.provider.org"},
+			},
+		},
+	}
+
+	return resolver.New(registry, resolver.Config{
+		ICANN:    resolver.NewDNSUpstream(icann),
+		NotFound: policy,
+		// Every delegated server listens on the stub's port.
+		Delegation: func(addrs []string) resolver.Upstream {
+			servers := make([]string, len(addrs))
+			for i, addr := range addrs {
+				servers[i] = net.JoinHostPort(addr, port)
+			}
+			return resolver.NewDNSUpstream(servers...)
+		},
+	}, log.NewNopLogger())
+}
+
+func resolve(t *testing.T, r *resolver.Resolver, name string, qtype uint16) *dns.Msg {
+	t.Helper()
+	req := new(dns.Msg)
+	req.SetQuestion(dns.Fqdn(name), qtype)
+	return r.Answer(context.Background(), req)
+}
+
+func TestResolverHybridResolution(t *testing.T) {
+	r := newTestResolver(t, resolver.NotFoundNXDOMAIN)
+
+	tests := []struct {
+		desc string
+		name string
+		want string
+	}{
+		{desc: "icann name", name: "example.com", want: "93.184.216.34"},
+		{desc: "delegation with glue", name: "www.delegated.web3", want: "203.0.113.5"},
+		{desc: "delegation without glue", name: "www.glueless.web3", want: "203.0.113.6"},
+		{desc: "on-chain record", name: "onchain.web3", want: "192.0.2.10"},
+		{desc: "on-chain cname to icann", name: "cdn.onchain.web3", want: "198.51.100.7"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp := resolve(t, r, tc.name, dns.TypeA)
+			require.Equal(t, dns.RcodeSuccess, resp.Rcode)
+			require.True(t, resp.RecursionAvailable)
+			require.NotEmpty(t, resp.Answer)
+			a, ok := resp.Answer[len(resp.Answer)-1].(*dns.A)
+			require.True(t, ok)
+			require.Equal(t, tc.want, a.A.String())
+		})
+	}
+}
+
+func TestResolverNotFoundPolicy(t *testing.T) {
+	resp := resolve(t, newTestResolver(t, resolver.NotFoundNXDOMAIN), "unknown.web3", dns.TypeA)
+	require.Equal(t, dns.RcodeNameError, resp.Rcode)
+
+	resp = resolve(t, newTestResolver(t, resolver.NotFoundICANN), "unknown.web3", dns.TypeA)
+	require.Equal(t, dns.RcodeSuccess, resp.Rcode)
+	require.Equal(t, "198.51.100.1", resp.Answer[0].(*dns.A).A.String())
+
+	policy, err := resolver.ParseNotFoundPolicy("ICANN")
+	require.NoError(t, err)
+	require.Equal(t, resolver.NotFoundICANN, policy)
+	_, err = resolver.ParseNotFoundPolicy("drop")
+	require.Error(t, err)
+}
+
+func TestResolverWithoutICANNUpstream(t *testing.T) {
+	r := resolver.New(fakeRegistry{}, resolver.Config{}, log.NewNopLogger())
+	resp := resolve(t, r, "example.com", dns.TypeA)
+	require.Equal(t, dns.RcodeRefused, resp.Rcode)
+}
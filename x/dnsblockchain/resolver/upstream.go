@@ -0,0 +1,67 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// DefaultUpstreamTimeout bounds each exchange with an upstream server.
+const DefaultUpstreamTimeout = 3 * time.Second
+
+// Upstream answers the queries the resolver forwards: ICANN names, and names delegated by the
+// chain to their owners' authoritative servers.
+type Upstream interface {
+	Exchange(ctx context.Context, req *dns.Msg) (*dns.Msg, error)
+}
+
+// DNSUpstream sends queries to a list of DNS servers over UDP, trying each in turn and retrying
+// over TCP when a response is truncated.
+type DNSUpstream struct {
+	servers []string
+	udp     *dns.Client
+	tcp     *dns.Client
+}
+
+// NewDNSUpstream returns an upstream querying servers, given as "host:port" or bare IPs, which use
+// port 53.
+func NewDNSUpstream(servers ...string) *DNSUpstream {
+	addrs := make([]string, 0, len(servers))
+	for _, s := range servers {
+		if _, _, err := net.SplitHostPort(s); err != nil {
+			s = net.JoinHostPort(s, "53")
+		}
+		addrs = append(addrs, s)
+	}
+	return &DNSUpstream{
+		servers: addrs,
+		udp:     &dns.Client{Net: "udp", Timeout: DefaultUpstreamTimeout},
+		tcp:     &dns.Client{Net: "tcp", Timeout: DefaultUpstreamTimeout},
+	}
+}
+
+// Exchange implements Upstream.
+func (u *DNSUpstream) Exchange(ctx context.Context, req *dns.Msg) (*dns.Msg, error) {
+	if len(u.servers) == 0 {
+		return nil, errors.New("no upstream servers configured")
+	}
+	var errs []error
+	for _, addr := range u.servers {
+		resp, _, err := u.udp.ExchangeContext(ctx, req, addr)
+		if err == nil && resp.Truncated {
+			resp, _, err = u.tcp.ExchangeContext(ctx, req, addr)
+		}
+		if err == nil {
+			return resp, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", addr, err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, errors.Join(errs...)
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"crypto/sha256"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultAuctionBidPeriod is how long an auction accepts sealed bids (3 days).
+	DefaultAuctionBidPeriod uint64 = 3 * 24 * 60 * 60
+	// DefaultAuctionRevealPeriod is how long bids can be revealed once bidding closes (2 days).
+	DefaultAuctionRevealPeriod uint64 = 2 * 24 * 60 * 60
+	// DefaultTLDLaunchPeriod is how long the names of a newly permitted TLD can only be won at
+	// auction (7 days).
+	DefaultTLDLaunchPeriod uint64 = 7 * 24 * 60 * 60
+	// DefaultAuctionForfeitBps forfeits the whole deposit of a bid that is never revealed.
+	DefaultAuctionForfeitBps uint32 = FeeSplitTotalBps
+
+	// DefaultReleaseStartMultiplier is the multiple of its normal fee a lapsed name costs when it
+	// is released.
+	DefaultReleaseStartMultiplier uint32 = 100
+	// MaxReleaseStartMultiplier bounds Params.ReleaseStartMultiplier.
+	MaxReleaseStartMultiplier uint32 = 1_000_000
+	// DefaultReleasePeriod is how long the price of a released name takes to decay to its normal
+	// fee (14 days).
+	DefaultReleasePeriod uint64 = 14 * 24 * 60 * 60
+)
+
+// BidHash returns the sealed bid a MsgPlaceBid commits to: the SHA-256 of the normalized name,
+// the bidder, the amount in coin notation (e.g. "25000000udns") and the salt.
+func BidHash(name, bidder, amount, salt string) []byte {
+	name = strings.ToLower(strings.Trim(name, "."))
+	sum := sha256.Sum256([]byte(strings.Join([]string{name, bidder, amount, salt}, "\x00")))
+	return sum[:]
+}
+
+// AuctionPeriods returns the bid and reveal periods of auctions in seconds, falling back to the
+// defaults when unset.
+func (p Params) AuctionPeriods() (bidPeriod, revealPeriod uint64) {
+	bidPeriod, revealPeriod = p.AuctionBidPeriod, p.AuctionRevealPeriod
+	if bidPeriod == 0 {
+		bidPeriod = DefaultAuctionBidPeriod
+	}
+	if revealPeriod == 0 {
+		revealPeriod = DefaultAuctionRevealPeriod
+	}
+	return bidPeriod, revealPeriod
+}
+
+// AuctionForfeit splits the deposit of a bid never revealed into the portion forfeited through the
+// fee split when its auction is settled and the portion refunded to the bidder.
+func (p Params) AuctionForfeit(deposit sdk.Coins) (forfeited, refunded sdk.Coins) {
+	return splitForfeit(deposit, p.AuctionForfeitBps)
+}
+
+// ReleaseWindow returns the seconds over which the price of a released name decays, falling back
+// to the default when unset.
+func (p Params) ReleaseWindow() uint64 {
+	if p.ReleasePeriod == 0 {
+		return DefaultReleasePeriod
+	}
+	return p.ReleasePeriod
+}
+
+// ReleasePremium returns what a released name costs on top of its normal registration fee at
+// time now: (Params.ReleaseStartMultiplier - 1) times the fee at the start of the release,
+// decaying linearly to nothing at its end.
+func (p Params) ReleasePremium(fee sdk.Coins, release NameRelease, now uint64) sdk.Coins {
+	if p.ReleaseStartMultiplier <= 1 || now >= release.End || release.End <= release.Start {
+		return sdk.NewCoins()
+	}
+	elapsed := uint64(0)
+	if now > release.Start {
+		elapsed = now - release.Start
+	}
+	remaining := math.NewIntFromUint64(release.End - release.Start - elapsed)
+	window := math.NewIntFromUint64(release.End - release.Start)
+	extra := math.NewIntFromUint64(uint64(p.ReleaseStartMultiplier - 1))
+
+	premium := sdk.NewCoins()
+	for _, coin := range fee {
+		premium = premium.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(extra).Mul(remaining).Quo(window)))
+	}
+	return premium
+}
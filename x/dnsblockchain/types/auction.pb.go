@@ -0,0 +1,1577 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dnsblockchain/dnsblockchain/v1/auction.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Auction is a sealed-bid, second-price auction for a name under a launching TLD or a premium name.
+// Bids are placed as hashes with a deposit until bid_end and revealed until reveal_end; the name
+// then goes to the highest revealed bid at the price of the second highest.
+type Auction struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Address that opened the auction.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Unix time at which bidding closes and revealing opens.
+	BidEnd uint64 `protobuf:"varint,3,opt,name=bid_end,json=bidEnd,proto3" json:"bid_end,omitempty"`
+	// Unix time at which revealing closes and the auction is settled.
+	RevealEnd uint64 `protobuf:"varint,4,opt,name=reveal_end,json=revealEnd,proto3" json:"reveal_end,omitempty"`
+	// Lowest accepted bid and the price paid when a single bid is revealed: the name's one-year
+	// registration fee when the auction opened.
+	MinPrice types.Coin `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3" json:"min_price"`
+	// Deposit of min_price escrowed by the opener. It is refunded once a bid is revealed and
+	// forfeited through the fee split otherwise, so a name cannot be held in auction for free.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *Auction) Reset()         { *m = Auction{} }
+func (m *Auction) String() string { return proto.CompactTextString(m) }
+func (*Auction) ProtoMessage()    {}
+func (*Auction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2abb4f55cd644dbd, []int{0}
+}
+func (m *Auction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Auction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Auction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Auction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Auction.Merge(m, src)
+}
+func (m *Auction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Auction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Auction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Auction proto.InternalMessageInfo
+
+func (m *Auction) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Auction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Auction) GetBidEnd() uint64 {
+	if m != nil {
+		return m.BidEnd
+	}
+	return 0
+}
+
+func (m *Auction) GetRevealEnd() uint64 {
+	if m != nil {
+		return m.RevealEnd
+	}
+	return 0
+}
+
+func (m *Auction) GetMinPrice() types.Coin {
+	if m != nil {
+		return m.MinPrice
+	}
+	return types.Coin{}
+}
+
+func (m *Auction) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+// AuctionBid is a bidder's sealed bid in an auction.
+type AuctionBid struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// SHA-256 of the name, bidder, amount and salt, as computed by BidHash.
+	SealedBid []byte `protobuf:"bytes,3,opt,name=sealed_bid,json=sealedBid,proto3" json:"sealed_bid,omitempty"`
+	// Deposit held by the module. It must cover the bid, and can exceed it to hide the amount.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// Block height the bid was placed at; earlier bids win ties.
+	Height   int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Revealed bool  `protobuf:"varint,6,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// The revealed bid amount.
+	Amount types.Coin `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount"`
+	// Name servers revealed with the bid, which the name is registered with if the bid wins.
+	NsRecords []*NSRecordWithIP `protobuf:"bytes,8,rep,name=ns_records,json=nsRecords,proto3" json:"ns_records,omitempty"`
+}
+
+func (m *AuctionBid) Reset()         { *m = AuctionBid{} }
+func (m *AuctionBid) String() string { return proto.CompactTextString(m) }
+func (*AuctionBid) ProtoMessage()    {}
+func (*AuctionBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2abb4f55cd644dbd, []int{1}
+}
+func (m *AuctionBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionBid.Merge(m, src)
+}
+func (m *AuctionBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionBid proto.InternalMessageInfo
+
+func (m *AuctionBid) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AuctionBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *AuctionBid) GetSealedBid() []byte {
+	if m != nil {
+		return m.SealedBid
+	}
+	return nil
+}
+
+func (m *AuctionBid) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *AuctionBid) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AuctionBid) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+func (m *AuctionBid) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *AuctionBid) GetNsRecords() []*NSRecordWithIP {
+	if m != nil {
+		return m.NsRecords
+	}
+	return nil
+}
+
+// TLDLaunch records until when the names of a newly permitted TLD can only be won at auction.
+type TLDLaunch struct {
+	Tld string `protobuf:"bytes,1,opt,name=tld,proto3" json:"tld,omitempty"`
+	// Unix time until which names directly under the TLD can only be registered through an auction.
+	AuctionOnlyUntil uint64 `protobuf:"varint,2,opt,name=auction_only_until,json=auctionOnlyUntil,proto3" json:"auction_only_until,omitempty"`
+}
+
+func (m *TLDLaunch) Reset()         { *m = TLDLaunch{} }
+func (m *TLDLaunch) String() string { return proto.CompactTextString(m) }
+func (*TLDLaunch) ProtoMessage()    {}
+func (*TLDLaunch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2abb4f55cd644dbd, []int{2}
+}
+func (m *TLDLaunch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLDLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLDLaunch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLDLaunch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLDLaunch.Merge(m, src)
+}
+func (m *TLDLaunch) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLDLaunch) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLDLaunch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLDLaunch proto.InternalMessageInfo
+
+func (m *TLDLaunch) GetTld() string {
+	if m != nil {
+		return m.Tld
+	}
+	return ""
+}
+
+func (m *TLDLaunch) GetAuctionOnlyUntil() uint64 {
+	if m != nil {
+		return m.AuctionOnlyUntil
+	}
+	return 0
+}
+
+// NameRelease is a lapsed name being released through a descending-price (Dutch) auction: its
+// registration price starts at Params.ReleaseStartMultiplier times the normal fee and decays to it
+// between start and end.
+type NameRelease struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Unix time the name's pending-delete period ended.
+	Start uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// Unix time from which the name costs its normal registration fee.
+	End uint64 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *NameRelease) Reset()         { *m = NameRelease{} }
+func (m *NameRelease) String() string { return proto.CompactTextString(m) }
+func (*NameRelease) ProtoMessage()    {}
+func (*NameRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2abb4f55cd644dbd, []int{3}
+}
+func (m *NameRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameRelease.Merge(m, src)
+}
+func (m *NameRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameRelease proto.InternalMessageInfo
+
+func (m *NameRelease) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NameRelease) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *NameRelease) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Auction)(nil), "dnsblockchain.dnsblockchain.v1.Auction")
+	proto.RegisterType((*AuctionBid)(nil), "dnsblockchain.dnsblockchain.v1.AuctionBid")
+	proto.RegisterType((*TLDLaunch)(nil), "dnsblockchain.dnsblockchain.v1.TLDLaunch")
+	proto.RegisterType((*NameRelease)(nil), "dnsblockchain.dnsblockchain.v1.NameRelease")
+}
+
+func init() {
+	proto.RegisterFile("dnsblockchain/dnsblockchain/v1/auction.proto", fileDescriptor_2abb4f55cd644dbd)
+}
+
+var fileDescriptor_2abb4f55cd644dbd = []byte{
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x6f, 0xd3, 0x3e,
+	0x14, 0x6f, 0xd6, 0x2c, 0x6d, 0xbc, 0xff, 0x61, 0xb2, 0xaa, 0x3f, 0x59, 0x25, 0xb2, 0xaa, 0x5c,
+	0x2a, 0xb1, 0x25, 0xeb, 0x38, 0x70, 0x81, 0xc3, 0x0a, 0x1c, 0x26, 0xc6, 0x98, 0x3c, 0x10, 0x12,
+	0x97, 0xc8, 0x89, 0xad, 0xc6, 0x5a, 0x62, 0x57, 0xb1, 0x5b, 0xd1, 0x6f, 0xc1, 0x81, 0x4f, 0xc1,
+	0x81, 0x13, 0x1f, 0x62, 0xc7, 0x89, 0x13, 0x27, 0x40, 0xed, 0x17, 0x41, 0x8e, 0xbd, 0x41, 0xd1,
+	0xb4, 0x71, 0xe1, 0x94, 0xf7, 0x7b, 0x3f, 0xbf, 0x67, 0xbf, 0xdf, 0x2f, 0x0f, 0xec, 0x10, 0x2e,
+	0xd3, 0x42, 0x64, 0x67, 0x59, 0x8e, 0x19, 0x8f, 0x57, 0xd1, 0x6c, 0x18, 0xe3, 0x69, 0xa6, 0x98,
+	0xe0, 0xd1, 0xa4, 0x12, 0x4a, 0xc0, 0x70, 0x85, 0x8f, 0x56, 0xd1, 0x6c, 0xd8, 0x0d, 0x33, 0x21,
+	0x4b, 0x21, 0xe3, 0x14, 0x4b, 0x1a, 0xcf, 0x86, 0x29, 0x55, 0x78, 0x18, 0x67, 0x82, 0xd9, 0xfa,
+	0xee, 0x96, 0xe1, 0x93, 0x1a, 0xc5, 0x06, 0x58, 0xea, 0xfe, 0x2d, 0x0f, 0x21, 0xa2, 0xc4, 0x57,
+	0x7d, 0x3a, 0x63, 0x31, 0x16, 0xa6, 0x89, 0x8e, 0x4c, 0xb6, 0xff, 0x69, 0x0d, 0xb4, 0x0e, 0xcc,
+	0x7b, 0x21, 0x04, 0x2e, 0xc7, 0x25, 0x0d, 0x9c, 0x9e, 0x33, 0xf0, 0x51, 0x1d, 0xc3, 0x7d, 0xd0,
+	0xca, 0x2a, 0x8a, 0x95, 0xa8, 0x82, 0x35, 0x9d, 0x1e, 0x05, 0x5f, 0x3e, 0xef, 0x76, 0xec, 0x2b,
+	0x0e, 0x08, 0xa9, 0xa8, 0x94, 0xa7, 0xaa, 0x62, 0x7c, 0x8c, 0x2e, 0x0f, 0xc2, 0x3b, 0xa0, 0x95,
+	0x32, 0x92, 0x50, 0x4e, 0x82, 0x66, 0xcf, 0x19, 0xb8, 0xc8, 0x4b, 0x19, 0x79, 0xc6, 0x09, 0xbc,
+	0x0b, 0x40, 0x45, 0x67, 0x14, 0x17, 0x35, 0xe7, 0xd6, 0x9c, 0x6f, 0x32, 0x9a, 0x7e, 0x04, 0xfc,
+	0x92, 0xf1, 0x64, 0x52, 0xb1, 0x8c, 0x06, 0xeb, 0x3d, 0x67, 0xb0, 0xb1, 0xbf, 0x15, 0xd9, 0xab,
+	0xb4, 0x3a, 0x91, 0x55, 0x27, 0x7a, 0x22, 0x18, 0x1f, 0xb9, 0xe7, 0xdf, 0xb6, 0x1b, 0xa8, 0x5d,
+	0x32, 0x7e, 0xa2, 0x0b, 0x20, 0x05, 0x2d, 0x42, 0x27, 0x42, 0x32, 0x15, 0x78, 0xbd, 0xe6, 0xcd,
+	0xb5, 0x7b, 0xba, 0xf6, 0xe3, 0xf7, 0xed, 0xc1, 0x98, 0xa9, 0x7c, 0x9a, 0x46, 0x99, 0x28, 0xad,
+	0xb2, 0xf6, 0xb3, 0x2b, 0xc9, 0x59, 0xac, 0xe6, 0x13, 0x2a, 0xeb, 0x02, 0x89, 0x2e, 0x7b, 0xf7,
+	0x3f, 0x34, 0x01, 0xb0, 0x82, 0x8d, 0x18, 0xb9, 0x56, 0xb3, 0x3d, 0xa0, 0x07, 0x26, 0xf4, 0x76,
+	0xc9, 0xec, 0x39, 0x2d, 0x8c, 0xa4, 0xb8, 0xa0, 0x24, 0x49, 0x99, 0x11, 0xed, 0x3f, 0xe4, 0x9b,
+	0x8c, 0xbe, 0xe4, 0xb7, 0xd1, 0xdc, 0x7f, 0x37, 0x1a, 0xfc, 0x1f, 0x78, 0x39, 0x65, 0xe3, 0x5c,
+	0xd5, 0xe2, 0x37, 0x91, 0x45, 0xb0, 0x0b, 0xda, 0xc6, 0x24, 0x4a, 0x02, 0xaf, 0xe7, 0x0c, 0xda,
+	0xe8, 0x0a, 0xc3, 0x87, 0xc0, 0xc3, 0xa5, 0x98, 0x72, 0x15, 0xb4, 0xfe, 0xce, 0x30, 0x7b, 0x1c,
+	0xbe, 0x00, 0x80, 0xcb, 0xa4, 0xa2, 0x99, 0xa8, 0x88, 0x0c, 0xda, 0xf5, 0x58, 0x51, 0x74, 0xf3,
+	0xae, 0x44, 0xc7, 0xa7, 0xa8, 0x2e, 0x78, 0xc3, 0x54, 0x7e, 0x78, 0x82, 0x7c, 0x2e, 0x0d, 0x96,
+	0xfd, 0xe7, 0xc0, 0x7f, 0x75, 0xf4, 0xf4, 0x08, 0x4f, 0x79, 0x96, 0xc3, 0x4d, 0xd0, 0x54, 0x05,
+	0xb1, 0x9e, 0xe8, 0x10, 0xee, 0x00, 0x68, 0xb7, 0x32, 0x11, 0xbc, 0x98, 0x27, 0x53, 0xae, 0x58,
+	0x51, 0xdb, 0xe3, 0xa2, 0x4d, 0xcb, 0xbc, 0xe4, 0xc5, 0xfc, 0xb5, 0xce, 0xf7, 0x0f, 0xc1, 0xc6,
+	0x31, 0x2e, 0x29, 0xa2, 0x05, 0xc5, 0x92, 0x5e, 0xeb, 0x71, 0x07, 0xac, 0x4b, 0x85, 0x2b, 0x65,
+	0x7b, 0x18, 0xa0, 0x2f, 0xfe, 0xf5, 0xd7, 0xeb, 0x70, 0xf4, 0xf8, 0x7c, 0x11, 0x3a, 0x17, 0x8b,
+	0xd0, 0xf9, 0xb1, 0x08, 0x9d, 0xf7, 0xcb, 0xb0, 0x71, 0xb1, 0x0c, 0x1b, 0x5f, 0x97, 0x61, 0xe3,
+	0xed, 0xbd, 0xd5, 0x75, 0x7d, 0xf7, 0xc7, 0xfa, 0xd6, 0x0e, 0xa5, 0x5e, 0xbd, 0xa5, 0x0f, 0x7e,
+	0x06, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x03, 0x0c, 0x08, 0x73, 0x04, 0x00, 0x00,
+}
+
+func (m *Auction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Auction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.MinPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.RevealEnd != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.RevealEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BidEnd != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.BidEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NsRecords) > 0 {
+		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SealedBid) > 0 {
+		i -= len(m.SealedBid)
+		copy(dAtA[i:], m.SealedBid)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.SealedBid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLDLaunch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLDLaunch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLDLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionOnlyUntil != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionOnlyUntil))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tld) > 0 {
+		i -= len(m.Tld)
+		copy(dAtA[i:], m.Tld)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Tld)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Auction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.BidEnd != 0 {
+		n += 1 + sovAuction(uint64(m.BidEnd))
+	}
+	if m.RevealEnd != 0 {
+		n += 1 + sovAuction(uint64(m.RevealEnd))
+	}
+	l = m.MinPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AuctionBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = len(m.SealedBid)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuction(uint64(m.Height))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if len(m.NsRecords) > 0 {
+		for _, e := range m.NsRecords {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TLDLaunch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tld)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.AuctionOnlyUntil != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionOnlyUntil))
+	}
+	return n
+}
+
+func (m *NameRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovAuction(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovAuction(uint64(m.End))
+	}
+	return n
+}
+
+func sovAuction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuction(x uint64) (n int) {
+	return sovAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Auction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Auction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidEnd", wireType)
+			}
+			m.BidEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEnd", wireType)
+			}
+			m.RevealEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealedBid = append(m.SealedBid[:0], dAtA[iNdEx:postIndex]...)
+			if m.SealedBid == nil {
+				m.SealedBid = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsRecords = append(m.NsRecords, &NSRecordWithIP{})
+			if err := m.NsRecords[len(m.NsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLDLaunch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLDLaunch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLDLaunch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionOnlyUntil", wireType)
+			}
+			m.AuctionOnlyUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionOnlyUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuction = fmt.Errorf("proto: unexpected end of group")
+)
@@ -18,6 +18,23 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgDeleteDomain{},
 		&MsgTransferDomain{},  // Añadido si no estaba
 		&MsgHeartbeatDomain{}, // Añadido si no estaba
+		&MsgRenewDomain{},
+		&MsgSetDomainRecords{},
+		&MsgSetDomainDSRecords{},
+		&MsgCommitDomain{},
+		&MsgRevealDomain{},
+		&MsgOpenAuction{},
+		&MsgPlaceBid{},
+		&MsgRevealBid{},
+		&MsgOfferDomainTransfer{},
+		&MsgAcceptDomainTransfer{},
+		&MsgCancelDomainTransfer{},
+		&MsgListDomain{},
+		&MsgUnlistDomain{},
+		&MsgBuyDomain{},
+		&MsgPlaceMarketOffer{},
+		&MsgWithdrawMarketOffer{},
+		&MsgAcceptMarketOffer{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultCommitMinBlocks is how many blocks a commitment waits before it can be revealed.
+	DefaultCommitMinBlocks uint64 = 2
+	// DefaultCommitMaxBlocks is how long a commitment can be revealed for (about a day of 6s blocks).
+	DefaultCommitMaxBlocks uint64 = 14400
+	// DefaultCommitForfeitBps forfeits the whole deposit of a commitment that is never revealed.
+	// Reveals of names taken after the commitment was made are refunded in full instead.
+	DefaultCommitForfeitBps uint32 = FeeSplitTotalBps
+)
+
+// CommitmentHash returns the hash a MsgCommitDomain commits to: the SHA-256 of the normalized name,
+// the owner, the committer and the salt. Binding the committer and the owner keeps anyone who sees
+// the reveal in the mempool from reusing the commitment for themselves.
+func CommitmentHash(name, owner, creator, salt string) []byte {
+	name = strings.ToLower(strings.Trim(name, "."))
+	sum := sha256.Sum256([]byte(strings.Join([]string{name, owner, creator, salt}, "\x00")))
+	return sum[:]
+}
+
+// CommitWindow returns the blocks a commitment must wait before its reveal and the blocks after
+// which it expires, falling back to the defaults when unset.
+func (p Params) CommitWindow() (minBlocks, maxBlocks uint64) {
+	minBlocks, maxBlocks = p.CommitMinBlocks, p.CommitMaxBlocks
+	if maxBlocks == 0 {
+		maxBlocks = DefaultCommitMaxBlocks
+	}
+	return minBlocks, maxBlocks
+}
+
+// CommitForfeit splits the deposit of a commitment pruned without a reveal into the portion
+// forfeited through the fee split and the portion refunded to the committer.
+func (p Params) CommitForfeit(deposit sdk.Coins) (forfeited, refunded sdk.Coins) {
+	return splitForfeit(deposit, p.CommitForfeitBps)
+}
+
+// splitForfeit splits a deposit into the share of bps basis points that is forfeited and the rest.
+func splitForfeit(deposit sdk.Coins, bps uint32) (forfeited, refunded sdk.Coins) {
+	forfeited = sdk.NewCoins()
+	for _, coin := range deposit {
+		amount := coin.Amount.Mul(math.NewIntFromUint64(uint64(bps))).QuoRaw(FeeSplitTotalBps)
+		forfeited = forfeited.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return forfeited, deposit.Sub(forfeited...)
+}
+
+func validateCommitParams(p Params) error {
+	if err := validateFee("commit deposit", p.CommitDeposit); err != nil {
+		return err
+	}
+	if p.CommitMaxBlocks != 0 && p.CommitMinBlocks >= p.CommitMaxBlocks {
+		return fmt.Errorf("commit min blocks %d must be lower than commit max blocks %d", p.CommitMinBlocks, p.CommitMaxBlocks)
+	}
+	if p.CommitForfeitBps > FeeSplitTotalBps {
+		return fmt.Errorf("commit forfeit %d bps exceeds %d", p.CommitForfeitBps, FeeSplitTotalBps)
+	}
+	return nil
+}
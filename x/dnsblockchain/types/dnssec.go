@@ -0,0 +1,78 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxDSRecordsPerDomain caps the DS records of a delegation, enough for a key rollover across
+// several algorithms and digest types.
+const MaxDSRecordsPerDomain = 8
+
+// dsAlgorithms are the DNSKEY algorithms that may be referenced by a DS record. Algorithms that
+// RFC 8624 forbids for signing (RSAMD5, DSA, DSA-NSEC3-SHA1 and ECC-GOST) are rejected.
+var dsAlgorithms = map[uint32]string{
+	5:  "RSASHA1",
+	7:  "RSASHA1-NSEC3-SHA1",
+	8:  "RSASHA256",
+	10: "RSASHA512",
+	13: "ECDSAP256SHA256",
+	14: "ECDSAP384SHA384",
+	15: "ED25519",
+	16: "ED448",
+}
+
+// dsDigestLengths maps each supported DS digest type to its digest length in bytes.
+var dsDigestLengths = map[uint32]int{
+	1: 20, // SHA-1
+	2: 32, // SHA-256
+	4: 48, // SHA-384
+}
+
+// Validate checks the key tag, the algorithm and that the digest is valid hex of the length its
+// digest type requires.
+func (r DSRecord) Validate() error {
+	if r.KeyTag > 0xFFFF {
+		return errorsmod.Wrapf(ErrInvalidDSRecord, "key tag %d exceeds 65535", r.KeyTag)
+	}
+	if _, ok := dsAlgorithms[r.Algorithm]; !ok {
+		return errorsmod.Wrapf(ErrInvalidDSRecord, "unsupported DNSSEC algorithm %d", r.Algorithm)
+	}
+	length, ok := dsDigestLengths[r.DigestType]
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidDSRecord, "unsupported digest type %d", r.DigestType)
+	}
+	digest, err := hex.DecodeString(r.Digest)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidDSRecord, "digest is not valid hex: %s", err)
+	}
+	if len(digest) != length {
+		return errorsmod.Wrapf(ErrInvalidDSRecord, "digest type %d requires a %d-byte digest, got %d bytes", r.DigestType, length, len(digest))
+	}
+	return nil
+}
+
+// ValidateDSRecords checks every DS record of a set and rejects duplicates and oversized sets.
+func ValidateDSRecords(records []*DSRecord) error {
+	if len(records) > MaxDSRecordsPerDomain {
+		return errorsmod.Wrapf(ErrInvalidDSRecord, "%d DS records exceed the limit of %d", len(records), MaxDSRecordsPerDomain)
+	}
+	seen := make(map[string]bool, len(records))
+	for i, r := range records {
+		if r == nil {
+			return errorsmod.Wrapf(ErrInvalidDSRecord, "DS record %d cannot be nil", i)
+		}
+		if err := r.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "DS record %d", i)
+		}
+		key := fmt.Sprintf("%d|%d|%d|%s", r.KeyTag, r.Algorithm, r.DigestType, strings.ToUpper(r.Digest))
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidDSRecord, "DS record %d duplicates an earlier record", i)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestValidateDSRecords(t *testing.T) {
+	sha256 := strings.Repeat("ab", 32)
+	valid := &types.DSRecord{KeyTag: 2371, Algorithm: 13, DigestType: 2, Digest: sha256}
+
+	tests := []struct {
+		desc    string
+		records []*types.DSRecord
+		valid   bool
+	}{
+		{desc: "empty", valid: true},
+		{desc: "sha256", records: []*types.DSRecord{valid}, valid: true},
+		{desc: "sha384 and sha1", records: []*types.DSRecord{
+			{KeyTag: 1, Algorithm: 14, DigestType: 4, Digest: strings.Repeat("CD", 48)},
+			{KeyTag: 1, Algorithm: 8, DigestType: 1, Digest: strings.Repeat("01", 20)},
+		}, valid: true},
+		{desc: "key tag too large", records: []*types.DSRecord{{KeyTag: 70000, Algorithm: 13, DigestType: 2, Digest: sha256}}},
+		{desc: "forbidden algorithm", records: []*types.DSRecord{{KeyTag: 1, Algorithm: 1, DigestType: 2, Digest: sha256}}},
+		{desc: "unknown digest type", records: []*types.DSRecord{{KeyTag: 1, Algorithm: 13, DigestType: 3, Digest: sha256}}},
+		{desc: "digest length mismatch", records: []*types.DSRecord{{KeyTag: 1, Algorithm: 13, DigestType: 4, Digest: sha256}}},
+		{desc: "digest not hex", records: []*types.DSRecord{{KeyTag: 1, Algorithm: 13, DigestType: 2, Digest: strings.Repeat("zz", 32)}}},
+		{desc: "duplicate", records: []*types.DSRecord{valid, {KeyTag: 2371, Algorithm: 13, DigestType: 2, Digest: strings.ToUpper(sha256)}}},
+		{desc: "too many", records: func() []*types.DSRecord {
+			var records []*types.DSRecord
+			for i := 0; i <= types.MaxDSRecordsPerDomain; i++ {
+				records = append(records, &types.DSRecord{KeyTag: uint32(i), Algorithm: 13, DigestType: 2, Digest: sha256})
+			}
+			return records
+		}()},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.ValidateDSRecords(tc.records)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidDSRecord)
+			}
+		})
+	}
+}
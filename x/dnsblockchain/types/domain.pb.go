@@ -22,6 +22,94 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RecordType is the type of an on-chain resource record.
+type RecordType int32
+
+const (
+	RecordType_RECORD_TYPE_UNSPECIFIED RecordType = 0
+	// IPv4 address in value.
+	RecordType_RECORD_TYPE_A RecordType = 1
+	// IPv6 address in value.
+	RecordType_RECORD_TYPE_AAAA RecordType = 2
+	// Free text in value, split into 255-byte strings when served.
+	RecordType_RECORD_TYPE_TXT RecordType = 3
+	// Mail exchanger host in value, with its preference in priority.
+	RecordType_RECORD_TYPE_MX RecordType = 4
+	// Canonical name in value. It cannot share its name with any other record.
+	RecordType_RECORD_TYPE_CNAME RecordType = 5
+	// Target host in value, with priority, weight and port. The name must be "_service._proto".
+	RecordType_RECORD_TYPE_SRV RecordType = 6
+	// Certification authority authorization: flags, tag ("issue", "issuewild" or "iodef") and value.
+	RecordType_RECORD_TYPE_CAA RecordType = 7
+)
+
+var RecordType_name = map[int32]string{
+	0: "RECORD_TYPE_UNSPECIFIED",
+	1: "RECORD_TYPE_A",
+	2: "RECORD_TYPE_AAAA",
+	3: "RECORD_TYPE_TXT",
+	4: "RECORD_TYPE_MX",
+	5: "RECORD_TYPE_CNAME",
+	6: "RECORD_TYPE_SRV",
+	7: "RECORD_TYPE_CAA",
+}
+
+var RecordType_value = map[string]int32{
+	"RECORD_TYPE_UNSPECIFIED": 0,
+	"RECORD_TYPE_A":           1,
+	"RECORD_TYPE_AAAA":        2,
+	"RECORD_TYPE_TXT":         3,
+	"RECORD_TYPE_MX":          4,
+	"RECORD_TYPE_CNAME":       5,
+	"RECORD_TYPE_SRV":         6,
+	"RECORD_TYPE_CAA":         7,
+}
+
+func (x RecordType) String() string {
+	return proto.EnumName(RecordType_name, int32(x))
+}
+
+func (RecordType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bcd274ba4fefaf66, []int{0}
+}
+
+// DomainStatus is the registry lifecycle state of a domain.
+// The zero value is ACTIVE so domains stored before lifecycle states existed stay live.
+type DomainStatus int32
+
+const (
+	// Registered and within its expiration.
+	DomainStatus_DOMAIN_STATUS_ACTIVE DomainStatus = 0
+	// Expired; only the owner can renew it with a heartbeat.
+	DomainStatus_DOMAIN_STATUS_GRACE DomainStatus = 1
+	// Past the grace period; the owner can still restore it by paying the restore fee.
+	DomainStatus_DOMAIN_STATUS_REDEMPTION DomainStatus = 2
+	// Awaiting release; no further changes are accepted.
+	DomainStatus_DOMAIN_STATUS_PENDING_DELETE DomainStatus = 3
+)
+
+var DomainStatus_name = map[int32]string{
+	0: "DOMAIN_STATUS_ACTIVE",
+	1: "DOMAIN_STATUS_GRACE",
+	2: "DOMAIN_STATUS_REDEMPTION",
+	3: "DOMAIN_STATUS_PENDING_DELETE",
+}
+
+var DomainStatus_value = map[string]int32{
+	"DOMAIN_STATUS_ACTIVE":         0,
+	"DOMAIN_STATUS_GRACE":          1,
+	"DOMAIN_STATUS_REDEMPTION":     2,
+	"DOMAIN_STATUS_PENDING_DELETE": 3,
+}
+
+func (x DomainStatus) String() string {
+	return proto.EnumName(DomainStatus_name, int32(x))
+}
+
+func (DomainStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bcd274ba4fefaf66, []int{1}
+}
+
 // NSRecordWithIP define un servidor de nombres con su(s) IP(s) opcional(es).
 type NSRecordWithIP struct {
 	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -83,22 +171,227 @@ func (m *NSRecordWithIP) GetIpv6Addresses() []string {
 	return nil
 }
 
+// ResourceRecord is a typed DNS record published on chain, so a domain can be resolved without
+// running its own name servers.
+type ResourceRecord struct {
+	// Owner name relative to the domain; empty or "@" for the domain itself, e.g. "www" or "_sip._tcp".
+	Name string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type RecordType `protobuf:"varint,2,opt,name=type,proto3,enum=dnsblockchain.dnsblockchain.v1.RecordType" json:"type,omitempty"`
+	// Time to live in seconds; 0 uses the default TTL.
+	Ttl   uint32 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// MX preference or SRV priority.
+	Priority uint32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// SRV weight.
+	Weight uint32 `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	// SRV port.
+	Port uint32 `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	// CAA flags.
+	Flags uint32 `protobuf:"varint,8,opt,name=flags,proto3" json:"flags,omitempty"`
+	// CAA property tag.
+	Tag string `protobuf:"bytes,9,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (m *ResourceRecord) Reset()         { *m = ResourceRecord{} }
+func (m *ResourceRecord) String() string { return proto.CompactTextString(m) }
+func (*ResourceRecord) ProtoMessage()    {}
+func (*ResourceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcd274ba4fefaf66, []int{1}
+}
+func (m *ResourceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceRecord.Merge(m, src)
+}
+func (m *ResourceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceRecord proto.InternalMessageInfo
+
+func (m *ResourceRecord) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResourceRecord) GetType() RecordType {
+	if m != nil {
+		return m.Type
+	}
+	return RecordType_RECORD_TYPE_UNSPECIFIED
+}
+
+func (m *ResourceRecord) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *ResourceRecord) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ResourceRecord) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *ResourceRecord) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ResourceRecord) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *ResourceRecord) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *ResourceRecord) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+// DSRecord is a DNSSEC delegation signer record (RFC 4034) identifying a key of the delegated zone.
+type DSRecord struct {
+	// Key tag of the referenced DNSKEY, 0-65535.
+	KeyTag uint32 `protobuf:"varint,1,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
+	// DNSSEC algorithm number of the referenced DNSKEY, e.g. 13 for ECDSAP256SHA256.
+	Algorithm uint32 `protobuf:"varint,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Digest algorithm: 2 for SHA-256 or 4 for SHA-384; 1 (SHA-1) is accepted for existing zones.
+	DigestType uint32 `protobuf:"varint,3,opt,name=digest_type,json=digestType,proto3" json:"digest_type,omitempty"`
+	// Hex-encoded digest of the DNSKEY.
+	Digest string `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (m *DSRecord) Reset()         { *m = DSRecord{} }
+func (m *DSRecord) String() string { return proto.CompactTextString(m) }
+func (*DSRecord) ProtoMessage()    {}
+func (*DSRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcd274ba4fefaf66, []int{2}
+}
+func (m *DSRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DSRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DSRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DSRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DSRecord.Merge(m, src)
+}
+func (m *DSRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DSRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DSRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DSRecord proto.InternalMessageInfo
+
+func (m *DSRecord) GetKeyTag() uint32 {
+	if m != nil {
+		return m.KeyTag
+	}
+	return 0
+}
+
+func (m *DSRecord) GetAlgorithm() uint32 {
+	if m != nil {
+		return m.Algorithm
+	}
+	return 0
+}
+
+func (m *DSRecord) GetDigestType() uint32 {
+	if m != nil {
+		return m.DigestType
+	}
+	return 0
+}
+
+func (m *DSRecord) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
 // Domain defines the Domain message.
 type Domain struct {
 	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 	// string ns = 4; // Este campo se reemplazaría o se usaría como fallback si ns_records está vacío
-	NsRecords  []*NSRecordWithIP `protobuf:"bytes,7,rep,name=ns_records,json=nsRecords,proto3" json:"ns_records,omitempty"`
-	Creator    string            `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
-	Expiration uint64            `protobuf:"varint,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	NsRecords      []*NSRecordWithIP `protobuf:"bytes,7,rep,name=ns_records,json=nsRecords,proto3" json:"ns_records,omitempty"`
+	Creator        string            `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Expiration     uint64            `protobuf:"varint,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Status         DomainStatus      `protobuf:"varint,8,opt,name=status,proto3,enum=dnsblockchain.dnsblockchain.v1.DomainStatus" json:"status,omitempty"`
+	StatusDeadline uint64            `protobuf:"varint,9,opt,name=status_deadline,json=statusDeadline,proto3" json:"status_deadline,omitempty"`
+	// Name of the parent domain for subdomains (e.g. "example.web3" for "shop.example.web3");
+	// empty for names registered directly under a TLD.
+	Parent string `protobuf:"bytes,10,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Records served directly from chain, in addition to any NS delegation.
+	Records []*ResourceRecord `protobuf:"bytes,11,rep,name=records,proto3" json:"records,omitempty"`
+	// DNSSEC delegation signer records for the zone delegated through ns_records.
+	DsRecords []*DSRecord `protobuf:"bytes,12,rep,name=ds_records,json=dsRecords,proto3" json:"ds_records,omitempty"`
+	// Address that registered the domain. Unlike creator it stays the same across transfers, and it
+	// receives the marketplace royalty when the domain is sold.
+	OriginalCreator string `protobuf:"bytes,13,opt,name=original_creator,json=originalCreator,proto3" json:"original_creator,omitempty"`
+	// Number of times the domain changed hands. Marketplace offers lapse once it moves past the count
+	// they were made at.
+	Transfers uint64 `protobuf:"varint,14,opt,name=transfers,proto3" json:"transfers,omitempty"`
 }
 
 func (m *Domain) Reset()         { *m = Domain{} }
 func (m *Domain) String() string { return proto.CompactTextString(m) }
 func (*Domain) ProtoMessage()    {}
 func (*Domain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcd274ba4fefaf66, []int{1}
+	return fileDescriptor_bcd274ba4fefaf66, []int{3}
 }
 func (m *Domain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -169,8 +462,61 @@ func (m *Domain) GetExpiration() uint64 {
 	return 0
 }
 
+func (m *Domain) GetStatus() DomainStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DomainStatus_DOMAIN_STATUS_ACTIVE
+}
+
+func (m *Domain) GetStatusDeadline() uint64 {
+	if m != nil {
+		return m.StatusDeadline
+	}
+	return 0
+}
+
+func (m *Domain) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *Domain) GetRecords() []*ResourceRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *Domain) GetDsRecords() []*DSRecord {
+	if m != nil {
+		return m.DsRecords
+	}
+	return nil
+}
+
+func (m *Domain) GetOriginalCreator() string {
+	if m != nil {
+		return m.OriginalCreator
+	}
+	return ""
+}
+
+func (m *Domain) GetTransfers() uint64 {
+	if m != nil {
+		return m.Transfers
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("dnsblockchain.dnsblockchain.v1.RecordType", RecordType_name, RecordType_value)
+	proto.RegisterEnum("dnsblockchain.dnsblockchain.v1.DomainStatus", DomainStatus_name, DomainStatus_value)
 	proto.RegisterType((*NSRecordWithIP)(nil), "dnsblockchain.dnsblockchain.v1.NSRecordWithIP")
+	proto.RegisterType((*ResourceRecord)(nil), "dnsblockchain.dnsblockchain.v1.ResourceRecord")
+	proto.RegisterType((*DSRecord)(nil), "dnsblockchain.dnsblockchain.v1.DSRecord")
 	proto.RegisterType((*Domain)(nil), "dnsblockchain.dnsblockchain.v1.Domain")
 }
 
@@ -179,26 +525,56 @@ func init() {
 }
 
 var fileDescriptor_bcd274ba4fefaf66 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbf, 0x4b, 0xc4, 0x30,
-	0x1c, 0xc5, 0x2f, 0xed, 0xfd, 0xa0, 0x5f, 0xb1, 0x43, 0x70, 0xc8, 0x14, 0xca, 0x89, 0x50, 0x10,
-	0x7a, 0x9c, 0xca, 0x6d, 0x0e, 0x8a, 0x8b, 0x83, 0x22, 0x75, 0x10, 0x5c, 0x8e, 0x5c, 0x13, 0xb8,
-	0xa0, 0x97, 0x94, 0xa4, 0xd4, 0xf3, 0xbf, 0xf0, 0xcf, 0x12, 0x5c, 0x6e, 0x74, 0x94, 0xf6, 0x1f,
-	0x11, 0x73, 0x5e, 0x69, 0x1d, 0xdc, 0xf2, 0x79, 0x79, 0xf0, 0xde, 0x97, 0x07, 0xc7, 0x5c, 0xd9,
-	0xc5, 0xb3, 0xce, 0x9e, 0xb2, 0x25, 0x93, 0x6a, 0xd2, 0xa5, 0x72, 0x3a, 0xe1, 0x7a, 0xc5, 0xa4,
-	0x4a, 0x72, 0xa3, 0x0b, 0x8d, 0x69, 0xe7, 0x3b, 0xe9, 0x52, 0x39, 0x1d, 0x1b, 0x08, 0x6f, 0xef,
-	0x53, 0x91, 0x69, 0xc3, 0x1f, 0x64, 0xb1, 0xbc, 0xbe, 0xc3, 0x18, 0xfa, 0x8a, 0xad, 0x04, 0x41,
-	0x11, 0x8a, 0x83, 0xd4, 0xbd, 0xf1, 0x11, 0x84, 0x32, 0x2f, 0xcf, 0xe6, 0x8c, 0x73, 0x23, 0xac,
-	0x15, 0x96, 0x78, 0x91, 0x1f, 0x07, 0xe9, 0xfe, 0x8f, 0x7a, 0xb1, 0x13, 0x7f, 0x6d, 0xb3, 0x96,
-	0xcd, 0x6f, 0x6c, 0xb3, 0xc6, 0x36, 0xfe, 0x40, 0x30, 0xbc, 0x72, 0x25, 0x71, 0x08, 0x9e, 0xe4,
-	0x2e, 0xaa, 0x9f, 0x7a, 0x92, 0x37, 0xe1, 0x5e, 0x2b, 0xfc, 0x00, 0x06, 0xfa, 0x45, 0x09, 0x43,
-	0x7c, 0x27, 0x6e, 0x01, 0xdf, 0x00, 0x28, 0x3b, 0x37, 0xae, 0xb9, 0x25, 0xa3, 0xc8, 0x8f, 0xf7,
-	0x4e, 0x92, 0xe4, 0xff, 0x6b, 0x93, 0xee, 0xa9, 0x69, 0xa0, 0xec, 0x96, 0x2d, 0x26, 0x30, 0xca,
-	0x8c, 0x60, 0x85, 0x36, 0x64, 0xe0, 0x62, 0x76, 0x88, 0x29, 0x80, 0x58, 0xe7, 0xd2, 0xb0, 0x42,
-	0x6a, 0x45, 0x86, 0xae, 0x6a, 0x4b, 0xb9, 0x3c, 0x7f, 0xaf, 0x28, 0xda, 0x54, 0x14, 0x7d, 0x55,
-	0x14, 0xbd, 0xd5, 0xb4, 0xb7, 0xa9, 0x69, 0xef, 0xb3, 0xa6, 0xbd, 0xc7, 0xc3, 0xee, 0x34, 0xeb,
-	0x3f, 0x53, 0x15, 0xaf, 0xb9, 0xb0, 0x8b, 0xa1, 0xdb, 0xe9, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xc9, 0x6c, 0xe1, 0x1e, 0xd6, 0x01, 0x00, 0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x51, 0x6f, 0xe3, 0x44,
+	0x10, 0x8e, 0x13, 0x27, 0xa9, 0xa7, 0x17, 0xd7, 0xb7, 0x17, 0xe8, 0x0a, 0x22, 0x13, 0x05, 0x21,
+	0x42, 0x41, 0x39, 0x5d, 0x41, 0xf7, 0x06, 0x92, 0x89, 0x4d, 0x89, 0x44, 0xd2, 0x68, 0xe3, 0x3b,
+	0x0e, 0x5e, 0xac, 0xbd, 0x78, 0x2f, 0xb1, 0x9a, 0xda, 0xd6, 0xda, 0xcd, 0x35, 0xbc, 0xf1, 0x0f,
+	0xf8, 0x25, 0xfc, 0x02, 0x7e, 0x00, 0x8f, 0x7d, 0xe4, 0x11, 0xb5, 0x7f, 0x83, 0x07, 0xe4, 0x5d,
+	0x27, 0xb1, 0x2b, 0x44, 0xef, 0x6d, 0xbe, 0x6f, 0x66, 0x76, 0x66, 0xbe, 0xd9, 0x5d, 0xf8, 0xdc,
+	0x0f, 0x93, 0xd7, 0xab, 0x68, 0x7e, 0x31, 0x5f, 0xd2, 0x20, 0x7c, 0x5a, 0x46, 0xeb, 0x67, 0x4f,
+	0xfd, 0xe8, 0x92, 0x06, 0xe1, 0x20, 0xe6, 0x51, 0x1a, 0x21, 0xb3, 0xe4, 0x1e, 0x94, 0xd1, 0xfa,
+	0x59, 0x8f, 0x83, 0x3e, 0x99, 0x11, 0x36, 0x8f, 0xb8, 0xff, 0x63, 0x90, 0x2e, 0x47, 0x53, 0x84,
+	0x40, 0x0d, 0xe9, 0x25, 0xc3, 0x4a, 0x57, 0xe9, 0x6b, 0x44, 0xd8, 0xe8, 0x13, 0xd0, 0x83, 0x78,
+	0xfd, 0x95, 0x47, 0x7d, 0x9f, 0xb3, 0x24, 0x61, 0x09, 0xae, 0x76, 0x6b, 0x7d, 0x8d, 0xb4, 0x32,
+	0xd6, 0xda, 0x92, 0x79, 0xd8, 0xf3, 0x42, 0x58, 0x6d, 0x17, 0xf6, 0x7c, 0x17, 0xd6, 0xfb, 0x47,
+	0x01, 0x9d, 0xb0, 0x24, 0xba, 0xe2, 0x73, 0x26, 0x4b, 0xff, 0x67, 0xd1, 0x6f, 0x40, 0x4d, 0x37,
+	0x31, 0xc3, 0xd5, 0xae, 0xd2, 0xd7, 0x4f, 0x4f, 0x06, 0xff, 0x3f, 0xc9, 0x40, 0x9e, 0xe4, 0x6e,
+	0x62, 0x46, 0x44, 0x1e, 0x32, 0xa0, 0x96, 0xa6, 0x2b, 0x5c, 0xeb, 0x2a, 0xfd, 0x16, 0xc9, 0x4c,
+	0xd4, 0x86, 0xfa, 0x9a, 0xae, 0xae, 0x18, 0x56, 0x45, 0x19, 0x09, 0xd0, 0x07, 0x70, 0x10, 0xf3,
+	0x20, 0xe2, 0x41, 0xba, 0xc1, 0x75, 0x11, 0xbc, 0xc3, 0xe8, 0x7d, 0x68, 0xbc, 0x65, 0xc1, 0x62,
+	0x99, 0xe2, 0x86, 0xf0, 0xe4, 0x28, 0xeb, 0x37, 0x8e, 0x78, 0x8a, 0x9b, 0x82, 0x15, 0x76, 0x76,
+	0xfa, 0x9b, 0x15, 0x5d, 0x24, 0xf8, 0x40, 0x90, 0x12, 0x88, 0x2e, 0xe8, 0x02, 0x6b, 0xa2, 0x62,
+	0x66, 0xf6, 0x7e, 0x81, 0x03, 0x3b, 0x97, 0x1c, 0x1d, 0x43, 0xf3, 0x82, 0x6d, 0xbc, 0x2c, 0x42,
+	0x91, 0x05, 0x2e, 0xd8, 0xc6, 0xa5, 0x0b, 0xd4, 0x01, 0x8d, 0xae, 0x16, 0x59, 0x13, 0xcb, 0x4b,
+	0xa1, 0x40, 0x8b, 0xec, 0x09, 0xf4, 0x11, 0x1c, 0xfa, 0xc1, 0x82, 0x25, 0xa9, 0x27, 0x14, 0x92,
+	0x23, 0x82, 0xa4, 0x32, 0x05, 0xb2, 0xbe, 0x25, 0xca, 0x47, 0xcd, 0x51, 0xef, 0x77, 0x15, 0x1a,
+	0xb6, 0xb8, 0x1f, 0x48, 0x87, 0x6a, 0xe0, 0x8b, 0xaa, 0x2a, 0xa9, 0x06, 0xfb, 0x15, 0x54, 0x0b,
+	0x2b, 0x68, 0x43, 0x3d, 0x7a, 0x1b, 0x32, 0x2e, 0x2a, 0x68, 0x44, 0x02, 0x34, 0x06, 0x08, 0x13,
+	0x8f, 0x8b, 0x09, 0x12, 0xdc, 0xec, 0xd6, 0xfa, 0x87, 0xa7, 0x83, 0x87, 0xd6, 0x53, 0xbe, 0x65,
+	0x44, 0x0b, 0x13, 0x89, 0x13, 0x84, 0xa1, 0x39, 0xe7, 0x8c, 0xa6, 0x11, 0x17, 0xf2, 0x6b, 0x64,
+	0x0b, 0x91, 0x09, 0xc0, 0xae, 0xe3, 0x80, 0xd3, 0x34, 0x88, 0x42, 0xb1, 0x01, 0x95, 0x14, 0x18,
+	0x64, 0x43, 0x23, 0x49, 0x69, 0x7a, 0x25, 0x25, 0xd7, 0x4f, 0xbf, 0x78, 0xa8, 0x09, 0x39, 0xfa,
+	0x4c, 0xe4, 0x90, 0x3c, 0x17, 0x7d, 0x0a, 0x47, 0xd2, 0xf2, 0x7c, 0x46, 0xfd, 0x55, 0x10, 0x32,
+	0xb1, 0x2d, 0x95, 0xe8, 0x92, 0xb6, 0x73, 0x36, 0x13, 0x35, 0xa6, 0x9c, 0x85, 0x29, 0x06, 0x29,
+	0xaa, 0x44, 0xe8, 0x7b, 0x68, 0x6e, 0xc5, 0x38, 0x7c, 0x37, 0x31, 0xca, 0xb7, 0x9f, 0x6c, 0xd3,
+	0xd1, 0x19, 0x80, 0xbf, 0x57, 0xf6, 0x91, 0x38, 0xac, 0xff, 0xe0, 0x50, 0xb9, 0xb2, 0x44, 0xf3,
+	0x77, 0x9a, 0x7e, 0x06, 0x46, 0xc4, 0x83, 0x45, 0x10, 0xd2, 0x95, 0xb7, 0x15, 0xb7, 0x25, 0x9a,
+	0x3e, 0xda, 0xf2, 0xc3, 0x5c, 0xe4, 0x0e, 0x68, 0x29, 0xa7, 0x61, 0xf2, 0x86, 0xf1, 0x04, 0xeb,
+	0x62, 0xf0, 0x3d, 0x71, 0xf2, 0x87, 0x02, 0xb0, 0x7f, 0x59, 0xe8, 0x43, 0x38, 0x26, 0xce, 0xf0,
+	0x9c, 0xd8, 0x9e, 0xfb, 0xd3, 0xd4, 0xf1, 0x5e, 0x4c, 0x66, 0x53, 0x67, 0x38, 0xfa, 0x6e, 0xe4,
+	0xd8, 0x46, 0x05, 0x3d, 0x86, 0x56, 0xd1, 0x69, 0x19, 0x0a, 0x6a, 0x83, 0x51, 0xa2, 0x2c, 0xcb,
+	0x32, 0xaa, 0xe8, 0x09, 0x1c, 0x15, 0x59, 0xf7, 0x95, 0x6b, 0xd4, 0x10, 0x02, 0xbd, 0x48, 0x8e,
+	0x5f, 0x19, 0x2a, 0x7a, 0x0f, 0x1e, 0x17, 0xb9, 0xe1, 0xc4, 0x1a, 0x3b, 0x46, 0xfd, 0x7e, 0xfe,
+	0x8c, 0xbc, 0x34, 0x1a, 0xf7, 0xc9, 0xa1, 0x65, 0x19, 0xcd, 0x93, 0x5f, 0x15, 0x78, 0x54, 0x5c,
+	0x3a, 0xc2, 0xd0, 0xb6, 0xcf, 0xc7, 0xd6, 0x68, 0xe2, 0xcd, 0x5c, 0xcb, 0x7d, 0x31, 0xf3, 0xac,
+	0xa1, 0x3b, 0x7a, 0xe9, 0x18, 0x15, 0x74, 0x0c, 0x4f, 0xca, 0x9e, 0x33, 0x62, 0x0d, 0x1d, 0x43,
+	0x41, 0x1d, 0xc0, 0x65, 0x07, 0x71, 0x6c, 0x67, 0x3c, 0x75, 0x47, 0xe7, 0x13, 0xa3, 0x8a, 0xba,
+	0xd0, 0x29, 0x7b, 0xa7, 0xce, 0xc4, 0x1e, 0x4d, 0xce, 0x3c, 0xdb, 0xf9, 0xc1, 0x71, 0x1d, 0xa3,
+	0xf6, 0xed, 0xd7, 0x7f, 0xde, 0x9a, 0xca, 0xcd, 0xad, 0xa9, 0xfc, 0x7d, 0x6b, 0x2a, 0xbf, 0xdd,
+	0x99, 0x95, 0x9b, 0x3b, 0xb3, 0xf2, 0xd7, 0x9d, 0x59, 0xf9, 0xf9, 0xe3, 0xf2, 0xdf, 0x7d, 0x7d,
+	0xef, 0x2f, 0xcf, 0xde, 0x76, 0xf2, 0xba, 0x21, 0x3e, 0xf2, 0x2f, 0xff, 0x0d, 0x00, 0x00, 0xff,
+	0xff, 0x15, 0x68, 0xfc, 0x58, 0xf7, 0x05, 0x00, 0x00,
 }
 
 func (m *NSRecordWithIP) Marshal() (dAtA []byte, err error) {
@@ -249,7 +625,7 @@ func (m *NSRecordWithIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Domain) Marshal() (dAtA []byte, err error) {
+func (m *ResourceRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -259,100 +635,337 @@ func (m *Domain) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Domain) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Domain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResourceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NsRecords) > 0 {
-		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDomain(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Expiration != 0 {
-		i = encodeVarintDomain(dAtA, i, uint64(m.Expiration))
+	if m.Flags != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Flags))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Port != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Weight != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Weight))
 		i--
 		dAtA[i] = 0x30
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintDomain(dAtA, i, uint64(len(m.Creator)))
+	if m.Priority != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Priority))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintDomain(dAtA, i, uint64(len(m.Owner)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
+	}
+	if m.Ttl != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
 		i = encodeVarintDomain(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintDomain(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDomain(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDomain(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DSRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *NSRecordWithIP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DSRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DSRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDomain(uint64(l))
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Ipv4Addresses) > 0 {
-		for _, s := range m.Ipv4Addresses {
-			l = len(s)
-			n += 1 + l + sovDomain(uint64(l))
-		}
+	if m.DigestType != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.DigestType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Ipv6Addresses) > 0 {
-		for _, s := range m.Ipv6Addresses {
-			l = len(s)
-			n += 1 + l + sovDomain(uint64(l))
-		}
+	if m.Algorithm != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
+	if m.KeyTag != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.KeyTag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Domain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Domain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Domain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transfers != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Transfers))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.OriginalCreator) > 0 {
+		i -= len(m.OriginalCreator)
+		copy(dAtA[i:], m.OriginalCreator)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.OriginalCreator)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DsRecords) > 0 {
+		for iNdEx := len(m.DsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDomain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDomain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Parent) > 0 {
+		i -= len(m.Parent)
+		copy(dAtA[i:], m.Parent)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Parent)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StatusDeadline != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.StatusDeadline))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Status != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NsRecords) > 0 {
+		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDomain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDomain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDomain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NSRecordWithIP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if len(m.Ipv4Addresses) > 0 {
+		for _, s := range m.Ipv4Addresses {
+			l = len(s)
+			n += 1 + l + sovDomain(uint64(l))
+		}
+	}
+	if len(m.Ipv6Addresses) > 0 {
+		for _, s := range m.Ipv6Addresses {
+			l = len(s)
+			n += 1 + l + sovDomain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResourceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovDomain(uint64(m.Type))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovDomain(uint64(m.Ttl))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovDomain(uint64(m.Priority))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDomain(uint64(m.Weight))
+	}
+	if m.Port != 0 {
+		n += 1 + sovDomain(uint64(m.Port))
+	}
+	if m.Flags != 0 {
+		n += 1 + sovDomain(uint64(m.Flags))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	return n
+}
+
+func (m *DSRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyTag != 0 {
+		n += 1 + sovDomain(uint64(m.KeyTag))
+	}
+	if m.Algorithm != 0 {
+		n += 1 + sovDomain(uint64(m.Algorithm))
+	}
+	if m.DigestType != 0 {
+		n += 1 + sovDomain(uint64(m.DigestType))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	return n
+}
+
 func (m *Domain) Size() (n int) {
 	if m == nil {
 		return 0
@@ -383,16 +996,451 @@ func (m *Domain) Size() (n int) {
 			n += 1 + l + sovDomain(uint64(l))
 		}
 	}
-	return n
-}
+	if m.Status != 0 {
+		n += 1 + sovDomain(uint64(m.Status))
+	}
+	if m.StatusDeadline != 0 {
+		n += 1 + sovDomain(uint64(m.StatusDeadline))
+	}
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovDomain(uint64(l))
+		}
+	}
+	if len(m.DsRecords) > 0 {
+		for _, e := range m.DsRecords {
+			l = e.Size()
+			n += 1 + l + sovDomain(uint64(l))
+		}
+	}
+	l = len(m.OriginalCreator)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if m.Transfers != 0 {
+		n += 1 + sovDomain(uint64(m.Transfers))
+	}
+	return n
+}
+
+func sovDomain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDomain(x uint64) (n int) {
+	return sovDomain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NSRecordWithIP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NSRecordWithIP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NSRecordWithIP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ipv4Addresses = append(m.Ipv4Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv6Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ipv6Addresses = append(m.Ipv6Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RecordType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			m.Flags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flags |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovDomain(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDomain(x uint64) (n int) {
-	return sovDomain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *NSRecordWithIP) Unmarshal(dAtA []byte) error {
+func (m *DSRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -415,17 +1463,17 @@ func (m *NSRecordWithIP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NSRecordWithIP: wiretype end group for non-group")
+			return fmt.Errorf("proto: DSRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NSRecordWithIP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DSRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyTag", wireType)
 			}
-			var stringLen uint64
+			m.KeyTag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDomain
@@ -435,29 +1483,16 @@ func (m *NSRecordWithIP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.KeyTag |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDomain
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDomain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Addresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 			}
-			var stringLen uint64
+			m.Algorithm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDomain
@@ -467,27 +1502,33 @@ func (m *NSRecordWithIP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Algorithm |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDomain
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDomain
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DigestType", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DigestType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DigestType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Ipv4Addresses = append(m.Ipv4Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv6Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -515,7 +1556,7 @@ func (m *NSRecordWithIP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipv6Addresses = append(m.Ipv6Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -735,6 +1776,195 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DomainStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDeadline", wireType)
+			}
+			m.StatusDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &ResourceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DsRecords = append(m.DsRecords, &DSRecord{})
+			if err := m.DsRecords[len(m.DsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalCreator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalCreator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			m.Transfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDomain(dAtA[iNdEx:])
@@ -8,10 +8,33 @@ import (
 
 // x/dnsblockchain module sentinel errors
 var (
-	ErrInvalidSigner       = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrDuplicateDomainName = errors.Register(ModuleName, 1101, "domain name already exists")
-	ErrInvalidTLD          = errors.Register(ModuleName, 1102, "invalid TLD")
-	ErrTLDReservedByICANN  = errors.Register(ModuleName, 1103, "TLD is reserved by ICANN and cannot be registered")
-	ErrTLDNotPermitted     = errors.Register(ModuleName, 1104, "the TLD of the domain is not permitted for registration")
-	ErrInvalidDomainName   = errors.Register(ModuleName, 1105, "invalid domain name format")
+	ErrInvalidSigner            = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrDuplicateDomainName      = errors.Register(ModuleName, 1101, "domain name already exists")
+	ErrInvalidTLD               = errors.Register(ModuleName, 1102, "invalid TLD")
+	ErrTLDReservedByICANN       = errors.Register(ModuleName, 1103, "TLD is reserved by ICANN and cannot be registered")
+	ErrTLDNotPermitted          = errors.Register(ModuleName, 1104, "the TLD of the domain is not permitted for registration")
+	ErrInvalidDomainName        = errors.Register(ModuleName, 1105, "invalid domain name format")
+	ErrInvalidDomainStatus      = errors.Register(ModuleName, 1106, "operation not allowed in the domain's lifecycle status")
+	ErrInvalidRegistrationYears = errors.Register(ModuleName, 1107, "invalid registration period")
+	ErrInvalidParentDomain      = errors.Register(ModuleName, 1108, "parent domain is not registered and active")
+	ErrInvalidResourceRecord    = errors.Register(ModuleName, 1109, "invalid resource record")
+	ErrTooManyRecords           = errors.Register(ModuleName, 1110, "too many resource records")
+	ErrInvalidDSRecord          = errors.Register(ModuleName, 1111, "invalid DS record")
+	ErrCommitmentRequired       = errors.Register(ModuleName, 1112, "names must be registered through a commitment")
+	ErrCommitmentNotFound       = errors.Register(ModuleName, 1113, "commitment not found")
+	ErrCommitmentExists         = errors.Register(ModuleName, 1114, "commitment already exists")
+	ErrCommitmentNotReady       = errors.Register(ModuleName, 1115, "commitment is too recent to reveal")
+	ErrCommitmentExpired        = errors.Register(ModuleName, 1116, "commitment has expired")
+	ErrNameInAuction            = errors.Register(ModuleName, 1117, "name can only be won at auction")
+	ErrAuctionNotFound          = errors.Register(ModuleName, 1118, "auction not found")
+	ErrAuctionNotAllowed        = errors.Register(ModuleName, 1119, "name cannot be auctioned")
+	ErrInvalidBid               = errors.Register(ModuleName, 1120, "invalid bid")
+	ErrAuctionPhase             = errors.Register(ModuleName, 1121, "operation not allowed in the auction's current phase")
+	ErrTransferOfferNotFound    = errors.Register(ModuleName, 1122, "transfer offer not found")
+	ErrTransferOfferExpired     = errors.Register(ModuleName, 1123, "transfer offer has expired")
+	ErrDirectTransferDisabled   = errors.Register(ModuleName, 1124, "direct transfer not requested")
+	ErrListingNotFound          = errors.Register(ModuleName, 1125, "listing not found")
+	ErrMarketOfferNotFound      = errors.Register(ModuleName, 1126, "market offer not found")
+	ErrInvalidPrice             = errors.Register(ModuleName, 1127, "invalid price")
+	ErrMarketOfferLapsed        = errors.Register(ModuleName, 1128, "market offer has lapsed")
 )
@@ -2,13 +2,35 @@ package types
 
 // Events for the dnsblockchain module
 const (
-	EventTypeCreateDomain       = "create_domain"
-	EventTypeUpdateDomain       = "update_domain"
-	EventTypeDeleteDomain       = "delete_domain"
-	EventTypeTransferDomain     = "transfer_domain"
-	EventTypeHeartbeatDomain    = "heartbeat_domain"
-	EventTypeDomainFeeCollected = "domain_fee_collected" // Para la tarifa de creación
-	EventTypeDomainFeeBurned    = "domain_fee_burned"    // Para la quema de la tarifa
+	EventTypeCreateDomain             = "create_domain"
+	EventTypeUpdateDomain             = "update_domain"
+	EventTypeDeleteDomain             = "delete_domain"
+	EventTypeTransferDomain           = "transfer_domain"
+	EventTypeHeartbeatDomain          = "heartbeat_domain"
+	EventTypeRenewDomain              = "renew_domain"
+	EventTypeSetDomainRecords         = "set_domain_records"
+	EventTypeSetDomainDSRecords       = "set_domain_ds_records"
+	EventTypeExpireDomain             = "expire_domain"
+	EventTypeRemoveSubdomain          = "remove_subdomain"
+	EventTypeDomainStatus             = "domain_status_changed"
+	EventTypeDomainFeeCollected       = "domain_fee_collected" // Para la tarifa de creación
+	EventTypeDomainFeeBurned          = "domain_fee_burned"    // Para la quema de la tarifa
+	EventTypeDomainFeeToFeeCollector  = "domain_fee_to_fee_collector"
+	EventTypeDomainFeeToCommunityPool = "domain_fee_to_community_pool"
+	EventTypeCommitDomain             = "commit_domain"
+	EventTypeRevealDomain             = "reveal_domain"
+	EventTypePruneCommitment          = "prune_commitment"
+	EventTypeOpenAuction              = "open_auction"
+	EventTypePlaceBid                 = "place_bid"
+	EventTypeRevealBid                = "reveal_bid"
+	EventTypeSettleAuction            = "settle_auction"
+	EventTypeOfferDomainTransfer      = "offer_domain_transfer"
+	EventTypeCancelDomainTransfer     = "cancel_domain_transfer"
+	EventTypeListDomain               = "list_domain"
+	EventTypeUnlistDomain             = "unlist_domain"
+	EventTypeSellDomain               = "sell_domain"
+	EventTypePlaceMarketOffer         = "place_market_offer"
+	EventTypeWithdrawMarketOffer      = "withdraw_market_offer"
 
 	AttributeKeyDomainID      = "domain_id"
 	AttributeKeyDomainName    = "domain_name"
@@ -19,7 +41,40 @@ const (
 	AttributeKeyNewOwner      = "new_owner"
 	AttributeKeyOldOwner      = "old_owner"
 	AttributeKeyNewExpiration = "new_expiration"
+	AttributeKeyExpiration    = "expiration"
+	AttributeKeyStatus        = "status"
+	AttributeKeyYears         = "years"
+	AttributeKeyRecordCount   = "record_count"
+	AttributeKeyParent        = "parent"
+	AttributeKeyStatusEnd     = "status_deadline"
 	AttributeKeyFeeCollector  = "fee_collector" // Quién pagó la tarifa
 	AttributeKeyBurnerModule  = "burner_module" // Módulo que quemó la tarifa
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyFeeType       = "fee_type" // Mensaje que originó la tarifa
+	AttributeKeyCommitment    = "commitment"
+	AttributeKeyDeposit       = "deposit"
+	AttributeKeyRefund        = "refund"
+	AttributeKeyBidder        = "bidder"
+	AttributeKeyWinner        = "winner"
+	AttributeKeyPrice         = "price"
+	AttributeKeyBidEnd        = "bid_end"
+	AttributeKeyRevealEnd     = "reveal_end"
+	AttributeKeySeller        = "seller"
+	AttributeKeyBuyer         = "buyer"
+	AttributeKeyRoyalty       = "royalty"
+	AttributeKeyReason        = "reason"
 	// sdk.AttributeKeyAmount se puede usar para el monto de la tarifa
 )
+
+// Fee types reported in the fee_type attribute of the domain fee events.
+const (
+	FeeTypeRegistration   = "registration"
+	FeeTypeRenewal        = "renewal"
+	FeeTypeRestore        = "restore"
+	FeeTypeTransfer       = "transfer"
+	FeeTypeUpdate         = "update"
+	FeeTypeForfeit        = "commitment_forfeit"
+	FeeTypeAuction        = "auction"
+	FeeTypeBidForfeit     = "bid_forfeit"
+	FeeTypeAuctionForfeit = "auction_forfeit"
+)
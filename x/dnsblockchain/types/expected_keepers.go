@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -24,10 +25,28 @@ type BankKeeper interface {
 	// ---> AÑADIR ESTOS MÉTODOS QUE FALTABAN PARA dnsblockchain <---
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	// GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	// GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
+// DistributionKeeper defines the expected interface for the Distribution module.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// NFTKeeper defines the expected interface for the NFT module, which holds the token of each domain.
+type NFTKeeper interface {
+	SaveClass(ctx context.Context, class nft.Class) error
+	HasClass(ctx context.Context, classID string) bool
+	Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classID, nftID string) error
+	Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error
+	GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+	HasNFT(ctx context.Context, classID, nftID string) bool
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeSplitTotalBps is the sum every non-empty FeeSplit must add up to.
+const FeeSplitTotalBps = 10000
+
+// DefaultFeeSplit sends every domain fee to the fee collector, where x/distribution pays it out to
+// validators and their delegators.
+func DefaultFeeSplit() FeeSplit {
+	return FeeSplit{FeeCollectorBps: FeeSplitTotalBps}
+}
+
+// Split divides a fee into its burned, fee collector and community pool portions. Rounding dust
+// goes to the destination with the largest share so the portions always add up to the fee. An
+// all-zero split burns the whole fee.
+func (s FeeSplit) Split(fee sdk.Coins) (burn, feeCollector, communityPool sdk.Coins) {
+	if s.BurnBps+s.FeeCollectorBps+s.CommunityPoolBps == 0 {
+		return fee, sdk.NewCoins(), sdk.NewCoins()
+	}
+
+	shares := []uint32{s.BurnBps, s.FeeCollectorBps, s.CommunityPoolBps}
+	largest := 0
+	for i, bps := range shares {
+		if bps > shares[largest] {
+			largest = i
+		}
+	}
+
+	portions := make([]sdk.Coins, len(shares))
+	for i, bps := range shares {
+		if i == largest {
+			continue
+		}
+		for _, coin := range fee {
+			amount := coin.Amount.Mul(math.NewInt(int64(bps))).Quo(math.NewInt(FeeSplitTotalBps))
+			portions[i] = portions[i].Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	rest := fee
+	for i, portion := range portions {
+		if i != largest {
+			rest = rest.Sub(portion...)
+		}
+	}
+	portions[largest] = rest
+
+	return portions[0], portions[1], portions[2]
+}
+
+// Validate checks that the split is either empty or adds up to FeeSplitTotalBps.
+func (s FeeSplit) Validate() error {
+	total := uint64(s.BurnBps) + uint64(s.FeeCollectorBps) + uint64(s.CommunityPoolBps)
+	if total != 0 && total != FeeSplitTotalBps {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "fee split must add up to %d basis points, got %d", FeeSplitTotalBps, total)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestFeeSplit(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("udns", 1001), sdk.NewInt64Coin("uatom", 10))
+
+	tests := []struct {
+		desc                              string
+		split                             types.FeeSplit
+		burn, feeCollector, communityPool sdk.Coins
+	}{
+		{
+			desc: "empty split burns",
+			burn: fee,
+		},
+		{
+			desc:         "all to fee collector",
+			split:        types.DefaultFeeSplit(),
+			feeCollector: fee,
+		},
+		{
+			desc:          "dust goes to the largest share",
+			split:         types.FeeSplit{BurnBps: 3333, FeeCollectorBps: 3334, CommunityPoolBps: 3333},
+			burn:          sdk.NewCoins(sdk.NewInt64Coin("udns", 333), sdk.NewInt64Coin("uatom", 3)),
+			feeCollector:  sdk.NewCoins(sdk.NewInt64Coin("udns", 335), sdk.NewInt64Coin("uatom", 4)),
+			communityPool: sdk.NewCoins(sdk.NewInt64Coin("udns", 333), sdk.NewInt64Coin("uatom", 3)),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			burn, feeCollector, communityPool := tc.split.Split(fee)
+			require.True(t, tc.burn.Equal(burn), "burn %s", burn)
+			require.True(t, tc.feeCollector.Equal(feeCollector), "fee collector %s", feeCollector)
+			require.True(t, tc.communityPool.Equal(communityPool), "community pool %s", communityPool)
+			require.Equal(t, fee, burn.Add(feeCollector...).Add(communityPool...))
+		})
+	}
+
+	require.NoError(t, types.FeeSplit{}.Validate())
+	require.NoError(t, types.DefaultFeeSplit().Validate())
+	require.Error(t, types.FeeSplit{BurnBps: 5000}.Validate())
+}
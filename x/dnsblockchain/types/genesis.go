@@ -1,6 +1,9 @@
 package types
 
-import "fmt"
+import (
+	"crypto/sha256"
+	"fmt"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
@@ -24,6 +27,12 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("domain id should be lower or equal than the last id")
 		}
 		domainIdMap[elem.Id] = true
+		if err := ValidateResourceRecords(elem.Records); err != nil {
+			return fmt.Errorf("invalid records for domain %s: %w", elem.Name, err)
+		}
+		if err := ValidateDSRecords(elem.DsRecords); err != nil {
+			return fmt.Errorf("invalid DS records for domain %s: %w", elem.Name, err)
+		}
 	}
 
 	permittedTLDsMap := make(map[string]bool)
@@ -34,5 +43,120 @@ func (gs GenesisState) Validate() error {
 		permittedTLDsMap[tld] = true
 	}
 
+	tldStatsMap := make(map[string]bool)
+	for _, stats := range gs.TldStats {
+		if stats.Tld == "" {
+			return fmt.Errorf("TLD stats entry has an empty TLD")
+		}
+		if tldStatsMap[stats.Tld] {
+			return fmt.Errorf("duplicated TLD stats: %s", stats.Tld)
+		}
+		tldStatsMap[stats.Tld] = true
+		if err := stats.TotalFees.Validate(); err != nil {
+			return fmt.Errorf("invalid total fees for TLD %s: %w", stats.Tld, err)
+		}
+	}
+
+	commitmentMap := make(map[string]bool)
+	for _, commitment := range gs.Commitments {
+		if len(commitment.Hash) != sha256.Size {
+			return fmt.Errorf("commitment hash must be %d bytes", sha256.Size)
+		}
+		key := commitment.Creator + "/" + string(commitment.Hash)
+		if commitmentMap[key] {
+			return fmt.Errorf("duplicated commitment %X by %s", commitment.Hash, commitment.Creator)
+		}
+		commitmentMap[key] = true
+		if commitment.Creator == "" {
+			return fmt.Errorf("commitment %X has an empty creator", commitment.Hash)
+		}
+		if err := commitment.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid deposit for commitment %X: %w", commitment.Hash, err)
+		}
+	}
+
+	auctionMap := make(map[string]bool)
+	for _, auction := range gs.Auctions {
+		if auction.Name == "" {
+			return fmt.Errorf("auction has an empty name")
+		}
+		if auctionMap[auction.Name] {
+			return fmt.Errorf("duplicated auction for %s", auction.Name)
+		}
+		auctionMap[auction.Name] = true
+		if auction.BidEnd > auction.RevealEnd {
+			return fmt.Errorf("auction for %s closes bidding after its reveal period", auction.Name)
+		}
+		if err := auction.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid deposit of auction for %s: %w", auction.Name, err)
+		}
+	}
+	bidMap := make(map[string]bool)
+	for _, bid := range gs.AuctionBids {
+		if !auctionMap[bid.Name] {
+			return fmt.Errorf("bid by %s for %s has no auction", bid.Bidder, bid.Name)
+		}
+		key := bid.Name + "/" + bid.Bidder
+		if bidMap[key] {
+			return fmt.Errorf("duplicated bid by %s for %s", bid.Bidder, bid.Name)
+		}
+		bidMap[key] = true
+		if err := bid.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid deposit of bid by %s for %s: %w", bid.Bidder, bid.Name, err)
+		}
+	}
+	launchMap := make(map[string]bool)
+	for _, launch := range gs.TldLaunches {
+		if launchMap[launch.Tld] {
+			return fmt.Errorf("duplicated launch of TLD %s", launch.Tld)
+		}
+		launchMap[launch.Tld] = true
+	}
+	releaseMap := make(map[string]bool)
+	for _, release := range gs.Releases {
+		if releaseMap[release.Name] {
+			return fmt.Errorf("duplicated release of %s", release.Name)
+		}
+		releaseMap[release.Name] = true
+		if release.End < release.Start {
+			return fmt.Errorf("release of %s ends before it starts", release.Name)
+		}
+	}
+	offerMap := make(map[uint64]bool)
+	for _, offer := range gs.TransferOffers {
+		if offerMap[offer.DomainId] {
+			return fmt.Errorf("duplicated transfer offer for domain %d", offer.DomainId)
+		}
+		offerMap[offer.DomainId] = true
+		if !domainIdMap[offer.DomainId] {
+			return fmt.Errorf("transfer offer for unknown domain %d", offer.DomainId)
+		}
+	}
+	listingMap := make(map[uint64]bool)
+	for _, listing := range gs.Listings {
+		if listingMap[listing.DomainId] {
+			return fmt.Errorf("duplicated listing for domain %d", listing.DomainId)
+		}
+		listingMap[listing.DomainId] = true
+		if !domainIdMap[listing.DomainId] {
+			return fmt.Errorf("listing for unknown domain %d", listing.DomainId)
+		}
+		if err := listing.Price.Validate(); err != nil || !listing.Price.IsPositive() {
+			return fmt.Errorf("invalid price %s for listing of domain %d", listing.Price, listing.DomainId)
+		}
+	}
+	marketOfferMap := make(map[string]bool)
+	for _, offer := range gs.MarketOffers {
+		key := fmt.Sprintf("%d/%s", offer.DomainId, offer.Buyer)
+		if marketOfferMap[key] {
+			return fmt.Errorf("duplicated market offer of %s for domain %d", offer.Buyer, offer.DomainId)
+		}
+		marketOfferMap[key] = true
+		// Offers for removed domains lapse but stay until their buyers withdraw them.
+		if err := offer.Amount.Validate(); err != nil || !offer.Amount.IsPositive() {
+			return fmt.Errorf("invalid amount %s for market offer on domain %d", offer.Amount, offer.DomainId)
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -31,6 +31,22 @@ type GenesisState struct {
 	DomainList    []Domain `protobuf:"bytes,2,rep,name=domain_list,json=domainList,proto3" json:"domain_list"`
 	DomainCount   uint64   `protobuf:"varint,3,opt,name=domain_count,json=domainCount,proto3" json:"domain_count,omitempty"`
 	PermittedTlds []string `protobuf:"bytes,4,rep,name=permitted_tlds,json=permittedTlds,proto3" json:"permitted_tlds,omitempty"`
+	// Per-TLD statistics. Domain counts are rebuilt from domain_list; total fees are carried over.
+	TldStats []TLDStats `protobuf:"bytes,5,rep,name=tld_stats,json=tldStats,proto3" json:"tld_stats"`
+	// Pending registration commitments, with the deposits the module holds for them.
+	Commitments []Commitment `protobuf:"bytes,6,rep,name=commitments,proto3" json:"commitments"`
+	// Open auctions and their bids, with the deposits the module holds for them.
+	Auctions    []Auction    `protobuf:"bytes,7,rep,name=auctions,proto3" json:"auctions"`
+	AuctionBids []AuctionBid `protobuf:"bytes,8,rep,name=auction_bids,json=auctionBids,proto3" json:"auction_bids"`
+	// Launch phases of recently permitted TLDs.
+	TldLaunches []TLDLaunch `protobuf:"bytes,9,rep,name=tld_launches,json=tldLaunches,proto3" json:"tld_launches"`
+	// Lapsed names still in their descending-price release window.
+	Releases []NameRelease `protobuf:"bytes,10,rep,name=releases,proto3" json:"releases"`
+	// Pending two-step transfer offers.
+	TransferOffers []TransferOffer `protobuf:"bytes,11,rep,name=transfer_offers,json=transferOffers,proto3" json:"transfer_offers"`
+	// Marketplace listings, and the offers with the amounts the module holds for them.
+	Listings     []Listing     `protobuf:"bytes,12,rep,name=listings,proto3" json:"listings"`
+	MarketOffers []MarketOffer `protobuf:"bytes,13,rep,name=market_offers,json=marketOffers,proto3" json:"market_offers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,6 +110,69 @@ func (m *GenesisState) GetPermittedTlds() []string {
 	return nil
 }
 
+func (m *GenesisState) GetTldStats() []TLDStats {
+	if m != nil {
+		return m.TldStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommitments() []Commitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctions() []Auction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionBids() []AuctionBid {
+	if m != nil {
+		return m.AuctionBids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTldLaunches() []TLDLaunch {
+	if m != nil {
+		return m.TldLaunches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReleases() []NameRelease {
+	if m != nil {
+		return m.Releases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferOffers() []TransferOffer {
+	if m != nil {
+		return m.TransferOffers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetListings() []Listing {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketOffers() []MarketOffer {
+	if m != nil {
+		return m.MarketOffers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dnsblockchain.dnsblockchain.v1.GenesisState")
 }
@@ -103,26 +182,42 @@ func init() {
 }
 
 var fileDescriptor_4fc25967873ef679 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0xc9, 0x2b, 0x4e,
-	0xca, 0xc9, 0x4f, 0xce, 0x4e, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x47, 0xe5, 0x95, 0x19, 0xea, 0xa7,
-	0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0xc9, 0xa1, 0xc8,
-	0xeb, 0xa1, 0xf2, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44,
-	0x8b, 0x94, 0x36, 0x01, 0x0b, 0x52, 0xf2, 0x73, 0x41, 0x9a, 0x89, 0x53, 0x5c, 0x90, 0x58, 0x94,
-	0x98, 0x0b, 0x75, 0x8c, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44,
-	0x95, 0x3e, 0x31, 0x72, 0xf1, 0xb8, 0x43, 0x1c, 0x1d, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc9,
-	0xc5, 0x06, 0xd1, 0x26, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa6, 0x87, 0xdf, 0x13, 0x7a,
-	0x01, 0x60, 0xd5, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08,
-	0x6a, 0x80, 0x90, 0x2f, 0x17, 0x37, 0xc4, 0xb9, 0xf1, 0x39, 0x99, 0xc5, 0x25, 0x12, 0x4c, 0x0a,
-	0xcc, 0xc4, 0x98, 0xe7, 0x02, 0xd6, 0xe2, 0xc4, 0x02, 0x32, 0x2f, 0x88, 0x0b, 0x62, 0x80, 0x4f,
-	0x66, 0x71, 0x89, 0x90, 0x22, 0x17, 0x0f, 0xd4, 0xb8, 0xe4, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x66,
-	0x05, 0x46, 0x0d, 0x96, 0x20, 0xa8, 0x15, 0xce, 0x20, 0x21, 0x21, 0x55, 0x2e, 0xbe, 0x82, 0xd4,
-	0xa2, 0xdc, 0xcc, 0x92, 0x92, 0xd4, 0x94, 0xf8, 0x92, 0x9c, 0x94, 0x62, 0x09, 0x16, 0x05, 0x66,
-	0x0d, 0xce, 0x20, 0x5e, 0xb8, 0x68, 0x48, 0x4e, 0x4a, 0xb1, 0x93, 0xed, 0x89, 0x47, 0x72, 0x8c,
-	0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72,
-	0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x29, 0xa3, 0x86, 0x61, 0x05, 0x5a, 0x98, 0x96, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0x83, 0xce, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xe2, 0x2b,
-	0x82, 0x0d, 0x02, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc6, 0x9b, 0x7f, 0xfb, 0xef, 0x5a, 0x37, 0x1d, 0xc2, 0xe2, 0x60, 0xf5, 0x10, 0x0a, 0x08,
+	0x28, 0x1b, 0x6b, 0x35, 0x38, 0x73, 0xa0, 0x9b, 0x84, 0x26, 0x5a, 0x40, 0xd9, 0xc4, 0x01, 0x21,
+	0x45, 0x6e, 0xec, 0x76, 0xd6, 0x92, 0xb8, 0xca, 0xeb, 0x4e, 0xf0, 0x2d, 0xf8, 0x18, 0x1c, 0xf9,
+	0x18, 0x3b, 0xee, 0x06, 0x27, 0x84, 0xda, 0x03, 0x5f, 0x03, 0xc5, 0xb1, 0xbb, 0x96, 0x03, 0xc9,
+	0xa5, 0xb2, 0xdf, 0x3e, 0xcf, 0xcf, 0x8f, 0x5f, 0x3b, 0x46, 0x4f, 0x59, 0x02, 0x93, 0x48, 0x86,
+	0x17, 0xe1, 0x39, 0x15, 0xc9, 0x60, 0x7b, 0x76, 0x79, 0x38, 0x98, 0xf1, 0x84, 0x83, 0x80, 0xfe,
+	0x3c, 0x95, 0x4a, 0x62, 0x6f, 0xeb, 0xff, 0xfe, 0xf6, 0xec, 0xf2, 0xb0, 0x73, 0x9b, 0xc6, 0x22,
+	0x91, 0x03, 0xfd, 0x9b, 0x5b, 0x3a, 0x45, 0x0b, 0xd0, 0x45, 0xa8, 0x84, 0x4c, 0x8c, 0x7a, 0x50,
+	0xa0, 0x0e, 0x65, 0x1c, 0x0b, 0x15, 0xf3, 0x44, 0x19, 0xc3, 0x7e, 0x81, 0x81, 0xc9, 0x38, 0xcb,
+	0x56, 0x4e, 0x1c, 0xd3, 0xf4, 0x82, 0x97, 0x25, 0xcf, 0x69, 0x4a, 0x63, 0xd3, 0x98, 0x4e, 0xaf,
+	0x40, 0xac, 0x22, 0x66, 0x94, 0x07, 0x45, 0xca, 0x94, 0x26, 0x30, 0xe5, 0xa9, 0x91, 0xdf, 0x99,
+	0xc9, 0x99, 0xd4, 0xc3, 0x41, 0x36, 0xca, 0xab, 0xf7, 0xbf, 0xef, 0x20, 0xf7, 0x55, 0x7e, 0x32,
+	0xa7, 0x8a, 0x2a, 0x8e, 0x4f, 0x50, 0x3d, 0xcf, 0x43, 0x9c, 0xae, 0xd3, 0x6b, 0x3d, 0x7b, 0xd4,
+	0xff, 0xf7, 0x49, 0xf5, 0xdf, 0x69, 0xf5, 0xb0, 0x79, 0xf5, 0xf3, 0x6e, 0xe5, 0xeb, 0xef, 0x6f,
+	0x7b, 0x8e, 0x6f, 0x00, 0x78, 0x8c, 0x5a, 0x79, 0xd3, 0x82, 0x48, 0x80, 0x22, 0xff, 0x75, 0xab,
+	0x65, 0x78, 0xc7, 0xda, 0x32, 0xac, 0x65, 0x3c, 0x1f, 0xe5, 0x80, 0x91, 0x00, 0x85, 0xef, 0x21,
+	0xd7, 0xe0, 0x42, 0xb9, 0x48, 0x14, 0xa9, 0x76, 0x9d, 0x5e, 0xcd, 0x37, 0x4b, 0x1c, 0x65, 0x25,
+	0xfc, 0x10, 0xed, 0xce, 0x79, 0x1a, 0x0b, 0xa5, 0x38, 0x0b, 0x54, 0xc4, 0x80, 0xd4, 0xba, 0xd5,
+	0x5e, 0xd3, 0x6f, 0xaf, 0xab, 0x67, 0x11, 0x03, 0xfc, 0x1a, 0x35, 0x55, 0xc4, 0x02, 0x50, 0x54,
+	0x01, 0xf9, 0x5f, 0xc7, 0xea, 0x15, 0xc5, 0x3a, 0x1b, 0x1d, 0x67, 0x0d, 0x02, 0x13, 0xac, 0xa1,
+	0x22, 0xa6, 0xe7, 0xd8, 0x47, 0xad, 0x9b, 0xbb, 0x04, 0xa4, 0xae, 0x71, 0x7b, 0x45, 0xb8, 0xa3,
+	0xb5, 0xc5, 0x00, 0x37, 0x21, 0xf8, 0x04, 0x35, 0xcc, 0x6d, 0x06, 0xb2, 0xa3, 0x81, 0x8f, 0x8b,
+	0x80, 0x2f, 0x73, 0xbd, 0x8d, 0x67, 0xed, 0xf8, 0x14, 0xb9, 0x66, 0x1c, 0x4c, 0x04, 0x03, 0xd2,
+	0x28, 0x97, 0xcf, 0xe2, 0x04, 0xb3, 0xf9, 0xe8, 0xba, 0x92, 0xed, 0xd9, 0xcd, 0x1a, 0x18, 0xd1,
+	0x45, 0x12, 0x9e, 0x73, 0x20, 0x4d, 0x0d, 0x7d, 0x52, 0xa2, 0x87, 0x23, 0x6d, 0xb1, 0x4c, 0x15,
+	0xb1, 0x91, 0x61, 0xe0, 0x31, 0x6a, 0xa4, 0x3c, 0xe2, 0x14, 0x38, 0x10, 0xa4, 0x79, 0xfb, 0x45,
+	0xbc, 0x37, 0x34, 0xe6, 0x7e, 0xee, 0xb1, 0xfb, 0xb6, 0x08, 0xfc, 0x11, 0xdd, 0xb2, 0x1f, 0x40,
+	0x20, 0xa7, 0x53, 0x9e, 0x02, 0x69, 0x69, 0xea, 0x41, 0x61, 0x4a, 0x63, 0x7b, 0x9b, 0xb9, 0x0c,
+	0x77, 0x57, 0x6d, 0x16, 0xf5, 0x01, 0x65, 0x77, 0x5a, 0x24, 0x33, 0x20, 0x6e, 0xb9, 0x03, 0x1a,
+	0xe5, 0x7a, 0x1b, 0xd4, 0xda, 0xf1, 0x7b, 0xd4, 0xce, 0x5f, 0x0b, 0x1b, 0xb3, 0x5d, 0x6e, 0xf3,
+	0x63, 0x6d, 0xda, 0x0c, 0xe9, 0xc6, 0x37, 0x25, 0x18, 0xbe, 0xb8, 0x5a, 0x7a, 0xce, 0xf5, 0xd2,
+	0x73, 0x7e, 0x2d, 0x3d, 0xe7, 0xcb, 0xca, 0xab, 0x5c, 0xaf, 0xbc, 0xca, 0x8f, 0x95, 0x57, 0xf9,
+	0xf0, 0x60, 0xfb, 0xa9, 0xf8, 0xf4, 0xd7, 0xd3, 0xa1, 0x3e, 0xcf, 0x39, 0x4c, 0xea, 0xfa, 0x7d,
+	0x78, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x28, 0x5f, 0xc4, 0xd7, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,6 +240,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MarketOffers) > 0 {
+		for iNdEx := len(m.MarketOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TransferOffers) > 0 {
+		for iNdEx := len(m.TransferOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TldLaunches) > 0 {
+		for iNdEx := len(m.TldLaunches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TldLaunches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AuctionBids) > 0 {
+		for iNdEx := len(m.AuctionBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TldStats) > 0 {
+		for iNdEx := len(m.TldStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TldStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.PermittedTlds) > 0 {
 		for iNdEx := len(m.PermittedTlds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PermittedTlds[iNdEx])
@@ -220,6 +441,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TldStats) > 0 {
+		for _, e := range m.TldStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionBids) > 0 {
+		for _, e := range m.AuctionBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TldLaunches) > 0 {
+		for _, e := range m.TldLaunches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferOffers) > 0 {
+		for _, e := range m.TransferOffers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketOffers) > 0 {
+		for _, e := range m.MarketOffers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -376,6 +651,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.PermittedTlds = append(m.PermittedTlds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TldStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TldStats = append(m.TldStats, TLDStats{})
+			if err := m.TldStats[len(m.TldStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, Commitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionBids = append(m.AuctionBids, AuctionBid{})
+			if err := m.AuctionBids[len(m.AuctionBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TldLaunches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TldLaunches = append(m.TldLaunches, TLDLaunch{})
+			if err := m.TldLaunches[len(m.TldLaunches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, NameRelease{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferOffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferOffers = append(m.TransferOffers, TransferOffer{})
+			if err := m.TransferOffers[len(m.TransferOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketOffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketOffers = append(m.MarketOffers, MarketOffer{})
+			if err := m.MarketOffers[len(m.MarketOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"dnsblockchain/x/dnsblockchain/types"
 
 	"github.com/stretchr/testify/require"
@@ -46,6 +48,42 @@ func TestGenesisState_Validate(t *testing.T) {
 				DomainCount: 0,
 			},
 			valid: false,
+		}, {
+			desc: "duplicated commitment",
+			genState: &types.GenesisState{
+				Commitments: []types.Commitment{
+					{Hash: types.CommitmentHash("a.web3", "owner", "creator", "salt"), Creator: "creator"},
+					{Hash: types.CommitmentHash("a.web3", "owner", "creator", "salt"), Creator: "creator"},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "truncated commitment hash",
+			genState: &types.GenesisState{
+				Commitments: []types.Commitment{{Hash: []byte{1, 2, 3}, Creator: "creator"}},
+			},
+			valid: false,
+		}, {
+			desc: "bid without auction",
+			genState: &types.GenesisState{
+				Auctions:    []types.Auction{{Name: "a.web3", BidEnd: 10, RevealEnd: 20, MinPrice: sdk.NewInt64Coin("udns", 1)}},
+				AuctionBids: []types.AuctionBid{{Name: "b.web3", Bidder: "bidder", SealedBid: []byte{1}}},
+			},
+			valid: false,
+		}, {
+			desc: "transfer offer for unknown domain",
+			genState: &types.GenesisState{
+				TransferOffers: []types.TransferOffer{{DomainId: 7, Name: "a.web3", From: "from", To: "to", Expires: 10}},
+			},
+			valid: false,
+		}, {
+			desc: "unpriced listing",
+			genState: &types.GenesisState{
+				DomainList:  []types.Domain{{Id: 0, Name: "a.web3"}},
+				DomainCount: 1,
+				Listings:    []types.Listing{{DomainId: 0, Name: "a.web3", Seller: "seller"}},
+			},
+			valid: false,
 		},
 	}
 	for _, tc := range tests {
@@ -25,4 +25,27 @@ var (
 	DomainNameKey    = collections.NewPrefix("domain_by_name/value/") // Maps FQDN -> Domain ID
 	DomainCountKey   = collections.NewPrefix("domain/count/")
 	PermittedTLDsKey = collections.NewPrefix("permitted_tlds/")
+
+	DomainExpirationQueueKey = collections.NewPrefix("domain_expiration_queue/") // (Expiration, ID) -> nothing
+	DomainRetryQueueKey      = collections.NewPrefix("domain_retry_queue/")      // (Retry height, ID) -> nothing
+	DomainsByOwnerKey        = collections.NewPrefix("domains_by_owner/")        // (Owner, ID) -> nothing
+	DomainsByTLDKey          = collections.NewPrefix("domains_by_tld/")          // (TLD, ID) -> nothing
+	TLDExpirationsKey        = collections.NewPrefix("tld_expirations/")         // (TLD, Expiration, ID) -> nothing
+	TLDStatsKey              = collections.NewPrefix("tld_stats/")               // TLD -> TLDStats
+	SubdomainsKey            = collections.NewPrefix("subdomains/")              // (Parent name, ID) -> nothing
+	CommitmentsKey           = collections.NewPrefix("commitments/")             // (Creator, Hash) -> Commitment
+	CommitmentQueueKey       = collections.NewPrefix("commitment_queue/")        // (Height, Creator, Hash) -> nothing
+	CommitmentRetryQueueKey  = collections.NewPrefix("commitment_retry_queue/")  // (Retry height, Creator, Hash) -> nothing
+	AuctionsKey              = collections.NewPrefix("auctions/")                // Name -> Auction
+	AuctionQueueKey          = collections.NewPrefix("auction_queue/")           // (Reveal end, Name) -> nothing
+	AuctionRetryQueueKey     = collections.NewPrefix("auction_retry_queue/")     // (Retry height, Name) -> nothing
+	AuctionBidsKey           = collections.NewPrefix("auction_bids/")            // (Name, Bidder) -> AuctionBid
+	TLDLaunchesKey           = collections.NewPrefix("tld_launches/")            // TLD -> auction-only-until time
+	ReleasesKey              = collections.NewPrefix("releases/")                // Name -> NameRelease
+	ReleaseQueueKey          = collections.NewPrefix("release_queue/")           // (Release end, Name) -> nothing
+	TransferOffersKey        = collections.NewPrefix("transfer_offers/")         // Domain ID -> TransferOffer
+	TransferOffersByToKey    = collections.NewPrefix("transfer_offers_by_to/")   // (Recipient, Domain ID) -> nothing
+	TransferOfferQueueKey    = collections.NewPrefix("transfer_offer_queue/")    // (Expiry, Domain ID) -> nothing
+	ListingsKey              = collections.NewPrefix("listings/")                // Domain ID -> Listing
+	MarketOffersKey          = collections.NewPrefix("market_offers/")           // (Domain ID, Buyer) -> MarketOffer
 )
@@ -0,0 +1,40 @@
+package types
+
+import "time"
+
+// NextDeadline returns the unix time at which the domain leaves its current lifecycle status:
+// its expiration while active, or the end of the grace, redemption or pending-delete period.
+// The expiration queue is keyed by this value.
+func (d Domain) NextDeadline() uint64 {
+	if d.Status == DomainStatus_DOMAIN_STATUS_ACTIVE {
+		return d.Expiration
+	}
+	return d.StatusDeadline
+}
+
+// AdvanceLifecycle moves the domain through every lifecycle transition due at or before now.
+// It returns the advanced domain and whether its pending-delete period has also ended, meaning
+// the name must be released.
+func (d Domain) AdvanceLifecycle(now uint64, p Params) (Domain, bool) {
+	for d.NextDeadline() <= now {
+		switch d.Status {
+		case DomainStatus_DOMAIN_STATUS_ACTIVE:
+			d.Status = DomainStatus_DOMAIN_STATUS_GRACE
+			d.StatusDeadline = d.Expiration + p.GracePeriod
+		case DomainStatus_DOMAIN_STATUS_GRACE:
+			d.Status = DomainStatus_DOMAIN_STATUS_REDEMPTION
+			d.StatusDeadline += p.RedemptionPeriod
+		case DomainStatus_DOMAIN_STATUS_REDEMPTION:
+			d.Status = DomainStatus_DOMAIN_STATUS_PENDING_DELETE
+			d.StatusDeadline += p.PendingDeletePeriod
+		default:
+			return d, true
+		}
+	}
+	return d, false
+}
+
+// AddYears returns the unix time the given number of calendar years after ts.
+func AddYears(ts uint64, years uint64) uint64 {
+	return uint64(time.Unix(int64(ts), 0).UTC().AddDate(int(years), 0, 0).Unix())
+}
@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestDomainAdvanceLifecycle(t *testing.T) {
+	params := types.Params{GracePeriod: 10, RedemptionPeriod: 20, PendingDeletePeriod: 5}
+	active := types.Domain{Expiration: 100}
+
+	tests := []struct {
+		desc     string
+		domain   types.Domain
+		params   types.Params
+		now      uint64
+		status   types.DomainStatus
+		deadline uint64
+		released bool
+	}{
+		{desc: "before expiration", domain: active, params: params, now: 99, status: types.DomainStatus_DOMAIN_STATUS_ACTIVE},
+		{desc: "at expiration", domain: active, params: params, now: 100, status: types.DomainStatus_DOMAIN_STATUS_GRACE, deadline: 110},
+		{desc: "redemption", domain: active, params: params, now: 115, status: types.DomainStatus_DOMAIN_STATUS_REDEMPTION, deadline: 130},
+		{desc: "pending delete", domain: active, params: params, now: 130, status: types.DomainStatus_DOMAIN_STATUS_PENDING_DELETE, deadline: 135},
+		{desc: "released", domain: active, params: params, now: 135, status: types.DomainStatus_DOMAIN_STATUS_PENDING_DELETE, deadline: 135, released: true},
+		{
+			desc:     "from stored grace",
+			domain:   types.Domain{Expiration: 100, Status: types.DomainStatus_DOMAIN_STATUS_GRACE, StatusDeadline: 150},
+			params:   params,
+			now:      160,
+			status:   types.DomainStatus_DOMAIN_STATUS_REDEMPTION,
+			deadline: 170,
+		},
+		{desc: "zero periods release at expiration", domain: active, now: 100, status: types.DomainStatus_DOMAIN_STATUS_PENDING_DELETE, deadline: 100, released: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, released := tc.domain.AdvanceLifecycle(tc.now, tc.params)
+			require.Equal(t, tc.status, got.Status)
+			require.Equal(t, tc.released, released)
+			if tc.status != types.DomainStatus_DOMAIN_STATUS_ACTIVE {
+				require.Equal(t, tc.deadline, got.StatusDeadline)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MarketRoyalty splits the price of a marketplace sale into the royalty paid to the domain's
+// original creator and the proceeds paid to the seller.
+func (p Params) MarketRoyalty(price sdk.Coin) (royalty, proceeds sdk.Coin) {
+	amount := price.Amount.Mul(math.NewIntFromUint64(uint64(p.MarketRoyaltyBps))).QuoRaw(FeeSplitTotalBps)
+	royalty = sdk.NewCoin(price.Denom, amount)
+	return royalty, price.Sub(royalty)
+}
@@ -2,17 +2,20 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ---------- MsgCreateDomain ----------
-func NewMsgCreateDomain(creator string, name string, owner string, nsRecords []*NSRecordWithIP) *MsgCreateDomain {
+func NewMsgCreateDomain(creator string, name string, owner string, nsRecords []*NSRecordWithIP, years uint64) *MsgCreateDomain {
 	return &MsgCreateDomain{
 		Creator:   creator,
 		Name:      name,
 		Owner:     owner,
 		NsRecords: nsRecords,
+		Years:     years,
 	}
 }
 
@@ -113,11 +116,12 @@ func (msg *MsgDeleteDomain) GetSigners() []sdk.AccAddress {
 }
 
 // ---------- MsgTransferDomain ----------
-func NewMsgTransferDomain(creator string, id uint64, newOwner string) *MsgTransferDomain {
+func NewMsgTransferDomain(creator string, id uint64, newOwner string, direct bool) *MsgTransferDomain {
 	return &MsgTransferDomain{
 		Creator:  creator,
 		Id:       id,
 		NewOwner: newOwner,
+		Direct:   direct,
 	}
 }
 
@@ -161,3 +165,458 @@ func (msg *MsgHeartbeatDomain) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{creator}
 }
+
+// ---------- MsgRenewDomain ----------
+func NewMsgRenewDomain(creator string, id uint64, years uint64) *MsgRenewDomain {
+	return &MsgRenewDomain{
+		Creator: creator,
+		Id:      id,
+		Years:   years,
+	}
+}
+
+func (msg *MsgRenewDomain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	return nil
+}
+
+func (msg *MsgRenewDomain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgSetDomainRecords ----------
+func NewMsgSetDomainRecords(creator string, id uint64, records []*ResourceRecord, clearNsRecords bool) *MsgSetDomainRecords {
+	return &MsgSetDomainRecords{
+		Creator:        creator,
+		Id:             id,
+		Records:        records,
+		ClearNsRecords: clearNsRecords,
+	}
+}
+
+func (msg *MsgSetDomainRecords) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	return ValidateResourceRecords(msg.Records)
+}
+
+func (msg *MsgSetDomainRecords) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgSetDomainDSRecords ----------
+func NewMsgSetDomainDSRecords(creator string, id uint64, dsRecords []*DSRecord) *MsgSetDomainDSRecords {
+	return &MsgSetDomainDSRecords{
+		Creator:   creator,
+		Id:        id,
+		DsRecords: dsRecords,
+	}
+}
+
+func (msg *MsgSetDomainDSRecords) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	return ValidateDSRecords(msg.DsRecords)
+}
+
+func (msg *MsgSetDomainDSRecords) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgCommitDomain ----------
+func NewMsgCommitDomain(creator string, hash []byte) *MsgCommitDomain {
+	return &MsgCommitDomain{
+		Creator: creator,
+		Hash:    hash,
+	}
+}
+
+func (msg *MsgCommitDomain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if len(msg.Hash) != sha256.Size {
+		return sdkerrors.ErrInvalidRequest.Wrapf("commitment hash must be %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+func (msg *MsgCommitDomain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgRevealDomain ----------
+func NewMsgRevealDomain(creator string, name string, owner string, nsRecords []*NSRecordWithIP, years uint64, salt string) *MsgRevealDomain {
+	return &MsgRevealDomain{
+		Creator:   creator,
+		Name:      name,
+		Owner:     owner,
+		NsRecords: nsRecords,
+		Years:     years,
+		Salt:      salt,
+	}
+}
+
+func (msg *MsgRevealDomain) ValidateBasic() error {
+	create := MsgCreateDomain{Creator: msg.Creator, Name: msg.Name, Owner: msg.Owner, NsRecords: msg.NsRecords, Years: msg.Years}
+	if err := create.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.Salt == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("salt cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgRevealDomain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgOpenAuction ----------
+func NewMsgOpenAuction(creator string, name string) *MsgOpenAuction {
+	return &MsgOpenAuction{
+		Creator: creator,
+		Name:    name,
+	}
+}
+
+func (msg *MsgOpenAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if msg.Name == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("name cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgOpenAuction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgPlaceBid ----------
+func NewMsgPlaceBid(creator string, name string, sealedBid []byte, deposit sdk.Coins) *MsgPlaceBid {
+	return &MsgPlaceBid{
+		Creator:   creator,
+		Name:      name,
+		SealedBid: sealedBid,
+		Deposit:   deposit,
+	}
+}
+
+func (msg *MsgPlaceBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if msg.Name == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("name cannot be empty")
+	}
+	if len(msg.SealedBid) != sha256.Size {
+		return sdkerrors.ErrInvalidRequest.Wrapf("sealed bid must be %d bytes", sha256.Size)
+	}
+	if err := msg.Deposit.Validate(); err != nil || msg.Deposit.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid deposit %s", msg.Deposit)
+	}
+	return nil
+}
+
+func (msg *MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgRevealBid ----------
+func NewMsgRevealBid(creator string, name string, amount sdk.Coin, salt string, nsRecords []*NSRecordWithIP) *MsgRevealBid {
+	return &MsgRevealBid{
+		Creator:   creator,
+		Name:      name,
+		Amount:    amount,
+		Salt:      salt,
+		NsRecords: nsRecords,
+	}
+}
+
+func (msg *MsgRevealBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if msg.Name == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("name cannot be empty")
+	}
+	if err := msg.Amount.Validate(); err != nil || !msg.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid bid amount %s", msg.Amount)
+	}
+	if msg.Salt == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("salt cannot be empty")
+	}
+	if len(msg.NsRecords) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("ns_records cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgRevealBid) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgOfferDomainTransfer ----------
+func NewMsgOfferDomainTransfer(creator string, id uint64, newOwner string) *MsgOfferDomainTransfer {
+	return &MsgOfferDomainTransfer{
+		Creator:  creator,
+		Id:       id,
+		NewOwner: newOwner,
+	}
+}
+
+func (msg *MsgOfferDomainTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+	return nil
+}
+
+func (msg *MsgOfferDomainTransfer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgAcceptDomainTransfer ----------
+func NewMsgAcceptDomainTransfer(creator string, id uint64) *MsgAcceptDomainTransfer {
+	return &MsgAcceptDomainTransfer{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgAcceptDomainTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	return nil
+}
+
+func (msg *MsgAcceptDomainTransfer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgCancelDomainTransfer ----------
+func NewMsgCancelDomainTransfer(creator string, id uint64) *MsgCancelDomainTransfer {
+	return &MsgCancelDomainTransfer{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelDomainTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	return nil
+}
+
+func (msg *MsgCancelDomainTransfer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgListDomain ----------
+func NewMsgListDomain(creator string, id uint64, price sdk.Coin) *MsgListDomain {
+	return &MsgListDomain{
+		Creator: creator,
+		Id:      id,
+		Price:   price,
+	}
+}
+
+func (msg *MsgListDomain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if err := msg.Price.Validate(); err != nil || !msg.Price.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid price %s", msg.Price)
+	}
+	return nil
+}
+
+func (msg *MsgListDomain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgUnlistDomain ----------
+func NewMsgUnlistDomain(creator string, id uint64) *MsgUnlistDomain {
+	return &MsgUnlistDomain{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgUnlistDomain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	return nil
+}
+
+func (msg *MsgUnlistDomain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgBuyDomain ----------
+func NewMsgBuyDomain(creator string, id uint64, price sdk.Coin) *MsgBuyDomain {
+	return &MsgBuyDomain{
+		Creator: creator,
+		Id:      id,
+		Price:   price,
+	}
+}
+
+func (msg *MsgBuyDomain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if err := msg.Price.Validate(); err != nil || !msg.Price.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid price %s", msg.Price)
+	}
+	return nil
+}
+
+func (msg *MsgBuyDomain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgPlaceMarketOffer ----------
+func NewMsgPlaceMarketOffer(creator string, id uint64, amount sdk.Coin) *MsgPlaceMarketOffer {
+	return &MsgPlaceMarketOffer{
+		Creator: creator,
+		Id:      id,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgPlaceMarketOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if err := msg.Amount.Validate(); err != nil || !msg.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid offer amount %s", msg.Amount)
+	}
+	return nil
+}
+
+func (msg *MsgPlaceMarketOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgWithdrawMarketOffer ----------
+func NewMsgWithdrawMarketOffer(creator string, id uint64) *MsgWithdrawMarketOffer {
+	return &MsgWithdrawMarketOffer{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgWithdrawMarketOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	return nil
+}
+
+func (msg *MsgWithdrawMarketOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ---------- MsgAcceptMarketOffer ----------
+func NewMsgAcceptMarketOffer(creator string, id uint64, buyer string) *MsgAcceptMarketOffer {
+	return &MsgAcceptMarketOffer{
+		Creator: creator,
+		Id:      id,
+		Buyer:   buyer,
+	}
+}
+
+func (msg *MsgAcceptMarketOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid buyer address: %s", err)
+	}
+	return nil
+}
+
+func (msg *MsgAcceptMarketOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
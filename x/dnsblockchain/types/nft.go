@@ -0,0 +1,46 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/x/nft"
+)
+
+// NFTClassPrefix prefixes the x/nft class id of each permitted TLD, e.g. "dns-web3".
+const NFTClassPrefix = "dns-"
+
+// NFTClassID returns the x/nft class holding the domains registered under a TLD.
+func NFTClassID(tld string) string {
+	return NFTClassPrefix + tld
+}
+
+// TLDFromNFTClassID returns the TLD of a domain NFT class, and false for classes of other issuers.
+func TLDFromNFTClassID(classID string) (string, bool) {
+	tld, ok := strings.CutPrefix(classID, NFTClassPrefix)
+	return tld, ok && tld != ""
+}
+
+// NFTID returns the x/nft token id of a domain, which is its normalized name.
+func NFTID(name string) string {
+	return name
+}
+
+// NewNFTClass returns the x/nft class of a TLD, named after it so wallets can display it.
+func NewNFTClass(tld string) nft.Class {
+	return nft.Class{
+		Id:          NFTClassID(tld),
+		Name:        "." + tld,
+		Symbol:      strings.ToUpper(tld),
+		Description: "Domains registered under ." + tld,
+		Uri:         "dns:" + tld,
+	}
+}
+
+// NewDomainNFT returns the x/nft token of a domain.
+func NewDomainNFT(name string) nft.NFT {
+	return nft.NFT{
+		ClassId: NFTClassID(ExtractTLD(name)),
+		Id:      NFTID(name),
+		Uri:     "dns:" + name,
+	}
+}
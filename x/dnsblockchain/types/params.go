@@ -8,16 +8,117 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// DefaultMaxExpirationsPerBlock caps the items processed in a single EndBlocker run.
+const DefaultMaxExpirationsPerBlock uint64 = 100
+
+// SweepRetryBlocks is how many blocks an EndBlocker item that failed to process is set aside before
+// it is queued again, so failing items cannot keep the items behind them from being processed.
+const SweepRetryBlocks int64 = 100
+
+// DefaultMaxRegistrationYears caps how many years ahead a domain can be registered or renewed.
+const DefaultMaxRegistrationYears uint64 = 10
+
+const (
+	// DefaultGracePeriod is the time after expiration during which only the owner can renew (30 days).
+	DefaultGracePeriod uint64 = 30 * 24 * 60 * 60
+	// DefaultRedemptionPeriod is the time after the grace period during which the owner can restore (30 days).
+	DefaultRedemptionPeriod uint64 = 30 * 24 * 60 * 60
+	// DefaultPendingDeletePeriod is the time after the redemption period before release (5 days).
+	DefaultPendingDeletePeriod uint64 = 5 * 24 * 60 * 60
+)
+
 // NewParams crea una nueva instancia de Params.
-func NewParams(domainCreationFee sdk.Coins) Params {
+func NewParams(
+	domainCreationFee sdk.Coins,
+	maxExpirationsPerBlock uint64,
+	gracePeriod uint64,
+	redemptionPeriod uint64,
+	pendingDeletePeriod uint64,
+	restoreFee sdk.Coins,
+	maxRegistrationYears uint64,
+	domainRenewalFee sdk.Coins,
+	priceTiers []PriceTier,
+	premiumNames []PremiumName,
+	feeSplit FeeSplit,
+	domainTransferFee sdk.Coins,
+	domainUpdateFee sdk.Coins,
+	maxRecordsPerDomain uint64,
+	commitMinBlocks uint64,
+	commitMaxBlocks uint64,
+	commitDeposit sdk.Coins,
+	commitForfeitBps uint32,
+	requireCommitment bool,
+	auctionBidPeriod uint64,
+	auctionRevealPeriod uint64,
+	tldLaunchPeriod uint64,
+	releaseStartMultiplier uint32,
+	releasePeriod uint64,
+	transferOfferPeriod uint64,
+	marketRoyaltyBps uint32,
+	auctionForfeitBps uint32,
+) Params {
 	return Params{
-		DomainCreationFee: domainCreationFee,
+		DomainCreationFee:      domainCreationFee,
+		MaxExpirationsPerBlock: maxExpirationsPerBlock,
+		GracePeriod:            gracePeriod,
+		RedemptionPeriod:       redemptionPeriod,
+		PendingDeletePeriod:    pendingDeletePeriod,
+		RestoreFee:             restoreFee,
+		MaxRegistrationYears:   maxRegistrationYears,
+		DomainRenewalFee:       domainRenewalFee,
+		PriceTiers:             priceTiers,
+		PremiumNames:           premiumNames,
+		FeeSplit:               feeSplit,
+		DomainTransferFee:      domainTransferFee,
+		DomainUpdateFee:        domainUpdateFee,
+		MaxRecordsPerDomain:    maxRecordsPerDomain,
+		CommitMinBlocks:        commitMinBlocks,
+		CommitMaxBlocks:        commitMaxBlocks,
+		CommitDeposit:          commitDeposit,
+		CommitForfeitBps:       commitForfeitBps,
+		RequireCommitment:      requireCommitment,
+		AuctionBidPeriod:       auctionBidPeriod,
+		AuctionRevealPeriod:    auctionRevealPeriod,
+		TldLaunchPeriod:        tldLaunchPeriod,
+		ReleaseStartMultiplier: releaseStartMultiplier,
+		ReleasePeriod:          releasePeriod,
+		TransferOfferPeriod:    transferOfferPeriod,
+		MarketRoyaltyBps:       marketRoyaltyBps,
+		AuctionForfeitBps:      auctionForfeitBps,
 	}
 }
 
 // DefaultParams devuelve un conjunto de parámetros por defecto.
 func DefaultParams() Params {
-	return NewParams(sdk.NewCoins(sdk.NewInt64Coin("udns", 20000000))) // 20 dns = 20,000,000 udns
+	return NewParams(
+		sdk.NewCoins(sdk.NewInt64Coin("udns", 20000000)), // 20 dns = 20,000,000 udns
+		DefaultMaxExpirationsPerBlock,
+		DefaultGracePeriod,
+		DefaultRedemptionPeriod,
+		DefaultPendingDeletePeriod,
+		sdk.NewCoins(sdk.NewInt64Coin("udns", 50000000)), // 50 dns
+		DefaultMaxRegistrationYears,
+		sdk.NewCoins(sdk.NewInt64Coin("udns", 20000000)), // 20 dns por año
+		DefaultPriceTiers(),
+		nil,
+		DefaultFeeSplit(),
+		sdk.NewCoins(sdk.NewInt64Coin("udns", 5000000)), // 5 dns
+		sdk.NewCoins(sdk.NewInt64Coin("udns", 1000000)), // 1 dns
+		DefaultMaxRecordsPerDomain,
+		DefaultCommitMinBlocks,
+		DefaultCommitMaxBlocks,
+		sdk.NewCoins(sdk.NewInt64Coin("udns", 1000000)), // 1 dns
+		DefaultCommitForfeitBps,
+		false,
+		DefaultAuctionBidPeriod,
+		DefaultAuctionRevealPeriod,
+		DefaultTLDLaunchPeriod,
+		DefaultReleaseStartMultiplier,
+		DefaultReleasePeriod,
+		DefaultTransferOfferPeriod,
+		0,
+		DefaultAuctionForfeitBps,
+	)
 }
 
 // Validate valida el conjunto de parámetros.
@@ -25,9 +126,59 @@ func (p Params) Validate() error {
 	if err := validateDomainCreationFee(p.DomainCreationFee); err != nil {
 		return err
 	}
+	if err := validateFee("restore fee", p.RestoreFee); err != nil {
+		return err
+	}
+	if err := validateFee("domain renewal fee", p.DomainRenewalFee); err != nil {
+		return err
+	}
+	if err := validatePriceTiers(p.PriceTiers); err != nil {
+		return err
+	}
+	if err := validatePremiumNames(p.PremiumNames); err != nil {
+		return err
+	}
+	if err := validateFee("domain transfer fee", p.DomainTransferFee); err != nil {
+		return err
+	}
+	if err := validateFee("domain update fee", p.DomainUpdateFee); err != nil {
+		return err
+	}
+	if err := p.FeeSplit.Validate(); err != nil {
+		return err
+	}
+	if err := validateCommitParams(p); err != nil {
+		return err
+	}
+	if p.ReleaseStartMultiplier > MaxReleaseStartMultiplier {
+		return fmt.Errorf("release start multiplier %d exceeds %d", p.ReleaseStartMultiplier, MaxReleaseStartMultiplier)
+	}
+	if p.MarketRoyaltyBps > FeeSplitTotalBps {
+		return fmt.Errorf("market royalty %d bps exceeds %d", p.MarketRoyaltyBps, FeeSplitTotalBps)
+	}
+	if p.AuctionForfeitBps > FeeSplitTotalBps {
+		return fmt.Errorf("auction forfeit %d bps exceeds %d", p.AuctionForfeitBps, FeeSplitTotalBps)
+	}
 	return nil
 }
 
+// ExpirationsPerBlock returns the number of items the EndBlocker processes per block across all of
+// its sweeps, falling back to the default when unset.
+func (p Params) ExpirationsPerBlock() uint64 {
+	if p.MaxExpirationsPerBlock == 0 {
+		return DefaultMaxExpirationsPerBlock
+	}
+	return p.MaxExpirationsPerBlock
+}
+
+// RegistrationYearsLimit returns the maximum registration term in years, falling back to the default when unset.
+func (p Params) RegistrationYearsLimit() uint64 {
+	if p.MaxRegistrationYears == 0 {
+		return DefaultMaxRegistrationYears
+	}
+	return p.MaxRegistrationYears
+}
+
 func validateDomainCreationFee(i interface{}) error {
 	v, ok := i.(sdk.Coins)
 	if !ok {
@@ -46,3 +197,10 @@ func validateDomainCreationFee(i interface{}) error {
 	}
 	return nil
 }
+
+func validateFee(name string, fee sdk.Coins) error {
+	if err := fee.Validate(); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid %s: %v", name, err)
+	}
+	return nil
+}
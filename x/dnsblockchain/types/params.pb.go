@@ -28,6 +28,68 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
 	DomainCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=domain_creation_fee,json=domainCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"domain_creation_fee"`
+	// Maximum number of items the EndBlocker processes in a single block, shared by its sweeps in
+	// order: domain lifecycle transitions, stale commitments, auction settlements, ended releases
+	// and expired transfer offers. What is left over is picked up by the following blocks.
+	MaxExpirationsPerBlock uint64 `protobuf:"varint,2,opt,name=max_expirations_per_block,json=maxExpirationsPerBlock,proto3" json:"max_expirations_per_block,omitempty"`
+	// Seconds after expiration during which only the owner can renew the domain.
+	GracePeriod uint64 `protobuf:"varint,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	// Seconds after the grace period during which the owner can restore the domain for restore_fee.
+	RedemptionPeriod uint64 `protobuf:"varint,4,opt,name=redemption_period,json=redemptionPeriod,proto3" json:"redemption_period,omitempty"`
+	// Seconds after the redemption period before the name is released.
+	PendingDeletePeriod uint64 `protobuf:"varint,5,opt,name=pending_delete_period,json=pendingDeletePeriod,proto3" json:"pending_delete_period,omitempty"`
+	// Fee charged to restore a domain during its redemption period.
+	RestoreFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=restore_fee,json=restoreFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"restore_fee"`
+	// Maximum number of years a domain can be registered or renewed ahead of the current block time.
+	MaxRegistrationYears uint64 `protobuf:"varint,7,opt,name=max_registration_years,json=maxRegistrationYears,proto3" json:"max_registration_years,omitempty"`
+	// Fee charged per year of renewal, and per additional year of a multi-year registration.
+	DomainRenewalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=domain_renewal_fee,json=domainRenewalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"domain_renewal_fee"`
+	// Registration and renewal prices by label length. The first tier whose max_length is at least the
+	// label's length applies; labels longer than every tier use domain_creation_fee and domain_renewal_fee.
+	PriceTiers []PriceTier `protobuf:"bytes,9,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers"`
+	// Names with explicit prices, taking precedence over the label-length tiers.
+	PremiumNames []PremiumName `protobuf:"bytes,10,rep,name=premium_names,json=premiumNames,proto3" json:"premium_names"`
+	// How collected domain fees are split between burning, the fee collector and the community pool.
+	FeeSplit FeeSplit `protobuf:"bytes,11,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split"`
+	// Fee charged to transfer a domain to a new owner; zero makes transfers free.
+	DomainTransferFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=domain_transfer_fee,json=domainTransferFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"domain_transfer_fee"`
+	// Fee charged to update a domain's owner, NS records or resource records; zero makes updates free.
+	DomainUpdateFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=domain_update_fee,json=domainUpdateFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"domain_update_fee"`
+	// Maximum number of resource records a domain can publish on chain.
+	MaxRecordsPerDomain uint64 `protobuf:"varint,14,opt,name=max_records_per_domain,json=maxRecordsPerDomain,proto3" json:"max_records_per_domain,omitempty"`
+	// Blocks a commitment must wait before it can be revealed.
+	CommitMinBlocks uint64 `protobuf:"varint,15,opt,name=commit_min_blocks,json=commitMinBlocks,proto3" json:"commit_min_blocks,omitempty"`
+	// Blocks after which an unrevealed commitment expires and is pruned.
+	CommitMaxBlocks uint64 `protobuf:"varint,16,opt,name=commit_max_blocks,json=commitMaxBlocks,proto3" json:"commit_max_blocks,omitempty"`
+	// Deposit held with each commitment until it is revealed or pruned.
+	CommitDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,17,rep,name=commit_deposit,json=commitDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commit_deposit"`
+	// Share of the deposit of a commitment pruned without a reveal that is forfeited through the fee
+	// split, in basis points; the rest is refunded.
+	CommitForfeitBps uint32 `protobuf:"varint,18,opt,name=commit_forfeit_bps,json=commitForfeitBps,proto3" json:"commit_forfeit_bps,omitempty"`
+	// Only register names under a TLD through MsgCommitDomain and MsgRevealDomain; MsgCreateDomain
+	// then only creates subdomains.
+	RequireCommitment bool `protobuf:"varint,19,opt,name=require_commitment,json=requireCommitment,proto3" json:"require_commitment,omitempty"`
+	// Seconds an auction accepts sealed bids.
+	AuctionBidPeriod uint64 `protobuf:"varint,20,opt,name=auction_bid_period,json=auctionBidPeriod,proto3" json:"auction_bid_period,omitempty"`
+	// Seconds after the bid period during which bids are revealed.
+	AuctionRevealPeriod uint64 `protobuf:"varint,21,opt,name=auction_reveal_period,json=auctionRevealPeriod,proto3" json:"auction_reveal_period,omitempty"`
+	// Seconds after a TLD is permitted during which the names directly under it can only be won at
+	// auction; zero opens new TLDs to first-come registration right away.
+	TldLaunchPeriod uint64 `protobuf:"varint,22,opt,name=tld_launch_period,json=tldLaunchPeriod,proto3" json:"tld_launch_period,omitempty"`
+	// Multiple of its registration price a lapsed name costs when it is released; the price then
+	// decays linearly to the normal fee over release_period. Zero or one releases names at the normal
+	// fee.
+	ReleaseStartMultiplier uint32 `protobuf:"varint,23,opt,name=release_start_multiplier,json=releaseStartMultiplier,proto3" json:"release_start_multiplier,omitempty"`
+	// Seconds over which the price of a released name decays to its normal registration fee.
+	ReleasePeriod uint64 `protobuf:"varint,24,opt,name=release_period,json=releasePeriod,proto3" json:"release_period,omitempty"`
+	// Seconds a transfer offer can be accepted for.
+	TransferOfferPeriod uint64 `protobuf:"varint,25,opt,name=transfer_offer_period,json=transferOfferPeriod,proto3" json:"transfer_offer_period,omitempty"`
+	// Share of every marketplace sale paid to the domain's original creator, in basis points; zero
+	// pays no royalty.
+	MarketRoyaltyBps uint32 `protobuf:"varint,26,opt,name=market_royalty_bps,json=marketRoyaltyBps,proto3" json:"market_royalty_bps,omitempty"`
+	// Share of the deposit of an auction bid never revealed that is forfeited through the fee split
+	// at settlement, in basis points; the rest is refunded.
+	AuctionForfeitBps uint32 `protobuf:"varint,27,opt,name=auction_forfeit_bps,json=auctionForfeitBps,proto3" json:"auction_forfeit_bps,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,8 +132,382 @@ func (m *Params) GetDomainCreationFee() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *Params) GetMaxExpirationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExpirationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetGracePeriod() uint64 {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetRedemptionPeriod() uint64 {
+	if m != nil {
+		return m.RedemptionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetPendingDeletePeriod() uint64 {
+	if m != nil {
+		return m.PendingDeletePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetRestoreFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RestoreFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRegistrationYears() uint64 {
+	if m != nil {
+		return m.MaxRegistrationYears
+	}
+	return 0
+}
+
+func (m *Params) GetDomainRenewalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DomainRenewalFee
+	}
+	return nil
+}
+
+func (m *Params) GetPriceTiers() []PriceTier {
+	if m != nil {
+		return m.PriceTiers
+	}
+	return nil
+}
+
+func (m *Params) GetPremiumNames() []PremiumName {
+	if m != nil {
+		return m.PremiumNames
+	}
+	return nil
+}
+
+func (m *Params) GetFeeSplit() FeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return FeeSplit{}
+}
+
+func (m *Params) GetDomainTransferFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DomainTransferFee
+	}
+	return nil
+}
+
+func (m *Params) GetDomainUpdateFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DomainUpdateFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRecordsPerDomain() uint64 {
+	if m != nil {
+		return m.MaxRecordsPerDomain
+	}
+	return 0
+}
+
+func (m *Params) GetCommitMinBlocks() uint64 {
+	if m != nil {
+		return m.CommitMinBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetCommitMaxBlocks() uint64 {
+	if m != nil {
+		return m.CommitMaxBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetCommitDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommitDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetCommitForfeitBps() uint32 {
+	if m != nil {
+		return m.CommitForfeitBps
+	}
+	return 0
+}
+
+func (m *Params) GetRequireCommitment() bool {
+	if m != nil {
+		return m.RequireCommitment
+	}
+	return false
+}
+
+func (m *Params) GetAuctionBidPeriod() uint64 {
+	if m != nil {
+		return m.AuctionBidPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetAuctionRevealPeriod() uint64 {
+	if m != nil {
+		return m.AuctionRevealPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetTldLaunchPeriod() uint64 {
+	if m != nil {
+		return m.TldLaunchPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetReleaseStartMultiplier() uint32 {
+	if m != nil {
+		return m.ReleaseStartMultiplier
+	}
+	return 0
+}
+
+func (m *Params) GetReleasePeriod() uint64 {
+	if m != nil {
+		return m.ReleasePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetTransferOfferPeriod() uint64 {
+	if m != nil {
+		return m.TransferOfferPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMarketRoyaltyBps() uint32 {
+	if m != nil {
+		return m.MarketRoyaltyBps
+	}
+	return 0
+}
+
+func (m *Params) GetAuctionForfeitBps() uint32 {
+	if m != nil {
+		return m.AuctionForfeitBps
+	}
+	return 0
+}
+
+// FeeSplit divides domain fees between destinations, in basis points that must add up to 10000.
+// An all-zero split burns every fee.
+type FeeSplit struct {
+	// Share of each fee that is burned.
+	BurnBps uint32 `protobuf:"varint,1,opt,name=burn_bps,json=burnBps,proto3" json:"burn_bps,omitempty"`
+	// Share of each fee sent to the fee collector and distributed to validators and delegators.
+	FeeCollectorBps uint32 `protobuf:"varint,2,opt,name=fee_collector_bps,json=feeCollectorBps,proto3" json:"fee_collector_bps,omitempty"`
+	// Share of each fee sent to the community pool.
+	CommunityPoolBps uint32 `protobuf:"varint,3,opt,name=community_pool_bps,json=communityPoolBps,proto3" json:"community_pool_bps,omitempty"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_460f9f326abdbf6a, []int{1}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+func (m *FeeSplit) GetBurnBps() uint32 {
+	if m != nil {
+		return m.BurnBps
+	}
+	return 0
+}
+
+func (m *FeeSplit) GetFeeCollectorBps() uint32 {
+	if m != nil {
+		return m.FeeCollectorBps
+	}
+	return 0
+}
+
+func (m *FeeSplit) GetCommunityPoolBps() uint32 {
+	if m != nil {
+		return m.CommunityPoolBps
+	}
+	return 0
+}
+
+// PriceTier prices every label up to max_length characters long.
+type PriceTier struct {
+	MaxLength       uint32                                   `protobuf:"varint,1,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee"`
+	// Fee per year of renewal.
+	RenewalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=renewal_fee,json=renewalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewal_fee"`
+}
+
+func (m *PriceTier) Reset()         { *m = PriceTier{} }
+func (m *PriceTier) String() string { return proto.CompactTextString(m) }
+func (*PriceTier) ProtoMessage()    {}
+func (*PriceTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_460f9f326abdbf6a, []int{2}
+}
+func (m *PriceTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceTier.Merge(m, src)
+}
+func (m *PriceTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceTier proto.InternalMessageInfo
+
+func (m *PriceTier) GetMaxLength() uint32 {
+	if m != nil {
+		return m.MaxLength
+	}
+	return 0
+}
+
+func (m *PriceTier) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return nil
+}
+
+func (m *PriceTier) GetRenewalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewalFee
+	}
+	return nil
+}
+
+// PremiumName sets the price of a single fully qualified name (e.g. "bank.web3").
+type PremiumName struct {
+	Name            string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee"`
+	// Fee per year of renewal.
+	RenewalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=renewal_fee,json=renewalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewal_fee"`
+}
+
+func (m *PremiumName) Reset()         { *m = PremiumName{} }
+func (m *PremiumName) String() string { return proto.CompactTextString(m) }
+func (*PremiumName) ProtoMessage()    {}
+func (*PremiumName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_460f9f326abdbf6a, []int{3}
+}
+func (m *PremiumName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PremiumName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PremiumName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PremiumName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PremiumName.Merge(m, src)
+}
+func (m *PremiumName) XXX_Size() int {
+	return m.Size()
+}
+func (m *PremiumName) XXX_DiscardUnknown() {
+	xxx_messageInfo_PremiumName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PremiumName proto.InternalMessageInfo
+
+func (m *PremiumName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PremiumName) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return nil
+}
+
+func (m *PremiumName) GetRenewalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewalFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dnsblockchain.dnsblockchain.v1.Params")
+	proto.RegisterType((*FeeSplit)(nil), "dnsblockchain.dnsblockchain.v1.FeeSplit")
+	proto.RegisterType((*PriceTier)(nil), "dnsblockchain.dnsblockchain.v1.PriceTier")
+	proto.RegisterType((*PremiumName)(nil), "dnsblockchain.dnsblockchain.v1.PremiumName")
 }
 
 func init() {
@@ -79,25 +515,72 @@ func init() {
 }
 
 var fileDescriptor_460f9f326abdbf6a = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xc9, 0x2b, 0x4e,
-	0xca, 0xc9, 0x4f, 0xce, 0x4e, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x47, 0xe5, 0x95, 0x19, 0xea, 0x17,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0xc9, 0xa1, 0x48, 0xeb,
-	0xa1, 0xf2, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8b,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xe5, 0x92, 0xf3, 0x8b,
-	0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12, 0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d,
-	0xf5, 0x93, 0xf3, 0x33, 0xf3, 0x20, 0xf2, 0x4a, 0x5b, 0x18, 0xb9, 0xd8, 0x02, 0xc0, 0x36, 0x0b,
-	0x55, 0x73, 0x09, 0xa7, 0xe4, 0xe7, 0x26, 0x66, 0xe6, 0xc5, 0x27, 0x17, 0xa5, 0x26, 0x96, 0x64,
-	0xe6, 0xe7, 0xc5, 0xa7, 0xa5, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x49, 0xea, 0x41,
-	0x0c, 0xd2, 0x03, 0x19, 0xa4, 0x07, 0x35, 0x48, 0xcf, 0x39, 0x3f, 0x33, 0xcf, 0xc9, 0xe0, 0xc4,
-	0x3d, 0x79, 0x86, 0x55, 0xf7, 0xe5, 0x35, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3,
-	0x73, 0xf5, 0xa1, 0xb6, 0x42, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62,
-	0xb0, 0x86, 0xe2, 0x20, 0x41, 0x88, 0x3d, 0xce, 0x50, 0x6b, 0xdc, 0x52, 0x53, 0xad, 0x74, 0x5f,
-	0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x05, 0x35, 0x60, 0x2a, 0xd0, 0x02, 0x0a, 0xe2,
-	0x56, 0x27, 0xdb, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0xc6, 0xaf,
-	0x1f, 0xec, 0x8c, 0x24, 0x36, 0xb0, 0xe7, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x3e,
-	0xd5, 0x5d, 0x94, 0x01, 0x00, 0x00,
+	// 1031 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x3f, 0x73, 0x23, 0xc5,
+	0x13, 0xf5, 0xda, 0xfe, 0xf9, 0xec, 0x91, 0xff, 0x69, 0xec, 0xf3, 0x6f, 0x6d, 0x0a, 0xd9, 0x18,
+	0xa8, 0x12, 0xf6, 0x59, 0xc2, 0x3e, 0x02, 0xa0, 0x8a, 0x44, 0x36, 0x4e, 0xb8, 0x03, 0x95, 0xee,
+	0xa0, 0x0a, 0x92, 0xa9, 0xd1, 0x6e, 0x4b, 0x9e, 0xf2, 0xce, 0xce, 0x32, 0x33, 0xf2, 0x49, 0x45,
+	0x4c, 0x42, 0x15, 0x55, 0x44, 0xc4, 0xc4, 0x44, 0x7c, 0x8c, 0x0b, 0x2f, 0x24, 0x3a, 0x28, 0x3b,
+	0x80, 0x8f, 0x41, 0x4d, 0xcf, 0xac, 0x65, 0x11, 0xdc, 0x25, 0x76, 0x44, 0x22, 0xef, 0xf6, 0x7b,
+	0xdd, 0xaf, 0xa7, 0x77, 0xba, 0xdb, 0x64, 0x3f, 0xcd, 0x4d, 0x37, 0x53, 0xc9, 0x79, 0x72, 0xc6,
+	0x45, 0xde, 0x9c, 0x7c, 0xbb, 0x38, 0x6c, 0x16, 0x5c, 0x73, 0x69, 0x1a, 0x85, 0x56, 0x56, 0xd1,
+	0xda, 0x04, 0xdc, 0x98, 0x7c, 0xbb, 0x38, 0xdc, 0xaa, 0x72, 0x29, 0x72, 0xd5, 0xc4, 0x5f, 0xef,
+	0xb2, 0xb5, 0xde, 0x57, 0x7d, 0x85, 0x8f, 0x4d, 0xf7, 0x14, 0xac, 0xb5, 0x44, 0x19, 0xa9, 0x4c,
+	0xb3, 0xcb, 0x0d, 0x34, 0x2f, 0x0e, 0xbb, 0x60, 0xf9, 0x61, 0x33, 0x51, 0x22, 0xf7, 0xf8, 0xee,
+	0xcb, 0x65, 0x32, 0xd7, 0x46, 0x65, 0xfa, 0x1d, 0x59, 0x4b, 0x95, 0xe4, 0x22, 0x67, 0x89, 0x06,
+	0x6e, 0x85, 0xca, 0x59, 0x0f, 0x20, 0x8e, 0x76, 0x66, 0xea, 0x95, 0xa3, 0xcd, 0x86, 0x0f, 0xd4,
+	0x70, 0x81, 0x1a, 0x21, 0x50, 0xe3, 0x58, 0x89, 0xbc, 0xf5, 0xfe, 0xf3, 0x97, 0xdb, 0x53, 0xbf,
+	0xfe, 0xb1, 0x5d, 0xef, 0x0b, 0x7b, 0x36, 0xe8, 0x36, 0x12, 0x25, 0x9b, 0x41, 0xd5, 0xff, 0x39,
+	0x30, 0xe9, 0x79, 0xd3, 0x8e, 0x0a, 0x30, 0xe8, 0x60, 0x3a, 0x55, 0xaf, 0x73, 0x1c, 0x64, 0x4e,
+	0x01, 0xe8, 0x47, 0x64, 0x53, 0xf2, 0x21, 0x83, 0x61, 0x21, 0x34, 0x1a, 0x0d, 0x2b, 0x40, 0x33,
+	0x3c, 0x75, 0x3c, 0xbd, 0x13, 0xd5, 0x67, 0x3b, 0x1b, 0x92, 0x0f, 0x3f, 0x1d, 0xe3, 0x6d, 0xd0,
+	0x2d, 0x87, 0xd2, 0xb7, 0xc8, 0x62, 0x5f, 0xf3, 0x04, 0x9c, 0x83, 0x50, 0x69, 0x3c, 0x83, 0xec,
+	0x0a, 0xda, 0xda, 0x68, 0xa2, 0xfb, 0xa4, 0xaa, 0x21, 0x05, 0x59, 0xe0, 0xa9, 0x02, 0x6f, 0x16,
+	0x79, 0xab, 0x63, 0x20, 0x90, 0x8f, 0xc8, 0xfd, 0x02, 0xf2, 0x54, 0xe4, 0x7d, 0x96, 0x42, 0x06,
+	0xf6, 0x3a, 0xf0, 0xff, 0xd0, 0x61, 0x2d, 0x80, 0x27, 0x88, 0x05, 0x9f, 0x8c, 0x54, 0x34, 0x18,
+	0xab, 0x34, 0x60, 0xcd, 0xe6, 0x6e, 0xbf, 0x66, 0x24, 0xc4, 0x77, 0xc5, 0xfa, 0x80, 0xb8, 0x5a,
+	0x30, 0x0d, 0x7d, 0x61, 0xac, 0x2f, 0x07, 0x1b, 0x01, 0xd7, 0x26, 0xbe, 0x87, 0x29, 0xae, 0x4b,
+	0x3e, 0xec, 0xdc, 0x00, 0xbf, 0x76, 0x18, 0x1d, 0x11, 0x1a, 0xbe, 0xaf, 0x86, 0x1c, 0x9e, 0xf1,
+	0x0c, 0x53, 0x9d, 0xbf, 0xfd, 0x54, 0x57, 0xbd, 0x4c, 0xc7, 0xab, 0xb8, 0x84, 0xdb, 0xa4, 0x52,
+	0x68, 0x91, 0x00, 0xb3, 0x02, 0xb4, 0x89, 0x17, 0x50, 0xf3, 0xbd, 0xc6, 0xab, 0x2f, 0x79, 0xa3,
+	0xed, 0x5c, 0x9e, 0x0a, 0xd0, 0xad, 0x59, 0x97, 0x43, 0x87, 0x14, 0xa5, 0xc1, 0xd0, 0xaf, 0xc8,
+	0x52, 0xa1, 0x41, 0x8a, 0x81, 0x64, 0x39, 0x97, 0x60, 0x62, 0x82, 0x31, 0xf7, 0x5f, 0x1f, 0x13,
+	0x9d, 0x3e, 0xe7, 0x12, 0x42, 0xd4, 0xc5, 0x62, 0x6c, 0x32, 0xf4, 0x33, 0xb2, 0xd0, 0x03, 0x60,
+	0xa6, 0xc8, 0x84, 0x8d, 0x2b, 0x3b, 0x51, 0xbd, 0x72, 0x54, 0x7f, 0x5d, 0xcc, 0x53, 0x80, 0x27,
+	0x8e, 0x1f, 0x02, 0xce, 0xf7, 0xc2, 0xfb, 0x8d, 0x8e, 0xb2, 0x9a, 0xe7, 0xa6, 0x07, 0x1a, 0x4b,
+	0xbe, 0x78, 0x67, 0x1d, 0xf5, 0x34, 0xc8, 0xb8, 0x9a, 0x3f, 0x23, 0xc1, 0xc8, 0x06, 0x45, 0xca,
+	0xad, 0xbf, 0x98, 0x4b, 0xb7, 0x2f, 0xbd, 0xe2, 0x55, 0xbe, 0x44, 0x11, 0x27, 0xfc, 0xb0, 0xbc,
+	0x9d, 0x89, 0xd2, 0xa9, 0x6f, 0x63, 0x4f, 0x89, 0x97, 0x7d, 0x03, 0xe1, 0xed, 0x44, 0xb0, 0x0d,
+	0xfa, 0x04, 0x21, 0xba, 0x47, 0xaa, 0x89, 0x92, 0x52, 0x58, 0x26, 0x45, 0xee, 0xdb, 0xde, 0xc4,
+	0x2b, 0xc8, 0x5f, 0xf1, 0xc0, 0x63, 0x91, 0x63, 0xbf, 0x9b, 0x9b, 0x5c, 0x3e, 0x2c, 0xb9, 0xab,
+	0x13, 0x5c, 0x3e, 0x0c, 0x5c, 0x4d, 0x96, 0x03, 0x37, 0x85, 0x42, 0x19, 0x61, 0xe3, 0xea, 0xed,
+	0x97, 0x60, 0xc9, 0x4b, 0x9c, 0x78, 0x05, 0xfa, 0x80, 0xd0, 0xa0, 0xd9, 0x53, 0xba, 0x07, 0xc2,
+	0xb2, 0x6e, 0x61, 0x62, 0xba, 0x13, 0xd5, 0x97, 0x3a, 0xab, 0x1e, 0x39, 0xf5, 0x40, 0xab, 0x30,
+	0xf4, 0x80, 0x50, 0x0d, 0xdf, 0x0e, 0x84, 0x06, 0xe6, 0x31, 0x09, 0xb9, 0x8d, 0xd7, 0x76, 0xa2,
+	0xfa, 0x7c, 0xa7, 0x1a, 0x90, 0xe3, 0x6b, 0xc0, 0x05, 0xe7, 0x83, 0x04, 0x5b, 0xbe, 0x2b, 0xd2,
+	0x72, 0x34, 0xad, 0xfb, 0x59, 0x16, 0x90, 0x96, 0x48, 0xc7, 0xb3, 0xac, 0x64, 0x6b, 0xb8, 0x00,
+	0x9e, 0x95, 0x0e, 0xf7, 0xfd, 0xa7, 0x08, 0x60, 0x07, 0xb1, 0xe0, 0xb3, 0x47, 0xaa, 0x36, 0x4b,
+	0x59, 0xc6, 0x07, 0x79, 0x72, 0x56, 0xf2, 0x37, 0x7c, 0x79, 0x6d, 0x96, 0x3e, 0x42, 0x7b, 0xe0,
+	0x7e, 0x48, 0x62, 0x0d, 0x19, 0x70, 0x03, 0xcc, 0x58, 0xae, 0x2d, 0x93, 0x83, 0xcc, 0x8a, 0x22,
+	0x13, 0xa0, 0xe3, 0xff, 0xe3, 0x81, 0x37, 0x02, 0xfe, 0xc4, 0xc1, 0x8f, 0xaf, 0x51, 0xfa, 0x2e,
+	0x59, 0x2e, 0x3d, 0x83, 0x44, 0x8c, 0x12, 0x4b, 0xc1, 0x3a, 0x3e, 0xc0, 0x75, 0xef, 0xa8, 0x9e,
+	0xfb, 0x0d, 0xec, 0x4d, 0x7f, 0x80, 0x12, 0xfc, 0xc2, 0x61, 0xc1, 0xe7, 0x01, 0xa1, 0x92, 0xeb,
+	0x73, 0xb0, 0x4c, 0xab, 0x11, 0xcf, 0xec, 0x08, 0xeb, 0xbf, 0xe5, 0xeb, 0xef, 0x91, 0x8e, 0x07,
+	0x5c, 0xfd, 0x1b, 0xa4, 0xac, 0xc2, 0xc4, 0xe7, 0x7a, 0x03, 0xe9, 0xd5, 0x00, 0x8d, 0xbf, 0xd7,
+	0xc7, 0x07, 0x7f, 0xff, 0xb2, 0x1d, 0xfd, 0xf0, 0xd7, 0x6f, 0x7b, 0xef, 0x4c, 0xae, 0xf0, 0xe1,
+	0xbf, 0x56, 0xba, 0xdf, 0xaa, 0xbb, 0xdf, 0x47, 0x64, 0xbe, 0x1c, 0x10, 0x74, 0x93, 0xcc, 0x77,
+	0x07, 0x3a, 0x47, 0x81, 0x08, 0x05, 0xee, 0xb9, 0x77, 0x97, 0xc6, 0x1e, 0xa9, 0xba, 0xc1, 0x93,
+	0xa8, 0x2c, 0x83, 0xc4, 0x2a, 0x8d, 0x9c, 0x69, 0xe4, 0xac, 0xf4, 0x00, 0x8e, 0x4b, 0xbb, 0xe3,
+	0x86, 0x0b, 0x36, 0xc8, 0x85, 0x1d, 0xb1, 0x42, 0xa9, 0x0c, 0xc9, 0x33, 0xe3, 0x0b, 0x86, 0x48,
+	0x5b, 0xa9, 0xcc, 0x25, 0x3c, 0xeb, 0x12, 0xde, 0xfd, 0x79, 0x9a, 0x2c, 0x5c, 0x0f, 0x54, 0xfa,
+	0x26, 0x21, 0xae, 0x77, 0x32, 0xc8, 0xfb, 0xf6, 0x2c, 0xa4, 0xb2, 0x20, 0xf9, 0xf0, 0x11, 0x1a,
+	0xe8, 0x05, 0x59, 0x9d, 0x58, 0x2e, 0x6e, 0x74, 0x4c, 0xdf, 0xc1, 0xe8, 0xb8, 0x29, 0xe2, 0x46,
+	0x07, 0xae, 0xd1, 0xf1, 0x6e, 0x9a, 0xb9, 0x93, 0x35, 0x5a, 0x6e, 0xa5, 0x50, 0x98, 0x1f, 0xa7,
+	0x49, 0xe5, 0xc6, 0x56, 0xa0, 0x94, 0xcc, 0xba, 0x8d, 0x82, 0x45, 0x59, 0xe8, 0xe0, 0xf3, 0x7f,
+	0xa9, 0x1e, 0xad, 0x4f, 0x9e, 0x5f, 0xd6, 0xa2, 0x17, 0x97, 0xb5, 0xe8, 0xcf, 0xcb, 0x5a, 0xf4,
+	0xd3, 0x55, 0x6d, 0xea, 0xc5, 0x55, 0x6d, 0xea, 0xf7, 0xab, 0xda, 0xd4, 0x37, 0x6f, 0xbf, 0xfa,
+	0xc2, 0x63, 0xd8, 0xee, 0x1c, 0xfe, 0x5f, 0xf9, 0xf0, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c,
+	0x88, 0xd9, 0x36, 0xef, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -127,78 +610,1787 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaxExpirationsPerBlock != that1.MaxExpirationsPerBlock {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DomainCreationFee) > 0 {
-		for iNdEx := len(m.DomainCreationFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DomainCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if this.GracePeriod != that1.GracePeriod {
+		return false
+	}
+	if this.RedemptionPeriod != that1.RedemptionPeriod {
+		return false
+	}
+	if this.PendingDeletePeriod != that1.PendingDeletePeriod {
+		return false
+	}
+	if len(this.RestoreFee) != len(that1.RestoreFee) {
+		return false
+	}
+	for i := range this.RestoreFee {
+		if !this.RestoreFee[i].Equal(&that1.RestoreFee[i]) {
+			return false
+		}
+	}
+	if this.MaxRegistrationYears != that1.MaxRegistrationYears {
+		return false
+	}
+	if len(this.DomainRenewalFee) != len(that1.DomainRenewalFee) {
+		return false
+	}
+	for i := range this.DomainRenewalFee {
+		if !this.DomainRenewalFee[i].Equal(&that1.DomainRenewalFee[i]) {
+			return false
+		}
+	}
+	if len(this.PriceTiers) != len(that1.PriceTiers) {
+		return false
+	}
+	for i := range this.PriceTiers {
+		if !this.PriceTiers[i].Equal(&that1.PriceTiers[i]) {
+			return false
+		}
+	}
+	if len(this.PremiumNames) != len(that1.PremiumNames) {
+		return false
+	}
+	for i := range this.PremiumNames {
+		if !this.PremiumNames[i].Equal(&that1.PremiumNames[i]) {
+			return false
+		}
+	}
+	if !this.FeeSplit.Equal(&that1.FeeSplit) {
+		return false
+	}
+	if len(this.DomainTransferFee) != len(that1.DomainTransferFee) {
+		return false
+	}
+	for i := range this.DomainTransferFee {
+		if !this.DomainTransferFee[i].Equal(&that1.DomainTransferFee[i]) {
+			return false
+		}
+	}
+	if len(this.DomainUpdateFee) != len(that1.DomainUpdateFee) {
+		return false
+	}
+	for i := range this.DomainUpdateFee {
+		if !this.DomainUpdateFee[i].Equal(&that1.DomainUpdateFee[i]) {
+			return false
+		}
+	}
+	if this.MaxRecordsPerDomain != that1.MaxRecordsPerDomain {
+		return false
+	}
+	if this.CommitMinBlocks != that1.CommitMinBlocks {
+		return false
+	}
+	if this.CommitMaxBlocks != that1.CommitMaxBlocks {
+		return false
+	}
+	if len(this.CommitDeposit) != len(that1.CommitDeposit) {
+		return false
+	}
+	for i := range this.CommitDeposit {
+		if !this.CommitDeposit[i].Equal(&that1.CommitDeposit[i]) {
+			return false
+		}
+	}
+	if this.CommitForfeitBps != that1.CommitForfeitBps {
+		return false
+	}
+	if this.RequireCommitment != that1.RequireCommitment {
+		return false
+	}
+	if this.AuctionBidPeriod != that1.AuctionBidPeriod {
+		return false
+	}
+	if this.AuctionRevealPeriod != that1.AuctionRevealPeriod {
+		return false
+	}
+	if this.TldLaunchPeriod != that1.TldLaunchPeriod {
+		return false
+	}
+	if this.ReleaseStartMultiplier != that1.ReleaseStartMultiplier {
+		return false
+	}
+	if this.ReleasePeriod != that1.ReleasePeriod {
+		return false
+	}
+	if this.TransferOfferPeriod != that1.TransferOfferPeriod {
+		return false
+	}
+	if this.MarketRoyaltyBps != that1.MarketRoyaltyBps {
+		return false
+	}
+	if this.AuctionForfeitBps != that1.AuctionForfeitBps {
+		return false
+	}
+	return true
+}
+func (this *FeeSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSplit)
+	if !ok {
+		that2, ok := that.(FeeSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BurnBps != that1.BurnBps {
+		return false
+	}
+	if this.FeeCollectorBps != that1.FeeCollectorBps {
+		return false
+	}
+	if this.CommunityPoolBps != that1.CommunityPoolBps {
+		return false
+	}
+	return true
+}
+func (this *PriceTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceTier)
+	if !ok {
+		that2, ok := that.(PriceTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxLength != that1.MaxLength {
+		return false
+	}
+	if len(this.RegistrationFee) != len(that1.RegistrationFee) {
+		return false
+	}
+	for i := range this.RegistrationFee {
+		if !this.RegistrationFee[i].Equal(&that1.RegistrationFee[i]) {
+			return false
+		}
+	}
+	if len(this.RenewalFee) != len(that1.RenewalFee) {
+		return false
+	}
+	for i := range this.RenewalFee {
+		if !this.RenewalFee[i].Equal(&that1.RenewalFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PremiumName) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PremiumName)
+	if !ok {
+		that2, ok := that.(PremiumName)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.RegistrationFee) != len(that1.RegistrationFee) {
+		return false
+	}
+	for i := range this.RegistrationFee {
+		if !this.RegistrationFee[i].Equal(&that1.RegistrationFee[i]) {
+			return false
+		}
+	}
+	if len(this.RenewalFee) != len(that1.RenewalFee) {
+		return false
+	}
+	for i := range this.RenewalFee {
+		if !this.RenewalFee[i].Equal(&that1.RenewalFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionForfeitBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuctionForfeitBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MarketRoyaltyBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MarketRoyaltyBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.TransferOfferPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TransferOfferPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.ReleasePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReleasePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ReleaseStartMultiplier != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReleaseStartMultiplier))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.TldLaunchPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TldLaunchPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.AuctionRevealPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuctionRevealPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.AuctionBidPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuctionBidPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RequireCommitment {
+		i--
+		if m.RequireCommitment {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CommitForfeitBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommitForfeitBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.CommitDeposit) > 0 {
+		for iNdEx := len(m.CommitDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.CommitMaxBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommitMaxBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.CommitMinBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommitMinBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxRecordsPerDomain != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecordsPerDomain))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DomainUpdateFee) > 0 {
+		for iNdEx := len(m.DomainUpdateFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainUpdateFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DomainTransferFee) > 0 {
+		for iNdEx := len(m.DomainTransferFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainTransferFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.PremiumNames) > 0 {
+		for iNdEx := len(m.PremiumNames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PremiumNames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PriceTiers) > 0 {
+		for iNdEx := len(m.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DomainRenewalFee) > 0 {
+		for iNdEx := len(m.DomainRenewalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainRenewalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxRegistrationYears != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRegistrationYears))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RestoreFee) > 0 {
+		for iNdEx := len(m.RestoreFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestoreFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PendingDeletePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PendingDeletePeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RedemptionPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RedemptionPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxExpirationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExpirationsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DomainCreationFee) > 0 {
+		for iNdEx := len(m.DomainCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommunityPoolBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommunityPoolBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FeeCollectorBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeCollectorBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BurnBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BurnBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RenewalFee) > 0 {
+		for iNdEx := len(m.RenewalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PremiumName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PremiumName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PremiumName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RenewalFee) > 0 {
+		for iNdEx := len(m.RenewalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DomainCreationFee) > 0 {
+		for _, e := range m.DomainCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxExpirationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExpirationsPerBlock))
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovParams(uint64(m.GracePeriod))
+	}
+	if m.RedemptionPeriod != 0 {
+		n += 1 + sovParams(uint64(m.RedemptionPeriod))
+	}
+	if m.PendingDeletePeriod != 0 {
+		n += 1 + sovParams(uint64(m.PendingDeletePeriod))
+	}
+	if len(m.RestoreFee) > 0 {
+		for _, e := range m.RestoreFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxRegistrationYears != 0 {
+		n += 1 + sovParams(uint64(m.MaxRegistrationYears))
+	}
+	if len(m.DomainRenewalFee) > 0 {
+		for _, e := range m.DomainRenewalFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.PriceTiers) > 0 {
+		for _, e := range m.PriceTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.PremiumNames) > 0 {
+		for _, e := range m.PremiumNames {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.DomainTransferFee) > 0 {
+		for _, e := range m.DomainTransferFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DomainUpdateFee) > 0 {
+		for _, e := range m.DomainUpdateFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxRecordsPerDomain != 0 {
+		n += 1 + sovParams(uint64(m.MaxRecordsPerDomain))
+	}
+	if m.CommitMinBlocks != 0 {
+		n += 1 + sovParams(uint64(m.CommitMinBlocks))
+	}
+	if m.CommitMaxBlocks != 0 {
+		n += 2 + sovParams(uint64(m.CommitMaxBlocks))
+	}
+	if len(m.CommitDeposit) > 0 {
+		for _, e := range m.CommitDeposit {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CommitForfeitBps != 0 {
+		n += 2 + sovParams(uint64(m.CommitForfeitBps))
+	}
+	if m.RequireCommitment {
+		n += 3
+	}
+	if m.AuctionBidPeriod != 0 {
+		n += 2 + sovParams(uint64(m.AuctionBidPeriod))
+	}
+	if m.AuctionRevealPeriod != 0 {
+		n += 2 + sovParams(uint64(m.AuctionRevealPeriod))
+	}
+	if m.TldLaunchPeriod != 0 {
+		n += 2 + sovParams(uint64(m.TldLaunchPeriod))
+	}
+	if m.ReleaseStartMultiplier != 0 {
+		n += 2 + sovParams(uint64(m.ReleaseStartMultiplier))
+	}
+	if m.ReleasePeriod != 0 {
+		n += 2 + sovParams(uint64(m.ReleasePeriod))
+	}
+	if m.TransferOfferPeriod != 0 {
+		n += 2 + sovParams(uint64(m.TransferOfferPeriod))
+	}
+	if m.MarketRoyaltyBps != 0 {
+		n += 2 + sovParams(uint64(m.MarketRoyaltyBps))
+	}
+	if m.AuctionForfeitBps != 0 {
+		n += 2 + sovParams(uint64(m.AuctionForfeitBps))
+	}
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BurnBps != 0 {
+		n += 1 + sovParams(uint64(m.BurnBps))
+	}
+	if m.FeeCollectorBps != 0 {
+		n += 1 + sovParams(uint64(m.FeeCollectorBps))
+	}
+	if m.CommunityPoolBps != 0 {
+		n += 1 + sovParams(uint64(m.CommunityPoolBps))
+	}
+	return n
+}
+
+func (m *PriceTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxLength))
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RenewalFee) > 0 {
+		for _, e := range m.RenewalFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PremiumName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RenewalFee) > 0 {
+		for _, e := range m.RenewalFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainCreationFee = append(m.DomainCreationFee, types.Coin{})
+			if err := m.DomainCreationFee[len(m.DomainCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpirationsPerBlock", wireType)
+			}
+			m.MaxExpirationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpirationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionPeriod", wireType)
+			}
+			m.RedemptionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeletePeriod", wireType)
+			}
+			m.PendingDeletePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingDeletePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestoreFee = append(m.RestoreFee, types.Coin{})
+			if err := m.RestoreFee[len(m.RestoreFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegistrationYears", wireType)
+			}
+			m.MaxRegistrationYears = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRegistrationYears |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainRenewalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainRenewalFee = append(m.DomainRenewalFee, types.Coin{})
+			if err := m.DomainRenewalFee[len(m.DomainRenewalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceTiers = append(m.PriceTiers, PriceTier{})
+			if err := m.PriceTiers[len(m.PriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumNames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PremiumNames = append(m.PremiumNames, PremiumName{})
+			if err := m.PremiumNames[len(m.PremiumNames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainTransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainTransferFee = append(m.DomainTransferFee, types.Coin{})
+			if err := m.DomainTransferFee[len(m.DomainTransferFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainUpdateFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainUpdateFee = append(m.DomainUpdateFee, types.Coin{})
+			if err := m.DomainUpdateFee[len(m.DomainUpdateFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordsPerDomain", wireType)
+			}
+			m.MaxRecordsPerDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordsPerDomain |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMinBlocks", wireType)
+			}
+			m.CommitMinBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitMinBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMaxBlocks", wireType)
+			}
+			m.CommitMaxBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitMaxBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitDeposit = append(m.CommitDeposit, types.Coin{})
+			if err := m.CommitDeposit[len(m.CommitDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitForfeitBps", wireType)
+			}
+			m.CommitForfeitBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitForfeitBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireCommitment", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireCommitment = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionBidPeriod", wireType)
+			}
+			m.AuctionBidPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionBidPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionRevealPeriod", wireType)
+			}
+			m.AuctionRevealPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionRevealPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TldLaunchPeriod", wireType)
+			}
+			m.TldLaunchPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TldLaunchPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseStartMultiplier", wireType)
+			}
+			m.ReleaseStartMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseStartMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasePeriod", wireType)
+			}
+			m.ReleasePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleasePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferOfferPeriod", wireType)
+			}
+			m.TransferOfferPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferOfferPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketRoyaltyBps", wireType)
+			}
+			m.MarketRoyaltyBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketRoyaltyBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionForfeitBps", wireType)
+			}
+			m.AuctionForfeitBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionForfeitBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnBps", wireType)
+			}
+			m.BurnBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorBps", wireType)
+			}
+			m.FeeCollectorBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeCollectorBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolBps", wireType)
+			}
+			m.CommunityPoolBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityPoolBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DomainCreationFee) > 0 {
-		for _, e := range m.DomainCreationFee {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
+func (m *PriceTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLength", wireType)
+			}
+			m.MaxLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalFee = append(m.RenewalFee, types.Coin{})
+			if err := m.RenewalFee[len(m.RenewalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PremiumName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -221,15 +2413,47 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PremiumName: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PremiumName: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -256,8 +2480,42 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainCreationFee = append(m.DomainCreationFee, types.Coin{})
-			if err := m.DomainCreationFee[len(m.DomainCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalFee = append(m.RenewalFee, types.Coin{})
+			if err := m.RenewalFee[len(m.RenewalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultPriceTiers makes one- and two-character labels and three-character labels more expensive
+// than the base creation and renewal fees.
+func DefaultPriceTiers() []PriceTier {
+	return []PriceTier{
+		{
+			MaxLength:       2,
+			RegistrationFee: sdk.NewCoins(sdk.NewInt64Coin("udns", 500000000)), // 500 dns
+			RenewalFee:      sdk.NewCoins(sdk.NewInt64Coin("udns", 500000000)),
+		},
+		{
+			MaxLength:       3,
+			RegistrationFee: sdk.NewCoins(sdk.NewInt64Coin("udns", 100000000)), // 100 dns
+			RenewalFee:      sdk.NewCoins(sdk.NewInt64Coin("udns", 100000000)),
+		},
+	}
+}
+
+// LabelLength returns the number of characters in the leftmost label of a domain name.
+func LabelLength(name string) int {
+	label, _, _ := strings.Cut(strings.Trim(name, "."), ".")
+	return utf8.RuneCountInString(label)
+}
+
+// NamePrice returns the registration fee and per-year renewal fee of a domain name, and whether
+// the price comes from the premium list. Premium names take precedence over the label-length
+// tiers; names matching neither pay DomainCreationFee and DomainRenewalFee. Subdomains live in
+// their parent's paid namespace and are free.
+func (p Params) NamePrice(name string) (registration sdk.Coins, renewal sdk.Coins, premium bool) {
+	name = strings.ToLower(strings.Trim(name, "."))
+	if ParentName(name) != "" {
+		return sdk.NewCoins(), sdk.NewCoins(), false
+	}
+	for _, pn := range p.PremiumNames {
+		if pn.Name == name {
+			return pn.RegistrationFee, pn.RenewalFee, true
+		}
+	}
+
+	length := LabelLength(name)
+	for _, tier := range p.PriceTiers {
+		if length <= int(tier.MaxLength) {
+			return tier.RegistrationFee, tier.RenewalFee, false
+		}
+	}
+	return p.DomainCreationFee, p.DomainRenewalFee, false
+}
+
+// RenewalFee returns the fee for renewing a domain name for the given number of years.
+func (p Params) RenewalFee(name string, years uint64) sdk.Coins {
+	_, renewal, _ := p.NamePrice(name)
+	return renewal.MulInt(math.NewIntFromUint64(years))
+}
+
+// RegistrationFee returns the fee for registering a domain name for the given number of years: the
+// registration price covers the first year and each additional year costs the renewal price.
+func (p Params) RegistrationFee(name string, years uint64) sdk.Coins {
+	registration, renewal, _ := p.NamePrice(name)
+	if years <= 1 {
+		return registration
+	}
+	return registration.Add(renewal.MulInt(math.NewIntFromUint64(years - 1))...)
+}
+
+func validatePriceTiers(tiers []PriceTier) error {
+	var prev uint32
+	for i, tier := range tiers {
+		if tier.MaxLength == 0 {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "price tier %d: max length must be positive", i)
+		}
+		if tier.MaxLength <= prev {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "price tier %d: max lengths must be strictly increasing", i)
+		}
+		prev = tier.MaxLength
+		if err := validateFee("price tier registration fee", tier.RegistrationFee); err != nil {
+			return err
+		}
+		if err := validateFee("price tier renewal fee", tier.RenewalFee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePremiumNames(names []PremiumName) error {
+	seen := make(map[string]struct{}, len(names))
+	for _, pn := range names {
+		if pn.Name == "" || pn.Name != strings.ToLower(strings.Trim(pn.Name, ".")) || !strings.Contains(pn.Name, ".") {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "premium name %q must be a lowercase fully qualified name", pn.Name)
+		}
+		if _, ok := seen[pn.Name]; ok {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate premium name %q", pn.Name)
+		}
+		seen[pn.Name] = struct{}{}
+		if err := validateFee("premium name registration fee", pn.RegistrationFee); err != nil {
+			return err
+		}
+		if err := validateFee("premium name renewal fee", pn.RenewalFee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
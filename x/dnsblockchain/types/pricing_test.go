@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestParamsValidatePricing(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("udns", 1))
+
+	tests := []struct {
+		desc   string
+		modify func(p *types.Params)
+		valid  bool
+	}{
+		{desc: "default", modify: func(p *types.Params) {}, valid: true},
+		{desc: "zero max length", modify: func(p *types.Params) {
+			p.PriceTiers = []types.PriceTier{{MaxLength: 0, RegistrationFee: fee}}
+		}},
+		{desc: "unsorted tiers", modify: func(p *types.Params) {
+			p.PriceTiers = []types.PriceTier{{MaxLength: 3}, {MaxLength: 2}}
+		}},
+		{desc: "premium name", modify: func(p *types.Params) {
+			p.PremiumNames = []types.PremiumName{{Name: "bank.web3", RegistrationFee: fee, RenewalFee: fee}}
+		}, valid: true},
+		{desc: "premium name not normalized", modify: func(p *types.Params) {
+			p.PremiumNames = []types.PremiumName{{Name: "Bank.web3"}}
+		}},
+		{desc: "premium name without tld", modify: func(p *types.Params) {
+			p.PremiumNames = []types.PremiumName{{Name: "bank"}}
+		}},
+		{desc: "duplicate premium name", modify: func(p *types.Params) {
+			p.PremiumNames = []types.PremiumName{{Name: "bank.web3"}, {Name: "bank.web3"}}
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			p := types.DefaultParams()
+			tc.modify(&p)
+			err := p.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLabelLength(t *testing.T) {
+	require.Equal(t, 3, types.LabelLength("abc.web3"))
+	require.Equal(t, 2, types.LabelLength("ñü.web3"))
+	require.Equal(t, 1, types.LabelLength(".a.web3."))
+}
+
+func TestReleasePremium(t *testing.T) {
+	p := types.DefaultParams()
+	p.ReleaseStartMultiplier = 11
+	fee := sdk.NewCoins(sdk.NewInt64Coin("udns", 1000))
+	release := types.NameRelease{Name: "lapsed.web3", Start: 100, End: 200}
+
+	// Ten times the fee on top of it at the start, decaying linearly to nothing at the end.
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udns", 10000)), p.ReleasePremium(fee, release, 50))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udns", 10000)), p.ReleasePremium(fee, release, 100))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udns", 2500)), p.ReleasePremium(fee, release, 175))
+	require.True(t, p.ReleasePremium(fee, release, 200).IsZero())
+
+	p.ReleaseStartMultiplier = 1
+	require.True(t, p.ReleasePremium(fee, release, 100).IsZero())
+}
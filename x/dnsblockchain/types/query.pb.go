@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +34,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Availability is the machine-readable outcome of a name availability check.
+type Availability int32
+
+const (
+	// The name can be registered.
+	Availability_AVAILABILITY_AVAILABLE Availability = 0
+	// The name is not a valid 'label.tld' or subdomain name.
+	Availability_AVAILABILITY_INVALID_NAME Availability = 1
+	// The TLD is reserved by ICANN.
+	Availability_AVAILABILITY_TLD_RESERVED Availability = 2
+	// The TLD is not on the permitted list.
+	Availability_AVAILABILITY_TLD_NOT_PERMITTED Availability = 3
+	// The name is registered and active.
+	Availability_AVAILABILITY_REGISTERED Availability = 4
+	// The name has expired but is still held in its grace, redemption or pending-delete period.
+	Availability_AVAILABILITY_EXPIRED_HELD Availability = 5
+	// The name is a subdomain whose parent is not registered and active; only the parent's owner
+	// can register subdomains.
+	Availability_AVAILABILITY_PARENT_NOT_REGISTERED Availability = 6
+	// The name is being auctioned and goes to the auction's winner.
+	Availability_AVAILABILITY_IN_AUCTION Availability = 7
+	// The name is under a TLD in its launch phase and can only be won at auction.
+	Availability_AVAILABILITY_AUCTION_ONLY Availability = 8
+	// The name lies directly under a TLD and is otherwise available, but the chain requires such
+	// names to be registered with MsgCommitDomain and MsgRevealDomain rather than MsgCreateDomain.
+	Availability_AVAILABILITY_COMMITMENT_REQUIRED Availability = 9
+)
+
+var Availability_name = map[int32]string{
+	0: "AVAILABILITY_AVAILABLE",
+	1: "AVAILABILITY_INVALID_NAME",
+	2: "AVAILABILITY_TLD_RESERVED",
+	3: "AVAILABILITY_TLD_NOT_PERMITTED",
+	4: "AVAILABILITY_REGISTERED",
+	5: "AVAILABILITY_EXPIRED_HELD",
+	6: "AVAILABILITY_PARENT_NOT_REGISTERED",
+	7: "AVAILABILITY_IN_AUCTION",
+	8: "AVAILABILITY_AUCTION_ONLY",
+	9: "AVAILABILITY_COMMITMENT_REQUIRED",
+}
+
+var Availability_value = map[string]int32{
+	"AVAILABILITY_AVAILABLE":             0,
+	"AVAILABILITY_INVALID_NAME":          1,
+	"AVAILABILITY_TLD_RESERVED":          2,
+	"AVAILABILITY_TLD_NOT_PERMITTED":     3,
+	"AVAILABILITY_REGISTERED":            4,
+	"AVAILABILITY_EXPIRED_HELD":          5,
+	"AVAILABILITY_PARENT_NOT_REGISTERED": 6,
+	"AVAILABILITY_IN_AUCTION":            7,
+	"AVAILABILITY_AUCTION_ONLY":          8,
+	"AVAILABILITY_COMMITMENT_REQUIRED":   9,
+}
+
+func (x Availability) String() string {
+	return proto.EnumName(Availability_name, int32(x))
+}
+
+func (Availability) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -433,6 +498,8 @@ type QueryGetDomainByNameResponse struct {
 	Domain  Domain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
 	Found   bool   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
 	Expired bool   `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	// Whether the domain is the requested name itself rather than its longest registered suffix.
+	Exact bool `protobuf:"varint,4,opt,name=exact,proto3" json:"exact,omitempty"`
 }
 
 func (m *QueryGetDomainByNameResponse) Reset()         { *m = QueryGetDomainByNameResponse{} }
@@ -489,790 +556,8262 @@ func (m *QueryGetDomainByNameResponse) GetExpired() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetDomainRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryGetDomainRequest")
-	proto.RegisterType((*QueryGetDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryGetDomainResponse")
-	proto.RegisterType((*QueryAllDomainRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryAllDomainRequest")
-	proto.RegisterType((*QueryAllDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryAllDomainResponse")
-	proto.RegisterType((*QueryListPermittedTLDsRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryListPermittedTLDsRequest")
-	proto.RegisterType((*QueryListPermittedTLDsResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryListPermittedTLDsResponse")
-	proto.RegisterType((*QueryGetDomainByNameRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryGetDomainByNameRequest")
-	proto.RegisterType((*QueryGetDomainByNameResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryGetDomainByNameResponse")
+func (m *QueryGetDomainByNameResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("dnsblockchain/dnsblockchain/v1/query.proto", fileDescriptor_59b51a9b72d24737)
+// QueryListExpiringDomainsRequest defines the request for listing domains by expiration.
+type QueryListExpiringDomainsRequest struct {
+	Before     uint64             `protobuf:"varint,1,opt,name=before,proto3" json:"before,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var fileDescriptor_59b51a9b72d24737 = []byte{
-	// 669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6b, 0x13, 0x4f,
-	0x14, 0xcf, 0xa4, 0x6d, 0xfa, 0xcf, 0xfb, 0x83, 0xd2, 0xb1, 0x4a, 0x59, 0xeb, 0x56, 0x56, 0x68,
-	0x4b, 0x8b, 0x3b, 0xa6, 0xb5, 0xf5, 0xa0, 0x15, 0x0c, 0xc5, 0x22, 0x14, 0xa9, 0x8b, 0x27, 0x0f,
-	0xc6, 0x49, 0x76, 0x5c, 0x17, 0xb3, 0x3b, 0xdb, 0xec, 0x26, 0x34, 0x94, 0x5e, 0xfc, 0x04, 0x82,
-	0x7e, 0x01, 0x2f, 0x2a, 0x78, 0xd0, 0x8f, 0x51, 0xf4, 0x52, 0xf0, 0xe2, 0x49, 0x24, 0x11, 0xfc,
-	0x1a, 0xb2, 0x33, 0x93, 0xd8, 0x4d, 0x5a, 0xb3, 0x09, 0x5e, 0x96, 0x99, 0xc9, 0xfc, 0xde, 0xfb,
-	0xfd, 0xde, 0x7b, 0xbf, 0x09, 0x2c, 0xd9, 0x7e, 0x58, 0xae, 0xf2, 0xca, 0xf3, 0xca, 0x33, 0xea,
-	0xfa, 0x24, 0xb9, 0x6b, 0x14, 0xc8, 0x6e, 0x9d, 0xd5, 0x9a, 0x66, 0x50, 0xe3, 0x11, 0xc7, 0x7a,
-	0xe2, 0x57, 0x33, 0xb9, 0x6b, 0x14, 0xb4, 0x29, 0xea, 0xb9, 0x3e, 0x27, 0xe2, 0x2b, 0x21, 0xda,
-	0x52, 0x85, 0x87, 0x1e, 0x0f, 0x49, 0x99, 0x86, 0x4c, 0xc6, 0x22, 0x8d, 0x42, 0x99, 0x45, 0xb4,
-	0x40, 0x02, 0xea, 0xb8, 0x3e, 0x8d, 0x5c, 0xee, 0xab, 0xbb, 0xcb, 0x03, 0xa8, 0xd8, 0xdc, 0x8b,
-	0x13, 0xa5, 0xbb, 0x1c, 0xd0, 0x1a, 0xf5, 0x42, 0x75, 0x79, 0xda, 0xe1, 0x0e, 0x17, 0x4b, 0x12,
-	0xaf, 0xd4, 0xe9, 0xac, 0xc3, 0xb9, 0x53, 0x65, 0x84, 0x06, 0x2e, 0xa1, 0xbe, 0xcf, 0x23, 0x41,
-	0x46, 0x61, 0x8c, 0x69, 0xc0, 0x0f, 0x62, 0xbe, 0x3b, 0x22, 0x90, 0xc5, 0x76, 0xeb, 0x2c, 0x8c,
-	0x8c, 0x27, 0x70, 0x2e, 0x71, 0x1a, 0x06, 0xdc, 0x0f, 0x19, 0xbe, 0x07, 0x39, 0x99, 0x70, 0x06,
-	0x5d, 0x46, 0x8b, 0xff, 0xaf, 0xcc, 0x9b, 0x7f, 0x2f, 0x95, 0x29, 0xf1, 0xc5, 0xfc, 0xe1, 0xf7,
-	0xb9, 0xcc, 0xfb, 0x5f, 0x9f, 0x96, 0x90, 0xa5, 0x02, 0x18, 0x0b, 0x70, 0x5e, 0x64, 0xd8, 0x62,
-	0xd1, 0xa6, 0x10, 0xac, 0x52, 0xe3, 0x33, 0x90, 0x75, 0x6d, 0x11, 0x7f, 0xdc, 0xca, 0xba, 0xb6,
-	0xf1, 0x18, 0x2e, 0xf4, 0x5e, 0x54, 0x6c, 0x36, 0x21, 0x27, 0x6b, 0x95, 0x96, 0x8d, 0xc4, 0x17,
-	0xc7, 0x63, 0x36, 0x96, 0xc2, 0x1a, 0x25, 0x45, 0xe4, 0x4e, 0xb5, 0x9a, 0x24, 0x72, 0x17, 0xe0,
-	0x4f, 0xef, 0xba, 0x29, 0x64, 0xa3, 0xcd, 0xb8, 0xd1, 0xa6, 0x1c, 0x1a, 0xd5, 0x68, 0x73, 0x87,
-	0x3a, 0x4c, 0x61, 0xad, 0x63, 0x48, 0xe3, 0x1d, 0x52, 0x0a, 0x8e, 0x65, 0x38, 0x41, 0xc1, 0xd8,
-	0xa8, 0x0a, 0xf0, 0x56, 0x82, 0x68, 0x56, 0x10, 0x5d, 0x18, 0x48, 0x54, 0x52, 0x48, 0x30, 0x9d,
-	0x83, 0x4b, 0x82, 0xe8, 0xb6, 0x1b, 0x46, 0x3b, 0xac, 0xe6, 0xb9, 0x51, 0xc4, 0xec, 0x87, 0xdb,
-	0x9b, 0xdd, 0xb1, 0xb8, 0x0e, 0xfa, 0x69, 0x17, 0x94, 0x22, 0x0c, 0xe3, 0x51, 0xd5, 0x0e, 0x85,
-	0x9e, 0xbc, 0x25, 0xd6, 0x46, 0x01, 0x2e, 0x26, 0x3b, 0x58, 0x6c, 0xde, 0xa7, 0x5e, 0xa7, 0x56,
-	0x31, 0xc4, 0xa7, 0x1e, 0x13, 0x15, 0xce, 0x5b, 0x62, 0x6d, 0xbc, 0x46, 0x30, 0x7b, 0x32, 0xe6,
-	0x5f, 0xf6, 0x1e, 0x4f, 0xc3, 0xc4, 0x53, 0x5e, 0xf7, 0x6d, 0x51, 0xb4, 0xff, 0x2c, 0xb9, 0xc1,
-	0x33, 0x30, 0xc9, 0xf6, 0x02, 0xb7, 0xc6, 0xec, 0x99, 0x31, 0x71, 0xde, 0xd9, 0xae, 0xbc, 0x9d,
-	0x84, 0x09, 0x41, 0x0b, 0xbf, 0x41, 0x90, 0x93, 0xc3, 0x8d, 0x57, 0x06, 0xa5, 0xee, 0xf7, 0x97,
-	0xb6, 0x3a, 0x14, 0x46, 0x6a, 0x36, 0xcc, 0x17, 0x5f, 0x7f, 0xbe, 0xca, 0x2e, 0xe2, 0x79, 0x92,
-	0xea, 0x51, 0xc0, 0x1f, 0x11, 0xe4, 0xbb, 0xf5, 0xc3, 0x6b, 0xa9, 0x52, 0xf6, 0xda, 0x51, 0x5b,
-	0x1f, 0x16, 0xa6, 0xc8, 0xae, 0x0a, 0xb2, 0x57, 0xf1, 0x32, 0x49, 0xf5, 0xdc, 0x91, 0x7d, 0xd7,
-	0x3e, 0xc0, 0x1f, 0x10, 0x40, 0x3c, 0x5b, 0x43, 0x51, 0xee, 0x35, 0x6e, 0x4a, 0xca, 0x7d, 0x6e,
-	0x4c, 0x5f, 0x5f, 0x35, 0x3d, 0x9f, 0x11, 0x4c, 0xf5, 0x39, 0x01, 0x6f, 0xa4, 0xca, 0x7e, 0x9a,
-	0xc5, 0xb4, 0xdb, 0xa3, 0xc2, 0x95, 0x88, 0x75, 0x21, 0xe2, 0x1a, 0x36, 0x07, 0x0e, 0x49, 0x07,
-	0x5e, 0x8a, 0x4d, 0x8a, 0xbf, 0x20, 0x38, 0xdb, 0x63, 0x36, 0x7c, 0x73, 0xb8, 0xde, 0x27, 0x6c,
-	0xad, 0xdd, 0x1a, 0x0d, 0xac, 0x64, 0x6c, 0x08, 0x19, 0x37, 0xf0, 0x5a, 0xba, 0x5e, 0x94, 0xca,
-	0xcd, 0x52, 0xfc, 0x70, 0x90, 0xfd, 0xf8, 0x7b, 0x50, 0xdc, 0x38, 0x6c, 0xe9, 0xe8, 0xa8, 0xa5,
-	0xa3, 0x1f, 0x2d, 0x1d, 0xbd, 0x6c, 0xeb, 0x99, 0xa3, 0xb6, 0x9e, 0xf9, 0xd6, 0xd6, 0x33, 0x8f,
-	0xae, 0x24, 0x23, 0xec, 0xf5, 0x44, 0x8c, 0x9a, 0x01, 0x0b, 0xcb, 0x39, 0xf1, 0xdf, 0xb8, 0xfa,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x19, 0x25, 0x7d, 0x36, 0x08, 0x00, 0x00,
+func (m *QueryListExpiringDomainsRequest) Reset()         { *m = QueryListExpiringDomainsRequest{} }
+func (m *QueryListExpiringDomainsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListExpiringDomainsRequest) ProtoMessage()    {}
+func (*QueryListExpiringDomainsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{10}
+}
+func (m *QueryListExpiringDomainsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListExpiringDomainsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListExpiringDomainsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListExpiringDomainsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListExpiringDomainsRequest.Merge(m, src)
+}
+func (m *QueryListExpiringDomainsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListExpiringDomainsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListExpiringDomainsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryListExpiringDomainsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetDomain Queries a domain by its ID.
-	GetDomain(ctx context.Context, in *QueryGetDomainRequest, opts ...grpc.CallOption) (*QueryGetDomainResponse, error)
-	// ListDomain defines the ListDomain RPC.
-	ListDomain(ctx context.Context, in *QueryAllDomainRequest, opts ...grpc.CallOption) (*QueryAllDomainResponse, error)
-	// ListPermittedTLDs queries all permitted TLDs.
-	ListPermittedTLDs(ctx context.Context, in *QueryListPermittedTLDsRequest, opts ...grpc.CallOption) (*QueryListPermittedTLDsResponse, error)
-	// GetDomainByName queries a domain by its FQDN.
-	GetDomainByName(ctx context.Context, in *QueryGetDomainByNameRequest, opts ...grpc.CallOption) (*QueryGetDomainByNameResponse, error)
+func (m *QueryListExpiringDomainsRequest) GetBefore() uint64 {
+	if m != nil {
+		return m.Before
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryListExpiringDomainsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryListExpiringDomainsResponse defines the response for listing domains by expiration.
+type QueryListExpiringDomainsResponse struct {
+	Domain     []Domain            `protobuf:"bytes,1,rep,name=domain,proto3" json:"domain"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListExpiringDomainsResponse) Reset()         { *m = QueryListExpiringDomainsResponse{} }
+func (m *QueryListExpiringDomainsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListExpiringDomainsResponse) ProtoMessage()    {}
+func (*QueryListExpiringDomainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{11}
+}
+func (m *QueryListExpiringDomainsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListExpiringDomainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListExpiringDomainsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListExpiringDomainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListExpiringDomainsResponse.Merge(m, src)
+}
+func (m *QueryListExpiringDomainsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListExpiringDomainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListExpiringDomainsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetDomain(ctx context.Context, in *QueryGetDomainRequest, opts ...grpc.CallOption) (*QueryGetDomainResponse, error) {
-	out := new(QueryGetDomainResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/GetDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListExpiringDomainsResponse proto.InternalMessageInfo
+
+func (m *QueryListExpiringDomainsResponse) GetDomain() []Domain {
+	if m != nil {
+		return m.Domain
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListDomain(ctx context.Context, in *QueryAllDomainRequest, opts ...grpc.CallOption) (*QueryAllDomainResponse, error) {
-	out := new(QueryAllDomainResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListExpiringDomainsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListPermittedTLDs(ctx context.Context, in *QueryListPermittedTLDsRequest, opts ...grpc.CallOption) (*QueryListPermittedTLDsResponse, error) {
-	out := new(QueryListPermittedTLDsResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListPermittedTLDs", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryDomainPriceRequest is request type for the Query/DomainPrice RPC method.
+type QueryDomainPriceRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of years to price the registration for; 0 prices a one-year registration.
+	Years uint64 `protobuf:"varint,2,opt,name=years,proto3" json:"years,omitempty"`
+}
+
+func (m *QueryDomainPriceRequest) Reset()         { *m = QueryDomainPriceRequest{} }
+func (m *QueryDomainPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainPriceRequest) ProtoMessage()    {}
+func (*QueryDomainPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{12}
+}
+func (m *QueryDomainPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDomainPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainPriceRequest.Merge(m, src)
+}
+func (m *QueryDomainPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainPriceRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetDomainByName(ctx context.Context, in *QueryGetDomainByNameRequest, opts ...grpc.CallOption) (*QueryGetDomainByNameResponse, error) {
-	out := new(QueryGetDomainByNameResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/GetDomainByName", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDomainPriceRequest proto.InternalMessageInfo
+
+func (m *QueryDomainPriceRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetDomain Queries a domain by its ID.
-	GetDomain(context.Context, *QueryGetDomainRequest) (*QueryGetDomainResponse, error)
-	// ListDomain defines the ListDomain RPC.
-	ListDomain(context.Context, *QueryAllDomainRequest) (*QueryAllDomainResponse, error)
-	// ListPermittedTLDs queries all permitted TLDs.
-	ListPermittedTLDs(context.Context, *QueryListPermittedTLDsRequest) (*QueryListPermittedTLDsResponse, error)
-	// GetDomainByName queries a domain by its FQDN.
-	GetDomainByName(context.Context, *QueryGetDomainByNameRequest) (*QueryGetDomainByNameResponse, error)
+func (m *QueryDomainPriceRequest) GetYears() uint64 {
+	if m != nil {
+		return m.Years
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryDomainPriceResponse is response type for the Query/DomainPrice RPC method.
+type QueryDomainPriceResponse struct {
+	// Total fee to register the name for the requested years, including the release premium of a
+	// lapsed name in its release window.
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee"`
+	// Fee per year of renewal.
+	RenewalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=renewal_fee,json=renewalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewal_fee"`
+	// Whether the name is on the premium list.
+	Premium bool `protobuf:"varint,3,opt,name=premium,proto3" json:"premium,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDomainPriceResponse) Reset()         { *m = QueryDomainPriceResponse{} }
+func (m *QueryDomainPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainPriceResponse) ProtoMessage()    {}
+func (*QueryDomainPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{13}
 }
-func (*UnimplementedQueryServer) GetDomain(ctx context.Context, req *QueryGetDomainRequest) (*QueryGetDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDomain not implemented")
+func (m *QueryDomainPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListDomain(ctx context.Context, req *QueryAllDomainRequest) (*QueryAllDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDomain not implemented")
+func (m *QueryDomainPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListPermittedTLDs(ctx context.Context, req *QueryListPermittedTLDsRequest) (*QueryListPermittedTLDsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPermittedTLDs not implemented")
+func (m *QueryDomainPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainPriceResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetDomainByName(ctx context.Context, req *QueryGetDomainByNameRequest) (*QueryGetDomainByNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDomainByName not implemented")
+func (m *QueryDomainPriceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDomainPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainPriceResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/Params",
+var xxx_messageInfo_QueryDomainPriceResponse proto.InternalMessageInfo
+
+func (m *QueryDomainPriceResponse) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+func (m *QueryDomainPriceResponse) GetRenewalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewalFee
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDomainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryDomainPriceResponse) GetPremium() bool {
+	if m != nil {
+		return m.Premium
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDomain(ctx, in)
+	return false
+}
+
+// QueryListDomainsByOwnerRequest is request type for the Query/ListDomainsByOwner RPC method.
+type QueryListDomainsByOwnerRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListDomainsByOwnerRequest) Reset()         { *m = QueryListDomainsByOwnerRequest{} }
+func (m *QueryListDomainsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListDomainsByOwnerRequest) ProtoMessage()    {}
+func (*QueryListDomainsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{14}
+}
+func (m *QueryListDomainsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDomainsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDomainsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/GetDomain",
+}
+func (m *QueryListDomainsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDomainsByOwnerRequest.Merge(m, src)
+}
+func (m *QueryListDomainsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDomainsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDomainsByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListDomainsByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryListDomainsByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDomain(ctx, req.(*QueryGetDomainRequest))
+	return ""
+}
+
+func (m *QueryListDomainsByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDomainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListDomain(ctx, req.(*QueryAllDomainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryListDomainsByOwnerResponse is response type for the Query/ListDomainsByOwner RPC method.
+type QueryListDomainsByOwnerResponse struct {
+	Domain     []Domain            `protobuf:"bytes,1,rep,name=domain,proto3" json:"domain"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ListPermittedTLDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListPermittedTLDsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPermittedTLDs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListPermittedTLDs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPermittedTLDs(ctx, req.(*QueryListPermittedTLDsRequest))
+func (m *QueryListDomainsByOwnerResponse) Reset()         { *m = QueryListDomainsByOwnerResponse{} }
+func (m *QueryListDomainsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListDomainsByOwnerResponse) ProtoMessage()    {}
+func (*QueryListDomainsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{15}
+}
+func (m *QueryListDomainsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDomainsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDomainsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryListDomainsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDomainsByOwnerResponse.Merge(m, src)
+}
+func (m *QueryListDomainsByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDomainsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDomainsByOwnerResponse.DiscardUnknown(m)
 }
 
-func _Query_GetDomainByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDomainByNameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDomainByName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/GetDomainByName",
+var xxx_messageInfo_QueryListDomainsByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryListDomainsByOwnerResponse) GetDomain() []Domain {
+	if m != nil {
+		return m.Domain
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDomainByName(ctx, req.(*QueryGetDomainByNameRequest))
+	return nil
+}
+
+func (m *QueryListDomainsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dnsblockchain.dnsblockchain.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetDomain",
-			Handler:    _Query_GetDomain_Handler,
-		},
-		{
-			MethodName: "ListDomain",
-			Handler:    _Query_ListDomain_Handler,
-		},
-		{
-			MethodName: "ListPermittedTLDs",
-			Handler:    _Query_ListPermittedTLDs_Handler,
-		},
-		{
-			MethodName: "GetDomainByName",
-			Handler:    _Query_GetDomainByName_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dnsblockchain/dnsblockchain/v1/query.proto",
+// QueryListDomainsByTLDRequest is request type for the Query/ListDomainsByTLD RPC method.
+type QueryListDomainsByTLDRequest struct {
+	Tld        string             `protobuf:"bytes,1,opt,name=tld,proto3" json:"tld,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListDomainsByTLDRequest) Reset()         { *m = QueryListDomainsByTLDRequest{} }
+func (m *QueryListDomainsByTLDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListDomainsByTLDRequest) ProtoMessage()    {}
+func (*QueryListDomainsByTLDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{16}
+}
+func (m *QueryListDomainsByTLDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDomainsByTLDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDomainsByTLDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListDomainsByTLDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDomainsByTLDRequest.Merge(m, src)
+}
+func (m *QueryListDomainsByTLDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDomainsByTLDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDomainsByTLDRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryListDomainsByTLDRequest proto.InternalMessageInfo
+
+func (m *QueryListDomainsByTLDRequest) GetTld() string {
+	if m != nil {
+		return m.Tld
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListDomainsByTLDRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryListDomainsByTLDResponse is response type for the Query/ListDomainsByTLD RPC method.
+type QueryListDomainsByTLDResponse struct {
+	Domain     []Domain            `protobuf:"bytes,1,rep,name=domain,proto3" json:"domain"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryListDomainsByTLDResponse) Reset()         { *m = QueryListDomainsByTLDResponse{} }
+func (m *QueryListDomainsByTLDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListDomainsByTLDResponse) ProtoMessage()    {}
+func (*QueryListDomainsByTLDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{17}
+}
+func (m *QueryListDomainsByTLDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDomainsByTLDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDomainsByTLDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetDomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryListDomainsByTLDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDomainsByTLDResponse.Merge(m, src)
 }
-
-func (m *QueryGetDomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListDomainsByTLDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDomainsByTLDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDomainsByTLDResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryListDomainsByTLDResponse proto.InternalMessageInfo
+
+func (m *QueryListDomainsByTLDResponse) GetDomain() []Domain {
+	if m != nil {
+		return m.Domain
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListDomainsByTLDResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTLDStatsRequest is request type for the Query/TLDStats RPC method.
+type QueryTLDStatsRequest struct {
+	Tld string `protobuf:"bytes,1,opt,name=tld,proto3" json:"tld,omitempty"`
 }
 
-func (m *QueryGetDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTLDStatsRequest) Reset()         { *m = QueryTLDStatsRequest{} }
+func (m *QueryTLDStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTLDStatsRequest) ProtoMessage()    {}
+func (*QueryTLDStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{18}
+}
+func (m *QueryTLDStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTLDStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTLDStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTLDStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTLDStatsRequest.Merge(m, src)
+}
+func (m *QueryTLDStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTLDStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTLDStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllDomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTLDStatsRequest proto.InternalMessageInfo
+
+func (m *QueryTLDStatsRequest) GetTld() string {
+	if m != nil {
+		return m.Tld
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllDomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTLDStatsResponse is response type for the Query/TLDStats RPC method.
+type QueryTLDStatsResponse struct {
+	Tld string `protobuf:"bytes,1,opt,name=tld,proto3" json:"tld,omitempty"`
+	// Number of domains registered under the TLD.
+	DomainCount uint64 `protobuf:"varint,2,opt,name=domain_count,json=domainCount,proto3" json:"domain_count,omitempty"`
+	// Number of domains under the TLD expiring within the next 30 days.
+	ExpiringSoon uint64 `protobuf:"varint,3,opt,name=expiring_soon,json=expiringSoon,proto3" json:"expiring_soon,omitempty"`
+	// Sum of every domain fee charged for names under the TLD.
+	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
 }
 
-func (m *QueryAllDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTLDStatsResponse) Reset()         { *m = QueryTLDStatsResponse{} }
+func (m *QueryTLDStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTLDStatsResponse) ProtoMessage()    {}
+func (*QueryTLDStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{19}
+}
+func (m *QueryTLDStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTLDStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTLDStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTLDStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTLDStatsResponse.Merge(m, src)
 }
-
-func (m *QueryAllDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTLDStatsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAllDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		for iNdEx := len(m.Domain) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Domain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *QueryTLDStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTLDStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListPermittedTLDsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTLDStatsResponse proto.InternalMessageInfo
+
+func (m *QueryTLDStatsResponse) GetTld() string {
+	if m != nil {
+		return m.Tld
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListPermittedTLDsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTLDStatsResponse) GetDomainCount() uint64 {
+	if m != nil {
+		return m.DomainCount
+	}
+	return 0
 }
 
-func (m *QueryListPermittedTLDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryTLDStatsResponse) GetExpiringSoon() uint64 {
+	if m != nil {
+		return m.ExpiringSoon
+	}
+	return 0
 }
 
-func (m *QueryListPermittedTLDsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTLDStatsResponse) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListPermittedTLDsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckAvailabilityRequest is request type for the Query/CheckAvailability RPC method.
+type QueryCheckAvailabilityRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of years to price the registration for; 0 prices a one-year registration.
+	Years uint64 `protobuf:"varint,2,opt,name=years,proto3" json:"years,omitempty"`
 }
 
-func (m *QueryListPermittedTLDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tlds) > 0 {
-		for iNdEx := len(m.Tlds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tlds[iNdEx])
-			copy(dAtA[i:], m.Tlds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Tlds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCheckAvailabilityRequest) Reset()         { *m = QueryCheckAvailabilityRequest{} }
+func (m *QueryCheckAvailabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckAvailabilityRequest) ProtoMessage()    {}
+func (*QueryCheckAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{20}
+}
+func (m *QueryCheckAvailabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckAvailabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckAvailabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetDomainByNameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCheckAvailabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckAvailabilityRequest.Merge(m, src)
 }
-
-func (m *QueryGetDomainByNameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCheckAvailabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckAvailabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckAvailabilityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetDomainByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryCheckAvailabilityRequest proto.InternalMessageInfo
+
+func (m *QueryCheckAvailabilityRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetDomainByNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCheckAvailabilityRequest) GetYears() uint64 {
+	if m != nil {
+		return m.Years
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetDomainByNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckAvailabilityResponse is response type for the Query/CheckAvailability RPC method.
+type QueryCheckAvailabilityResponse struct {
+	// The normalized name that was checked.
+	Name      string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Available bool         `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Reason    Availability `protobuf:"varint,3,opt,name=reason,proto3,enum=dnsblockchain.dnsblockchain.v1.Availability" json:"reason,omitempty"`
+	// Human-readable explanation when the name is not available.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Fee CreateDomain would charge for the requested years; empty when the name is malformed.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
-func (m *QueryGetDomainByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Expired {
-		i--
-		if m.Expired {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Found {
-		i--
-		if m.Found {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCheckAvailabilityResponse) Reset()         { *m = QueryCheckAvailabilityResponse{} }
+func (m *QueryCheckAvailabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckAvailabilityResponse) ProtoMessage()    {}
+func (*QueryCheckAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{21}
+}
+func (m *QueryCheckAvailabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckAvailabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckAvailabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCheckAvailabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckAvailabilityResponse.Merge(m, src)
+}
+func (m *QueryCheckAvailabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckAvailabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckAvailabilityResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryCheckAvailabilityResponse proto.InternalMessageInfo
+
+func (m *QueryCheckAvailabilityResponse) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryCheckAvailabilityResponse) GetAvailable() bool {
+	if m != nil {
+		return m.Available
 	}
-	var l int
-	_ = l
-	return n
+	return false
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCheckAvailabilityResponse) GetReason() Availability {
+	if m != nil {
+		return m.Reason
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return Availability_AVAILABILITY_AVAILABLE
 }
 
-func (m *QueryGetDomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCheckAvailabilityResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	return ""
+}
+
+func (m *QueryCheckAvailabilityResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryDomainRecordsRequest is request type for the Query/DomainRecords RPC method.
+type QueryDomainRecordsRequest struct {
+	// Fully qualified name, e.g. "www.example.web3".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Only return records of this type; unspecified returns every type.
+	Type RecordType `protobuf:"varint,2,opt,name=type,proto3,enum=dnsblockchain.dnsblockchain.v1.RecordType" json:"type,omitempty"`
+}
+
+func (m *QueryDomainRecordsRequest) Reset()         { *m = QueryDomainRecordsRequest{} }
+func (m *QueryDomainRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainRecordsRequest) ProtoMessage()    {}
+func (*QueryDomainRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{22}
+}
+func (m *QueryDomainRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Domain.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryDomainRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainRecordsRequest.Merge(m, src)
+}
+func (m *QueryDomainRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllDomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryDomainRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryDomainRecordsRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryDomainRecordsRequest) GetType() RecordType {
+	if m != nil {
+		return m.Type
 	}
-	return n
+	return RecordType_RECORD_TYPE_UNSPECIFIED
 }
 
-func (m *QueryAllDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryDomainRecordsResponse is response type for the Query/DomainRecords RPC method.
+type QueryDomainRecordsResponse struct {
+	// Whether a registered domain covers the name.
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// Name of the registered domain that covers the name, e.g. "example.web3".
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Records published for the name itself.
+	Records []ResourceRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
+	// NS delegation of the covering domain; when set, resolvers should follow it instead.
+	NsRecords []*NSRecordWithIP `protobuf:"bytes,4,rep,name=ns_records,json=nsRecords,proto3" json:"ns_records,omitempty"`
+	// Whether the covering domain is active, expired or still held.
+	Status DomainStatus `protobuf:"varint,5,opt,name=status,proto3,enum=dnsblockchain.dnsblockchain.v1.DomainStatus" json:"status,omitempty"`
+	// DNSSEC delegation signer records of the covering domain's delegation.
+	DsRecords []*DSRecord `protobuf:"bytes,6,rep,name=ds_records,json=dsRecords,proto3" json:"ds_records,omitempty"`
+}
+
+func (m *QueryDomainRecordsResponse) Reset()         { *m = QueryDomainRecordsResponse{} }
+func (m *QueryDomainRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainRecordsResponse) ProtoMessage()    {}
+func (*QueryDomainRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{23}
+}
+func (m *QueryDomainRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Domain) > 0 {
-		for _, e := range m.Domain {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDomainRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainRecordsResponse.Merge(m, src)
+}
+func (m *QueryDomainRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDomainRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryDomainRecordsResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *QueryDomainRecordsResponse) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *QueryDomainRecordsResponse) GetRecords() []ResourceRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryDomainRecordsResponse) GetNsRecords() []*NSRecordWithIP {
+	if m != nil {
+		return m.NsRecords
+	}
+	return nil
+}
+
+func (m *QueryDomainRecordsResponse) GetStatus() DomainStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DomainStatus_DOMAIN_STATUS_ACTIVE
+}
+
+func (m *QueryDomainRecordsResponse) GetDsRecords() []*DSRecord {
+	if m != nil {
+		return m.DsRecords
+	}
+	return nil
+}
+
+// QueryExportZoneRequest is request type for the Query/ExportZone RPC method.
+type QueryExportZoneRequest struct {
+	Tld string `protobuf:"bytes,1,opt,name=tld,proto3" json:"tld,omitempty"`
+	// Host name published as the zone's primary name server; empty uses "ns1.dnsblockchain.".
+	Nameserver string `protobuf:"bytes,2,opt,name=nameserver,proto3" json:"nameserver,omitempty"`
+	// SOA responsible mailbox in domain form; empty uses "hostmaster.<tld>.".
+	Hostmaster string             `protobuf:"bytes,3,opt,name=hostmaster,proto3" json:"hostmaster,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExportZoneRequest) Reset()         { *m = QueryExportZoneRequest{} }
+func (m *QueryExportZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExportZoneRequest) ProtoMessage()    {}
+func (*QueryExportZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{24}
+}
+func (m *QueryExportZoneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExportZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportZoneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryExportZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportZoneRequest.Merge(m, src)
+}
+func (m *QueryExportZoneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExportZoneRequest proto.InternalMessageInfo
+
+func (m *QueryExportZoneRequest) GetTld() string {
+	if m != nil {
+		return m.Tld
 	}
-	return n
+	return ""
 }
 
-func (m *QueryListPermittedTLDsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExportZoneRequest) GetNameserver() string {
+	if m != nil {
+		return m.Nameserver
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryListPermittedTLDsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExportZoneRequest) GetHostmaster() string {
+	if m != nil {
+		return m.Hostmaster
 	}
-	var l int
-	_ = l
-	if len(m.Tlds) > 0 {
-		for _, s := range m.Tlds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryExportZoneRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExportZoneResponse is response type for the Query/ExportZone RPC method.
+type QueryExportZoneResponse struct {
+	// The zone in RFC 1035 master file format.
+	Zone string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	// SOA serial of the zone, the block height it was exported at.
+	Serial uint32 `protobuf:"varint,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	// Number of domains included in this page of the zone.
+	DomainCount uint64              `protobuf:"varint,3,opt,name=domain_count,json=domainCount,proto3" json:"domain_count,omitempty"`
+	Pagination  *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExportZoneResponse) Reset()         { *m = QueryExportZoneResponse{} }
+func (m *QueryExportZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExportZoneResponse) ProtoMessage()    {}
+func (*QueryExportZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{25}
+}
+func (m *QueryExportZoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExportZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportZoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExportZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportZoneResponse.Merge(m, src)
+}
+func (m *QueryExportZoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExportZoneResponse proto.InternalMessageInfo
+
+func (m *QueryExportZoneResponse) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *QueryExportZoneResponse) GetSerial() uint32 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *QueryExportZoneResponse) GetDomainCount() uint64 {
+	if m != nil {
+		return m.DomainCount
+	}
+	return 0
+}
+
+func (m *QueryExportZoneResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuctionRequest is request type for the Query/Auction RPC method.
+type QueryAuctionRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryAuctionRequest) Reset()         { *m = QueryAuctionRequest{} }
+func (m *QueryAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRequest) ProtoMessage()    {}
+func (*QueryAuctionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{26}
+}
+func (m *QueryAuctionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRequest.Merge(m, src)
+}
+func (m *QueryAuctionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryAuctionResponse is response type for the Query/Auction RPC method.
+type QueryAuctionResponse struct {
+	Auction Auction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction"`
+	// The bids placed so far; amounts are only set once revealed.
+	Bids []AuctionBid `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+}
+
+func (m *QueryAuctionResponse) Reset()         { *m = QueryAuctionResponse{} }
+func (m *QueryAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionResponse) ProtoMessage()    {}
+func (*QueryAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{27}
+}
+func (m *QueryAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionResponse.Merge(m, src)
+}
+func (m *QueryAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionResponse) GetAuction() Auction {
+	if m != nil {
+		return m.Auction
+	}
+	return Auction{}
+}
+
+func (m *QueryAuctionResponse) GetBids() []AuctionBid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+// QueryListAuctionsRequest is request type for the Query/ListAuctions RPC method.
+type QueryListAuctionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListAuctionsRequest) Reset()         { *m = QueryListAuctionsRequest{} }
+func (m *QueryListAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListAuctionsRequest) ProtoMessage()    {}
+func (*QueryListAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{28}
+}
+func (m *QueryListAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListAuctionsRequest.Merge(m, src)
+}
+func (m *QueryListAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryListAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListAuctionsResponse is response type for the Query/ListAuctions RPC method.
+type QueryListAuctionsResponse struct {
+	Auctions   []Auction           `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListAuctionsResponse) Reset()         { *m = QueryListAuctionsResponse{} }
+func (m *QueryListAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListAuctionsResponse) ProtoMessage()    {}
+func (*QueryListAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{29}
+}
+func (m *QueryListAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListAuctionsResponse.Merge(m, src)
+}
+func (m *QueryListAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryListAuctionsResponse) GetAuctions() []Auction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *QueryListAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListReleasesRequest is request type for the Query/ListReleases RPC method.
+type QueryListReleasesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListReleasesRequest) Reset()         { *m = QueryListReleasesRequest{} }
+func (m *QueryListReleasesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListReleasesRequest) ProtoMessage()    {}
+func (*QueryListReleasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{30}
+}
+func (m *QueryListReleasesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListReleasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListReleasesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListReleasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListReleasesRequest.Merge(m, src)
+}
+func (m *QueryListReleasesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListReleasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListReleasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListReleasesRequest proto.InternalMessageInfo
+
+func (m *QueryListReleasesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ReleasedName is a name in its release window with the one-year registration fee it costs at the
+// current block time.
+type ReleasedName struct {
+	Release NameRelease                              `protobuf:"bytes,1,opt,name=release,proto3" json:"release"`
+	Price   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+}
+
+func (m *ReleasedName) Reset()         { *m = ReleasedName{} }
+func (m *ReleasedName) String() string { return proto.CompactTextString(m) }
+func (*ReleasedName) ProtoMessage()    {}
+func (*ReleasedName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{31}
+}
+func (m *ReleasedName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleasedName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleasedName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleasedName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasedName.Merge(m, src)
+}
+func (m *ReleasedName) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleasedName) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasedName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleasedName proto.InternalMessageInfo
+
+func (m *ReleasedName) GetRelease() NameRelease {
+	if m != nil {
+		return m.Release
+	}
+	return NameRelease{}
+}
+
+func (m *ReleasedName) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+// QueryListReleasesResponse is response type for the Query/ListReleases RPC method.
+type QueryListReleasesResponse struct {
+	Releases   []ReleasedName      `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListReleasesResponse) Reset()         { *m = QueryListReleasesResponse{} }
+func (m *QueryListReleasesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListReleasesResponse) ProtoMessage()    {}
+func (*QueryListReleasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{32}
+}
+func (m *QueryListReleasesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListReleasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListReleasesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListReleasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListReleasesResponse.Merge(m, src)
+}
+func (m *QueryListReleasesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListReleasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListReleasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListReleasesResponse proto.InternalMessageInfo
+
+func (m *QueryListReleasesResponse) GetReleases() []ReleasedName {
+	if m != nil {
+		return m.Releases
+	}
+	return nil
+}
+
+func (m *QueryListReleasesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingTransferOffersRequest is request type for the Query/PendingTransferOffers RPC method.
+type QueryPendingTransferOffersRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTransferOffersRequest) Reset()         { *m = QueryPendingTransferOffersRequest{} }
+func (m *QueryPendingTransferOffersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTransferOffersRequest) ProtoMessage()    {}
+func (*QueryPendingTransferOffersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{33}
+}
+func (m *QueryPendingTransferOffersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTransferOffersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTransferOffersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTransferOffersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTransferOffersRequest.Merge(m, src)
+}
+func (m *QueryPendingTransferOffersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTransferOffersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTransferOffersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTransferOffersRequest proto.InternalMessageInfo
+
+func (m *QueryPendingTransferOffersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPendingTransferOffersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingTransferOffersResponse is response type for the Query/PendingTransferOffers RPC method.
+type QueryPendingTransferOffersResponse struct {
+	Offers     []TransferOffer     `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTransferOffersResponse) Reset()         { *m = QueryPendingTransferOffersResponse{} }
+func (m *QueryPendingTransferOffersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTransferOffersResponse) ProtoMessage()    {}
+func (*QueryPendingTransferOffersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{34}
+}
+func (m *QueryPendingTransferOffersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTransferOffersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTransferOffersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTransferOffersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTransferOffersResponse.Merge(m, src)
+}
+func (m *QueryPendingTransferOffersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTransferOffersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTransferOffersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTransferOffersResponse proto.InternalMessageInfo
+
+func (m *QueryPendingTransferOffersResponse) GetOffers() []TransferOffer {
+	if m != nil {
+		return m.Offers
+	}
+	return nil
+}
+
+func (m *QueryPendingTransferOffersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListListingsRequest is request type for the Query/ListListings RPC method.
+type QueryListListingsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListListingsRequest) Reset()         { *m = QueryListListingsRequest{} }
+func (m *QueryListListingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListListingsRequest) ProtoMessage()    {}
+func (*QueryListListingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{35}
+}
+func (m *QueryListListingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListListingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListListingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListListingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListListingsRequest.Merge(m, src)
+}
+func (m *QueryListListingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListListingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListListingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListListingsRequest proto.InternalMessageInfo
+
+func (m *QueryListListingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListListingsResponse is response type for the Query/ListListings RPC method.
+type QueryListListingsResponse struct {
+	Listings   []Listing           `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListListingsResponse) Reset()         { *m = QueryListListingsResponse{} }
+func (m *QueryListListingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListListingsResponse) ProtoMessage()    {}
+func (*QueryListListingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{36}
+}
+func (m *QueryListListingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListListingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListListingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListListingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListListingsResponse.Merge(m, src)
+}
+func (m *QueryListListingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListListingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListListingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListListingsResponse proto.InternalMessageInfo
+
+func (m *QueryListListingsResponse) GetListings() []Listing {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+func (m *QueryListListingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMarketOffersRequest is request type for the Query/MarketOffers RPC method.
+type QueryMarketOffersRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMarketOffersRequest) Reset()         { *m = QueryMarketOffersRequest{} }
+func (m *QueryMarketOffersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketOffersRequest) ProtoMessage()    {}
+func (*QueryMarketOffersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{37}
+}
+func (m *QueryMarketOffersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketOffersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketOffersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketOffersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketOffersRequest.Merge(m, src)
+}
+func (m *QueryMarketOffersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketOffersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketOffersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketOffersRequest proto.InternalMessageInfo
+
+func (m *QueryMarketOffersRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryMarketOffersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMarketOffersResponse is response type for the Query/MarketOffers RPC method.
+type QueryMarketOffersResponse struct {
+	Offers     []MarketOffer       `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMarketOffersResponse) Reset()         { *m = QueryMarketOffersResponse{} }
+func (m *QueryMarketOffersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketOffersResponse) ProtoMessage()    {}
+func (*QueryMarketOffersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b51a9b72d24737, []int{38}
+}
+func (m *QueryMarketOffersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketOffersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketOffersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketOffersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketOffersResponse.Merge(m, src)
+}
+func (m *QueryMarketOffersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketOffersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketOffersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketOffersResponse proto.InternalMessageInfo
+
+func (m *QueryMarketOffersResponse) GetOffers() []MarketOffer {
+	if m != nil {
+		return m.Offers
+	}
+	return nil
+}
+
+func (m *QueryMarketOffersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("dnsblockchain.dnsblockchain.v1.Availability", Availability_name, Availability_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetDomainRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryGetDomainRequest")
+	proto.RegisterType((*QueryGetDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryGetDomainResponse")
+	proto.RegisterType((*QueryAllDomainRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryAllDomainRequest")
+	proto.RegisterType((*QueryAllDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryAllDomainResponse")
+	proto.RegisterType((*QueryListPermittedTLDsRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryListPermittedTLDsRequest")
+	proto.RegisterType((*QueryListPermittedTLDsResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryListPermittedTLDsResponse")
+	proto.RegisterType((*QueryGetDomainByNameRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryGetDomainByNameRequest")
+	proto.RegisterType((*QueryGetDomainByNameResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryGetDomainByNameResponse")
+	proto.RegisterType((*QueryListExpiringDomainsRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryListExpiringDomainsRequest")
+	proto.RegisterType((*QueryListExpiringDomainsResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryListExpiringDomainsResponse")
+	proto.RegisterType((*QueryDomainPriceRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryDomainPriceRequest")
+	proto.RegisterType((*QueryDomainPriceResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryDomainPriceResponse")
+	proto.RegisterType((*QueryListDomainsByOwnerRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryListDomainsByOwnerRequest")
+	proto.RegisterType((*QueryListDomainsByOwnerResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryListDomainsByOwnerResponse")
+	proto.RegisterType((*QueryListDomainsByTLDRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryListDomainsByTLDRequest")
+	proto.RegisterType((*QueryListDomainsByTLDResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryListDomainsByTLDResponse")
+	proto.RegisterType((*QueryTLDStatsRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryTLDStatsRequest")
+	proto.RegisterType((*QueryTLDStatsResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryTLDStatsResponse")
+	proto.RegisterType((*QueryCheckAvailabilityRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryCheckAvailabilityRequest")
+	proto.RegisterType((*QueryCheckAvailabilityResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryCheckAvailabilityResponse")
+	proto.RegisterType((*QueryDomainRecordsRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryDomainRecordsRequest")
+	proto.RegisterType((*QueryDomainRecordsResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryDomainRecordsResponse")
+	proto.RegisterType((*QueryExportZoneRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryExportZoneRequest")
+	proto.RegisterType((*QueryExportZoneResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryExportZoneResponse")
+	proto.RegisterType((*QueryAuctionRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryAuctionRequest")
+	proto.RegisterType((*QueryAuctionResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryAuctionResponse")
+	proto.RegisterType((*QueryListAuctionsRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryListAuctionsRequest")
+	proto.RegisterType((*QueryListAuctionsResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryListAuctionsResponse")
+	proto.RegisterType((*QueryListReleasesRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryListReleasesRequest")
+	proto.RegisterType((*ReleasedName)(nil), "dnsblockchain.dnsblockchain.v1.ReleasedName")
+	proto.RegisterType((*QueryListReleasesResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryListReleasesResponse")
+	proto.RegisterType((*QueryPendingTransferOffersRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryPendingTransferOffersRequest")
+	proto.RegisterType((*QueryPendingTransferOffersResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryPendingTransferOffersResponse")
+	proto.RegisterType((*QueryListListingsRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryListListingsRequest")
+	proto.RegisterType((*QueryListListingsResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryListListingsResponse")
+	proto.RegisterType((*QueryMarketOffersRequest)(nil), "dnsblockchain.dnsblockchain.v1.QueryMarketOffersRequest")
+	proto.RegisterType((*QueryMarketOffersResponse)(nil), "dnsblockchain.dnsblockchain.v1.QueryMarketOffersResponse")
+}
+
+func init() {
+	proto.RegisterFile("dnsblockchain/dnsblockchain/v1/query.proto", fileDescriptor_59b51a9b72d24737)
+}
+
+var fileDescriptor_59b51a9b72d24737 = []byte{
+	// 2292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0x52, 0x94, 0x64, 0x3e, 0xcb, 0x0e, 0x3d, 0x71, 0x6c, 0x9a, 0x71, 0x68, 0x67, 0xf3,
+	0x85, 0xad, 0xaf, 0x6c, 0x73, 0x2d, 0x59, 0xfe, 0x6d, 0x27, 0xa1, 0x44, 0x5a, 0x25, 0x42, 0x51,
+	0xca, 0x8a, 0x71, 0x9b, 0x00, 0x2d, 0xbb, 0xe4, 0x8e, 0xa8, 0xad, 0xc9, 0x5d, 0x66, 0x67, 0xa5,
+	0x48, 0x11, 0x74, 0x68, 0xff, 0x82, 0x16, 0xbd, 0x14, 0xbd, 0x14, 0x3d, 0x14, 0x2d, 0xd2, 0xa2,
+	0x71, 0x8a, 0xf4, 0x52, 0xa0, 0xed, 0xa9, 0x40, 0xd0, 0xa2, 0x40, 0x90, 0xa2, 0x68, 0x81, 0xa2,
+	0xbf, 0xec, 0x02, 0xbd, 0xf7, 0xd4, 0x63, 0x31, 0xb3, 0x6f, 0xc9, 0x5d, 0x92, 0x12, 0x97, 0x0c,
+	0x0d, 0xf8, 0x60, 0x8b, 0xb3, 0x3b, 0xef, 0xbd, 0xcf, 0xfb, 0x31, 0x6f, 0x66, 0x3e, 0x24, 0xcc,
+	0xe8, 0x26, 0xab, 0xd4, 0xad, 0xea, 0xc3, 0xea, 0x86, 0x66, 0x98, 0x4a, 0x70, 0xb4, 0x35, 0xab,
+	0xbc, 0xbb, 0x49, 0xed, 0x9d, 0x74, 0xd3, 0xb6, 0x1c, 0x8b, 0xa4, 0x02, 0x6f, 0xd3, 0xc1, 0xd1,
+	0xd6, 0x6c, 0xf2, 0xb8, 0xd6, 0x30, 0x4c, 0x4b, 0x11, 0xff, 0xbb, 0x22, 0xc9, 0x99, 0xaa, 0xc5,
+	0x1a, 0x16, 0x53, 0x2a, 0x1a, 0xa3, 0xae, 0x2e, 0x65, 0x6b, 0xb6, 0x42, 0x1d, 0x6d, 0x56, 0x69,
+	0x6a, 0x35, 0xc3, 0xd4, 0x1c, 0xc3, 0x32, 0x71, 0x6e, 0xca, 0x3f, 0xd7, 0x9b, 0x55, 0xb5, 0x0c,
+	0xef, 0xfd, 0x69, 0xf7, 0x7d, 0x59, 0x8c, 0x14, 0x77, 0x80, 0xaf, 0x2e, 0xf5, 0xf1, 0x42, 0xdb,
+	0xac, 0xfa, 0x0c, 0x5d, 0xec, 0x33, 0x5b, 0xb7, 0x1a, 0x9a, 0x11, 0x76, 0x72, 0x43, 0xb3, 0x1f,
+	0x52, 0x27, 0xe4, 0xe4, 0xa6, 0x66, 0x6b, 0x0d, 0x0f, 0xf4, 0xe5, 0x3e, 0x93, 0x1d, 0x5b, 0x33,
+	0xd9, 0x3a, 0xb5, 0x71, 0xfa, 0x89, 0x9a, 0x55, 0xb3, 0x5c, 0xdf, 0xf9, 0x27, 0x7c, 0x7a, 0xa6,
+	0x66, 0x59, 0xb5, 0x3a, 0x55, 0xb4, 0xa6, 0xa1, 0x68, 0xa6, 0x69, 0x39, 0x22, 0xa2, 0x68, 0x42,
+	0x3e, 0x01, 0xe4, 0x4d, 0x1e, 0xf4, 0x55, 0x61, 0x57, 0xa5, 0xef, 0x6e, 0x52, 0xe6, 0xc8, 0x5f,
+	0x85, 0xe7, 0x03, 0x4f, 0x59, 0xd3, 0x32, 0x19, 0x25, 0x79, 0x98, 0x70, 0xf1, 0x25, 0xa4, 0x73,
+	0xd2, 0xf4, 0x91, 0xb9, 0xf3, 0xe9, 0x83, 0xf3, 0x9d, 0x76, 0xe5, 0x17, 0x62, 0x9f, 0xfc, 0xed,
+	0xec, 0xa1, 0x1f, 0xfd, 0xfb, 0xd1, 0x8c, 0xa4, 0xa2, 0x02, 0xf9, 0x02, 0xbc, 0x20, 0x2c, 0x2c,
+	0x51, 0x27, 0x2b, 0x82, 0x89, 0xa6, 0xc9, 0x31, 0x88, 0x18, 0xba, 0xd0, 0x1f, 0x55, 0x23, 0x86,
+	0x2e, 0x7f, 0x05, 0x4e, 0x76, 0x4e, 0x44, 0x34, 0x59, 0x98, 0x70, 0xf3, 0x10, 0x16, 0x8d, 0x2b,
+	0xbf, 0x10, 0xe5, 0x68, 0x54, 0x94, 0x95, 0xcb, 0x08, 0x24, 0x53, 0xaf, 0x07, 0x81, 0xdc, 0x07,
+	0x68, 0x17, 0x60, 0xcb, 0x04, 0x16, 0x15, 0xaf, 0xc0, 0xb4, 0x5b, 0xf9, 0x58, 0x87, 0xe9, 0x55,
+	0xad, 0x46, 0x51, 0x56, 0xf5, 0x49, 0xca, 0x3f, 0x94, 0xd0, 0x03, 0x9f, 0x85, 0x1e, 0x1e, 0x8c,
+	0x0d, 0xeb, 0x01, 0x59, 0x0a, 0x00, 0x8d, 0x08, 0xa0, 0x17, 0xfa, 0x02, 0x75, 0x21, 0x04, 0x90,
+	0x9e, 0x85, 0x97, 0x04, 0xd0, 0x82, 0xc1, 0x9c, 0x55, 0x6a, 0x37, 0x0c, 0xc7, 0xa1, 0x7a, 0xa9,
+	0x90, 0x6d, 0x95, 0xc5, 0x3c, 0xa4, 0xf6, 0x9b, 0x80, 0x1e, 0x11, 0x88, 0x3a, 0x75, 0x9d, 0x09,
+	0x7f, 0x62, 0xaa, 0xf8, 0x2c, 0xcf, 0xc2, 0x8b, 0xc1, 0x0c, 0x2e, 0xec, 0x14, 0xb5, 0x86, 0x17,
+	0x2b, 0x2e, 0x62, 0x6a, 0x0d, 0x2a, 0x22, 0x1c, 0x53, 0xc5, 0x67, 0xf9, 0x03, 0x09, 0xce, 0xf4,
+	0x96, 0x19, 0x65, 0xee, 0xc9, 0x09, 0x18, 0x5f, 0xb7, 0x36, 0x4d, 0x5d, 0x04, 0xed, 0xb0, 0xea,
+	0x0e, 0x48, 0x02, 0x26, 0xe9, 0x76, 0xd3, 0xb0, 0xa9, 0x9e, 0x18, 0x13, 0xcf, 0xbd, 0x21, 0x9f,
+	0x4f, 0xb7, 0xb5, 0xaa, 0x93, 0x88, 0xba, 0xf3, 0xc5, 0x40, 0xfe, 0xba, 0x04, 0x67, 0x5b, 0x61,
+	0xc9, 0xf1, 0xa9, 0x86, 0x59, 0x73, 0xed, 0x79, 0x91, 0x23, 0x27, 0x61, 0xa2, 0x42, 0xd7, 0x2d,
+	0x9b, 0x62, 0x65, 0xe3, 0xa8, 0xa3, 0xc8, 0x22, 0x43, 0x17, 0xd9, 0x47, 0x12, 0x9c, 0xdb, 0x1f,
+	0xc3, 0xb3, 0x59, 0x6e, 0x8b, 0x70, 0x4a, 0x40, 0x76, 0xad, 0xac, 0xda, 0x46, 0xf5, 0xa0, 0x9a,
+	0xe0, 0xc1, 0xdf, 0xa1, 0x9a, 0xcd, 0x84, 0xc9, 0xa8, 0xea, 0x0e, 0xe4, 0xef, 0x46, 0x20, 0xd1,
+	0xad, 0x05, 0x1d, 0xde, 0x82, 0xb8, 0x4d, 0x6b, 0x06, 0x73, 0x6c, 0x61, 0xb1, 0xbc, 0x4e, 0x29,
+	0xba, 0x7e, 0x3a, 0x00, 0xd8, 0x83, 0xba, 0x68, 0x19, 0xe6, 0xc2, 0x15, 0xee, 0xed, 0x07, 0x7f,
+	0x3f, 0x3b, 0x5d, 0x33, 0x9c, 0x8d, 0xcd, 0x4a, 0xba, 0x6a, 0x35, 0x70, 0x2b, 0xc1, 0x3f, 0x97,
+	0x99, 0xfe, 0x50, 0x71, 0x76, 0x9a, 0x94, 0x09, 0x01, 0xa6, 0x3e, 0xe7, 0x37, 0x72, 0x9f, 0x52,
+	0x52, 0x87, 0x23, 0x36, 0x35, 0xe9, 0x7b, 0x5a, 0x5d, 0x98, 0x8c, 0x8c, 0xde, 0x24, 0xa0, 0x7e,
+	0x6e, 0x2d, 0x01, 0x93, 0x4d, 0x9b, 0x36, 0x8c, 0xcd, 0x86, 0x57, 0xaf, 0x38, 0x94, 0xbf, 0x23,
+	0xf9, 0x16, 0x2c, 0x56, 0xc3, 0xc2, 0xce, 0xca, 0x7b, 0x26, 0xb5, 0xbd, 0x48, 0xa7, 0x61, 0xdc,
+	0xe2, 0x63, 0x37, 0xd4, 0x0b, 0x89, 0xcf, 0x3e, 0xbe, 0x7c, 0x02, 0x71, 0x66, 0x74, 0xdd, 0xa6,
+	0x8c, 0xad, 0x39, 0xbc, 0x96, 0x54, 0x77, 0xda, 0xc8, 0x0a, 0xf6, 0x91, 0x7f, 0xd1, 0x74, 0x42,
+	0x7b, 0x36, 0xeb, 0x75, 0x1b, 0x7b, 0x52, 0x00, 0x71, 0xa9, 0x90, 0xf5, 0x42, 0x19, 0x87, 0x31,
+	0xa7, 0xae, 0x63, 0xcd, 0xf2, 0x8f, 0x23, 0x0b, 0xd6, 0x4f, 0x25, 0x5f, 0x67, 0x0e, 0x9a, 0x7e,
+	0x36, 0x43, 0x35, 0x0d, 0x27, 0x04, 0xde, 0x52, 0x21, 0xbb, 0xe6, 0x68, 0x0e, 0xdb, 0x37, 0x44,
+	0xf2, 0x5f, 0x25, 0xdc, 0x7f, 0xdb, 0x53, 0xd1, 0xa5, 0xee, 0x70, 0xbe, 0x0c, 0x53, 0x2e, 0xd0,
+	0x72, 0xd5, 0xda, 0x34, 0x1d, 0x6c, 0x04, 0x47, 0xdc, 0x67, 0x8b, 0xfc, 0x11, 0x79, 0x05, 0x8e,
+	0x52, 0xec, 0x7e, 0x65, 0x66, 0x59, 0xa6, 0x58, 0x11, 0x51, 0x75, 0xca, 0x7b, 0xb8, 0x66, 0x59,
+	0x26, 0xf9, 0x1a, 0x80, 0x63, 0x39, 0xee, 0xe2, 0x64, 0x89, 0xe8, 0xe8, 0x57, 0x67, 0x4c, 0xa8,
+	0xbf, 0x4f, 0x29, 0x93, 0xf3, 0x98, 0xb9, 0xc5, 0x0d, 0x5a, 0x7d, 0x98, 0xd9, 0xd2, 0x8c, 0xba,
+	0x56, 0x31, 0xea, 0x86, 0xb3, 0x33, 0x78, 0xab, 0xfb, 0x56, 0x04, 0x57, 0x73, 0x0f, 0x5d, 0xed,
+	0xed, 0xb7, 0x4b, 0xd9, 0x19, 0x88, 0x69, 0xee, 0xdc, 0x3a, 0xc5, 0x8d, 0xae, 0xfd, 0x80, 0x17,
+	0x8e, 0x4d, 0x35, 0x86, 0x91, 0x3a, 0x36, 0x77, 0xa9, 0x5f, 0xe1, 0x04, 0xec, 0xa2, 0x2c, 0x6f,
+	0x41, 0x0d, 0xca, 0x98, 0x56, 0xa3, 0x62, 0x6b, 0x8c, 0xa9, 0xde, 0x90, 0x7c, 0x19, 0xc6, 0x78,
+	0x0b, 0x1c, 0x1f, 0x7d, 0x90, 0xb9, 0x5e, 0xd9, 0x82, 0xd3, 0xbe, 0xee, 0xaf, 0xd2, 0xaa, 0x65,
+	0xeb, 0xec, 0xa0, 0xd0, 0xbe, 0x0a, 0x51, 0xae, 0x44, 0x04, 0xe2, 0xd8, 0xdc, 0x4c, 0x3f, 0x6f,
+	0x5d, 0x8d, 0xa5, 0x9d, 0x26, 0x55, 0x85, 0x9c, 0xfc, 0xdf, 0x08, 0x24, 0x7b, 0x59, 0xc4, 0x04,
+	0xb4, 0x4e, 0x14, 0x92, 0xff, 0x44, 0x71, 0xb2, 0xb5, 0x3a, 0x23, 0x02, 0x8a, 0xb7, 0xde, 0x8a,
+	0x30, 0x69, 0xbb, 0x0a, 0x12, 0x63, 0x22, 0x40, 0xe9, 0xfe, 0x78, 0x98, 0xb5, 0x69, 0xf3, 0x2d,
+	0x8e, 0x8b, 0xe1, 0xf2, 0xf5, 0x94, 0x90, 0x65, 0x00, 0x93, 0x95, 0x3d, 0x95, 0xd1, 0x70, 0x2a,
+	0x8b, 0x6b, 0xae, 0xb2, 0x2f, 0x1a, 0xce, 0x46, 0x7e, 0x55, 0x8d, 0xf1, 0x03, 0x83, 0xab, 0x2e,
+	0x0b, 0x13, 0xcc, 0xd1, 0x9c, 0x4d, 0x96, 0x18, 0x0f, 0x57, 0x1b, 0x6e, 0x4c, 0xd6, 0x84, 0x8c,
+	0x8a, 0xb2, 0xbc, 0xa9, 0xe8, 0x6d, 0x50, 0x13, 0x02, 0xd4, 0x74, 0x5f, 0x4d, 0x08, 0x4a, 0x8d,
+	0xe9, 0x1e, 0x1c, 0xf9, 0x67, 0xde, 0x41, 0x3a, 0xb7, 0xdd, 0xb4, 0x6c, 0xe7, 0x1d, 0xcb, 0xa4,
+	0xfb, 0xb7, 0xde, 0x14, 0x00, 0xcf, 0x37, 0xa3, 0xf6, 0x16, 0xb5, 0x31, 0xec, 0xbe, 0x27, 0xfc,
+	0xfd, 0x86, 0xc5, 0x9c, 0x86, 0xc6, 0x1c, 0x6a, 0x8b, 0xda, 0x8f, 0xa9, 0xbe, 0x27, 0x1d, 0xad,
+	0x3b, 0xfa, 0x79, 0x0e, 0x66, 0xa7, 0xba, 0x40, 0xb7, 0x57, 0xeb, 0xfb, 0x96, 0xd9, 0xaa, 0x4f,
+	0xfe, 0x99, 0x97, 0x0a, 0xa3, 0xb6, 0xa1, 0xd5, 0x05, 0xe6, 0xa3, 0x2a, 0x8e, 0xba, 0x7a, 0xdf,
+	0x58, 0x77, 0xef, 0x5b, 0xea, 0x01, 0x79, 0xa8, 0xee, 0xfd, 0xff, 0x78, 0xfb, 0xcb, 0xb8, 0x77,
+	0xe2, 0x83, 0x0e, 0xea, 0x3f, 0x90, 0xb0, 0xd3, 0xb7, 0xe6, 0xa2, 0x6f, 0x4b, 0x30, 0x89, 0x57,
+	0x6a, 0x3c, 0xa1, 0x5f, 0xe8, 0xdb, 0x58, 0xdc, 0xe9, 0x5e, 0x4d, 0xa3, 0x34, 0xc9, 0x42, 0xb4,
+	0x62, 0xe8, 0x0c, 0x0f, 0x51, 0x33, 0x61, 0xb5, 0x18, 0xde, 0xe2, 0x10, 0xd2, 0x72, 0x05, 0x4f,
+	0x89, 0x7c, 0x03, 0xc5, 0x29, 0x6c, 0xd4, 0x17, 0xbd, 0x0f, 0x25, 0x6c, 0x46, 0x41, 0x23, 0xad,
+	0xbb, 0xf3, 0x61, 0x74, 0x89, 0xe1, 0x1e, 0x3d, 0x60, 0x44, 0x5a, 0xe2, 0xa3, 0xdb, 0xa6, 0xfd,
+	0x51, 0x51, 0x69, 0x9d, 0x6a, 0x8c, 0x8e, 0x3c, 0x2a, 0xbf, 0x92, 0x60, 0x0a, 0x75, 0xeb, 0xfc,
+	0x0a, 0x47, 0xde, 0xe0, 0x4d, 0x4f, 0x8c, 0x51, 0xeb, 0xc5, 0xbe, 0x1d, 0x4a, 0xdc, 0xfc, 0x84,
+	0x48, 0xbb, 0xe3, 0x89, 0x21, 0xd1, 0x60, 0xbc, 0xc9, 0x8f, 0xfc, 0x4f, 0xe3, 0x8c, 0xed, 0x6a,
+	0x96, 0x3f, 0xf6, 0xa7, 0xb5, 0x1d, 0x25, 0x4c, 0x6b, 0x11, 0x0e, 0x23, 0x16, 0x2f, 0xad, 0x97,
+	0xfa, 0xf7, 0xf0, 0x76, 0x34, 0xbc, 0xdc, 0x7a, 0x3a, 0x46, 0x97, 0xdb, 0xef, 0x49, 0xf0, 0xb2,
+	0xcb, 0xe1, 0x50, 0x53, 0x37, 0xcc, 0x5a, 0x09, 0xb9, 0xa2, 0x95, 0xf5, 0x75, 0x6a, 0xb7, 0xb2,
+	0x3c, 0x07, 0x93, 0x9a, 0x7b, 0xcc, 0xef, 0x7b, 0x01, 0xf0, 0x26, 0x8e, 0xec, 0x54, 0xfb, 0x0b,
+	0x09, 0xe4, 0x83, 0x10, 0x62, 0x84, 0xdf, 0x80, 0x09, 0x4b, 0x3c, 0xc1, 0xf8, 0x5e, 0xee, 0x17,
+	0xdf, 0x80, 0x1e, 0xef, 0x84, 0xeb, 0xaa, 0x78, 0x3a, 0x4b, 0x87, 0xff, 0x33, 0xcc, 0xda, 0xd3,
+	0x6d, 0x28, 0x6d, 0x23, 0xed, 0x86, 0x52, 0xc7, 0x67, 0x61, 0x1b, 0x0a, 0xea, 0xf0, 0x8a, 0xce,
+	0x13, 0x1f, 0x5d, 0x54, 0x6c, 0x8c, 0xca, 0xb2, 0xa0, 0x3c, 0x83, 0xa5, 0xd6, 0x41, 0xec, 0x8d,
+	0xac, 0x8c, 0x5a, 0x51, 0x0a, 0x1a, 0x6d, 0x53, 0x96, 0x81, 0xea, 0xe9, 0xdb, 0x6c, 0x7c, 0x5a,
+	0x9e, 0x52, 0xed, 0xcc, 0x7c, 0x16, 0x81, 0x29, 0xff, 0x31, 0x9a, 0x24, 0xe1, 0x64, 0xe6, 0x41,
+	0x26, 0x5f, 0xc8, 0x2c, 0xe4, 0x0b, 0xf9, 0xd2, 0xdb, 0x65, 0x1c, 0x14, 0x72, 0xf1, 0x43, 0xe4,
+	0x25, 0x38, 0x1d, 0x78, 0x97, 0x2f, 0x3e, 0xc8, 0x14, 0xf2, 0xd9, 0x72, 0x31, 0xb3, 0x9c, 0x8b,
+	0x4b, 0x5d, 0xaf, 0x4b, 0x85, 0x6c, 0x59, 0xcd, 0xad, 0xe5, 0xd4, 0x07, 0xb9, 0x6c, 0x3c, 0x42,
+	0x64, 0x48, 0x75, 0xbd, 0x2e, 0xae, 0x94, 0xca, 0xab, 0x39, 0x75, 0x39, 0x5f, 0x2a, 0xe5, 0xb2,
+	0xf1, 0x31, 0xf2, 0x22, 0x9c, 0x0a, 0xcc, 0x51, 0x73, 0x4b, 0xf9, 0xb5, 0x52, 0x4e, 0xcd, 0x65,
+	0xe3, 0xd1, 0x2e, 0xfd, 0xb9, 0x2f, 0xad, 0xe6, 0xd5, 0x5c, 0xb6, 0xfc, 0x85, 0x5c, 0x21, 0x1b,
+	0x1f, 0x27, 0xe7, 0x41, 0x0e, 0xbc, 0x5e, 0xcd, 0xa8, 0xb9, 0x62, 0x49, 0x98, 0xf0, 0xa9, 0x99,
+	0xe8, 0xb2, 0x91, 0x2f, 0x96, 0x33, 0x6f, 0x2d, 0x96, 0xf2, 0x2b, 0xc5, 0xf8, 0x64, 0x97, 0x0d,
+	0x7c, 0x53, 0x5e, 0x29, 0x16, 0xde, 0x8e, 0x1f, 0x26, 0xff, 0x07, 0xe7, 0x02, 0xaf, 0x17, 0x57,
+	0x96, 0x97, 0xf3, 0xa5, 0x65, 0x6e, 0x47, 0xcd, 0xbd, 0xf9, 0x16, 0x87, 0x13, 0x8f, 0xcd, 0xfd,
+	0xe7, 0x0c, 0x8c, 0x8b, 0x32, 0x20, 0xdf, 0x97, 0x60, 0xc2, 0x25, 0x9e, 0xc9, 0x5c, 0xbf, 0x6c,
+	0x77, 0x73, 0xdf, 0xc9, 0xab, 0x03, 0xc9, 0xb8, 0xe9, 0x95, 0xd3, 0xdf, 0xf8, 0xc3, 0xbf, 0xbe,
+	0x1d, 0x99, 0x26, 0xe7, 0x95, 0x50, 0xfc, 0x3e, 0xf9, 0x50, 0x82, 0x58, 0x8b, 0xdb, 0x24, 0xd7,
+	0x42, 0x99, 0xec, 0xa4, 0xca, 0x93, 0xd7, 0x07, 0x15, 0x43, 0xb0, 0x57, 0x05, 0xd8, 0xcb, 0xe4,
+	0xa2, 0x12, 0xea, 0x6b, 0x0e, 0x65, 0xd7, 0xd0, 0xf7, 0xc8, 0x8f, 0x25, 0x80, 0x36, 0xfd, 0x10,
+	0x12, 0x72, 0x27, 0xa9, 0x1e, 0x12, 0x72, 0x17, 0x53, 0x1e, 0x3e, 0xbe, 0x78, 0xb3, 0xfa, 0xad,
+	0x04, 0xc7, 0xbb, 0x58, 0x6a, 0x72, 0x2f, 0x94, 0xf5, 0xfd, 0xe8, 0xef, 0xe4, 0xab, 0xc3, 0x8a,
+	0xa3, 0x13, 0xd7, 0x85, 0x13, 0x57, 0x48, 0xba, 0x6f, 0x91, 0x78, 0xe2, 0x65, 0xa7, 0xae, 0x33,
+	0xf2, 0x3b, 0x09, 0x9e, 0xeb, 0x20, 0xc2, 0xc9, 0x9d, 0xc1, 0x72, 0x1f, 0xa0, 0xdc, 0x93, 0x77,
+	0x87, 0x13, 0x46, 0x37, 0xee, 0x09, 0x37, 0x6e, 0x90, 0x6b, 0xe1, 0x72, 0x51, 0xae, 0xec, 0x94,
+	0xf9, 0x5d, 0x41, 0xd9, 0xe5, 0xff, 0xef, 0x91, 0xbf, 0x48, 0xf0, 0x7c, 0x0f, 0x96, 0x9a, 0xbc,
+	0x16, 0x3a, 0xba, 0xbd, 0x39, 0xf6, 0xe4, 0xeb, 0xc3, 0x2b, 0x40, 0xcf, 0x32, 0xc2, 0xb3, 0x3b,
+	0xe4, 0x56, 0x3f, 0xcf, 0x5a, 0x1c, 0x93, 0xeb, 0x22, 0x53, 0x76, 0x5d, 0x3e, 0x7f, 0x8f, 0xfc,
+	0x49, 0x02, 0xd2, 0x4d, 0x69, 0x92, 0xf0, 0xa5, 0xd3, 0x93, 0xa6, 0x4d, 0xbe, 0x36, 0xb4, 0x3c,
+	0xba, 0xf6, 0xba, 0x70, 0xed, 0x36, 0xb9, 0x19, 0x2e, 0x69, 0x8c, 0x67, 0x4d, 0x30, 0xbe, 0xca,
+	0xae, 0xf8, 0xb3, 0x47, 0x7e, 0x2f, 0x41, 0xbc, 0x93, 0x7f, 0x24, 0x77, 0x07, 0xc7, 0xd5, 0x66,
+	0x4c, 0x93, 0xf7, 0x86, 0x94, 0x46, 0x9f, 0xee, 0x0a, 0x9f, 0xae, 0x93, 0xf9, 0x01, 0x7c, 0x72,
+	0xea, 0xba, 0xb2, 0xeb, 0xd4, 0xf5, 0x3d, 0xf2, 0x48, 0x82, 0xc3, 0x1e, 0xe9, 0x48, 0xe6, 0x43,
+	0x21, 0xe9, 0xa0, 0x33, 0x93, 0xd7, 0x06, 0x94, 0x42, 0xdc, 0x37, 0x04, 0xee, 0x59, 0xa2, 0xf4,
+	0xc3, 0xed, 0xd4, 0xf5, 0x32, 0xe3, 0xa2, 0x08, 0xf9, 0x8f, 0x12, 0x1c, 0xef, 0x22, 0xff, 0x42,
+	0x76, 0xb5, 0xfd, 0x08, 0xc8, 0x90, 0x5d, 0x6d, 0x5f, 0xce, 0x31, 0xfc, 0xa2, 0xa9, 0x72, 0x15,
+	0x65, 0xcd, 0xa7, 0xc3, 0x6b, 0x09, 0xbf, 0x94, 0xe0, 0x88, 0xef, 0xfb, 0x1b, 0x72, 0x23, 0x14,
+	0xa4, 0xee, 0xef, 0x8d, 0x92, 0x37, 0x07, 0x17, 0x44, 0x2f, 0xee, 0x08, 0x2f, 0xae, 0x91, 0xab,
+	0x21, 0x9b, 0x9a, 0xb8, 0x1b, 0x7a, 0xf8, 0x7f, 0x23, 0xc1, 0xd1, 0x00, 0x1f, 0x48, 0x6e, 0x0d,
+	0x00, 0x24, 0xc8, 0x5a, 0x26, 0x6f, 0x0f, 0x23, 0x3a, 0x64, 0x6b, 0x46, 0x56, 0xce, 0xf3, 0xe3,
+	0x23, 0x09, 0xa0, 0xcd, 0x53, 0x91, 0x70, 0x9b, 0x75, 0x17, 0x1b, 0x97, 0xbc, 0x31, 0xb0, 0x1c,
+	0xc2, 0x9f, 0x13, 0xf0, 0x2f, 0x91, 0x99, 0x7e, 0xf0, 0xdf, 0xb7, 0x4c, 0x8a, 0x6b, 0xe2, 0x27,
+	0x12, 0x4c, 0x22, 0x51, 0x42, 0xc2, 0x1d, 0xdd, 0x82, 0xb4, 0x56, 0x72, 0x7e, 0x30, 0xa1, 0x41,
+	0xf7, 0x72, 0x64, 0x6d, 0xbc, 0x10, 0xff, 0x5c, 0x82, 0x29, 0x3f, 0x3f, 0x44, 0x6e, 0x86, 0xee,
+	0x81, 0x1d, 0xbc, 0x55, 0xf2, 0xd6, 0x10, 0x92, 0x88, 0xfe, 0x8a, 0x40, 0x3f, 0x43, 0xa6, 0x43,
+	0xa2, 0x67, 0x2d, 0xdc, 0x1e, 0x01, 0x32, 0x00, 0xee, 0x0e, 0x66, 0x69, 0x00, 0xdc, 0x9d, 0x6c,
+	0x4b, 0x78, 0xdc, 0x2d, 0x3e, 0xe5, 0x9f, 0x12, 0xbc, 0xd0, 0x93, 0x5f, 0x20, 0x99, 0x70, 0xe7,
+	0xfc, 0x03, 0xd8, 0x93, 0xe4, 0xc2, 0xe7, 0x51, 0x31, 0x68, 0xfb, 0xf4, 0x7e, 0xec, 0x53, 0x76,
+	0xaf, 0xa3, 0xca, 0x2e, 0xf2, 0x31, 0xed, 0x9a, 0xf2, 0x28, 0x82, 0x01, 0x72, 0xd3, 0x41, 0x5d,
+	0x0c, 0x90, 0x9b, 0x4e, 0x3e, 0x22, 0x7c, 0x6e, 0x5a, 0xb4, 0xc3, 0xaf, 0x25, 0x98, 0xf2, 0x5f,
+	0xda, 0x43, 0xe2, 0xee, 0x41, 0x2e, 0x84, 0xc4, 0xdd, 0x8b, 0x21, 0x90, 0x6f, 0x0b, 0xdc, 0xf3,
+	0x64, 0x4e, 0x09, 0xf5, 0x3b, 0xae, 0x56, 0xf8, 0x0d, 0x7d, 0x6f, 0xe1, 0xde, 0x27, 0x8f, 0x53,
+	0xd2, 0xa7, 0x8f, 0x53, 0xd2, 0x3f, 0x1e, 0xa7, 0xa4, 0x6f, 0x3e, 0x49, 0x1d, 0xfa, 0xf4, 0x49,
+	0xea, 0xd0, 0x9f, 0x9f, 0xa4, 0x0e, 0xbd, 0xf3, 0x4a, 0x50, 0x7c, 0xbb, 0x43, 0x9d, 0xe0, 0x19,
+	0x2b, 0x13, 0xe2, 0x37, 0x58, 0x57, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x3c, 0xc8, 0x35,
+	0x63, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetDomain Queries a domain by its ID.
+	GetDomain(ctx context.Context, in *QueryGetDomainRequest, opts ...grpc.CallOption) (*QueryGetDomainResponse, error)
+	// ListDomain defines the ListDomain RPC.
+	ListDomain(ctx context.Context, in *QueryAllDomainRequest, opts ...grpc.CallOption) (*QueryAllDomainResponse, error)
+	// ListPermittedTLDs queries all permitted TLDs.
+	ListPermittedTLDs(ctx context.Context, in *QueryListPermittedTLDsRequest, opts ...grpc.CallOption) (*QueryListPermittedTLDsResponse, error)
+	// GetDomainByName queries a domain by its FQDN, falling back to its longest registered suffix.
+	GetDomainByName(ctx context.Context, in *QueryGetDomainByNameRequest, opts ...grpc.CallOption) (*QueryGetDomainByNameResponse, error)
+	// ListExpiringDomains queries live domains expiring up to a unix timestamp, soonest first.
+	ListExpiringDomains(ctx context.Context, in *QueryListExpiringDomainsRequest, opts ...grpc.CallOption) (*QueryListExpiringDomainsResponse, error)
+	// ListDomainsByOwner queries the domains owned by an address.
+	ListDomainsByOwner(ctx context.Context, in *QueryListDomainsByOwnerRequest, opts ...grpc.CallOption) (*QueryListDomainsByOwnerResponse, error)
+	// ListDomainsByTLD queries the domains registered under a TLD.
+	ListDomainsByTLD(ctx context.Context, in *QueryListDomainsByTLDRequest, opts ...grpc.CallOption) (*QueryListDomainsByTLDResponse, error)
+	// TLDStats queries the statistics of a TLD.
+	TLDStats(ctx context.Context, in *QueryTLDStatsRequest, opts ...grpc.CallOption) (*QueryTLDStatsResponse, error)
+	// CheckAvailability queries whether a name can be registered and the fee that would be charged.
+	CheckAvailability(ctx context.Context, in *QueryCheckAvailabilityRequest, opts ...grpc.CallOption) (*QueryCheckAvailabilityResponse, error)
+	// DomainPrice queries the registration and renewal price of a candidate name.
+	DomainPrice(ctx context.Context, in *QueryDomainPriceRequest, opts ...grpc.CallOption) (*QueryDomainPriceResponse, error)
+	// DomainRecords queries the on-chain resource records published for a name, together with the NS
+	// delegation and DS records of the registered domain that covers it.
+	DomainRecords(ctx context.Context, in *QueryDomainRecordsRequest, opts ...grpc.CallOption) (*QueryDomainRecordsResponse, error)
+	// ExportZone renders the active domains of a TLD as an RFC 1035 master file, for secondary
+	// name servers to load. The SOA serial is the queried block height. The zone is paginated by
+	// domain: the first page starts with the SOA and apex NS records, and the pages read at its
+	// serial concatenate into the whole zone.
+	ExportZone(ctx context.Context, in *QueryExportZoneRequest, opts ...grpc.CallOption) (*QueryExportZoneResponse, error)
+	// Auction queries the open auction of a name and its bids.
+	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
+	// ListAuctions queries the open auctions, settling soonest first.
+	ListAuctions(ctx context.Context, in *QueryListAuctionsRequest, opts ...grpc.CallOption) (*QueryListAuctionsResponse, error)
+	// ListReleases queries the lapsed names in their release window and their live price, ending
+	// soonest first.
+	ListReleases(ctx context.Context, in *QueryListReleasesRequest, opts ...grpc.CallOption) (*QueryListReleasesResponse, error)
+	// PendingTransferOffers queries the unexpired transfer offers made to an address.
+	PendingTransferOffers(ctx context.Context, in *QueryPendingTransferOffersRequest, opts ...grpc.CallOption) (*QueryPendingTransferOffersResponse, error)
+	// ListListings queries the domains listed for sale.
+	ListListings(ctx context.Context, in *QueryListListingsRequest, opts ...grpc.CallOption) (*QueryListListingsResponse, error)
+	// MarketOffers queries the escrowed offers to buy a domain.
+	MarketOffers(ctx context.Context, in *QueryMarketOffersRequest, opts ...grpc.CallOption) (*QueryMarketOffersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDomain(ctx context.Context, in *QueryGetDomainRequest, opts ...grpc.CallOption) (*QueryGetDomainResponse, error) {
+	out := new(QueryGetDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/GetDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDomain(ctx context.Context, in *QueryAllDomainRequest, opts ...grpc.CallOption) (*QueryAllDomainResponse, error) {
+	out := new(QueryAllDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPermittedTLDs(ctx context.Context, in *QueryListPermittedTLDsRequest, opts ...grpc.CallOption) (*QueryListPermittedTLDsResponse, error) {
+	out := new(QueryListPermittedTLDsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListPermittedTLDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDomainByName(ctx context.Context, in *QueryGetDomainByNameRequest, opts ...grpc.CallOption) (*QueryGetDomainByNameResponse, error) {
+	out := new(QueryGetDomainByNameResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/GetDomainByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListExpiringDomains(ctx context.Context, in *QueryListExpiringDomainsRequest, opts ...grpc.CallOption) (*QueryListExpiringDomainsResponse, error) {
+	out := new(QueryListExpiringDomainsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListExpiringDomains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDomainsByOwner(ctx context.Context, in *QueryListDomainsByOwnerRequest, opts ...grpc.CallOption) (*QueryListDomainsByOwnerResponse, error) {
+	out := new(QueryListDomainsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListDomainsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDomainsByTLD(ctx context.Context, in *QueryListDomainsByTLDRequest, opts ...grpc.CallOption) (*QueryListDomainsByTLDResponse, error) {
+	out := new(QueryListDomainsByTLDResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListDomainsByTLD", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TLDStats(ctx context.Context, in *QueryTLDStatsRequest, opts ...grpc.CallOption) (*QueryTLDStatsResponse, error) {
+	out := new(QueryTLDStatsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/TLDStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckAvailability(ctx context.Context, in *QueryCheckAvailabilityRequest, opts ...grpc.CallOption) (*QueryCheckAvailabilityResponse, error) {
+	out := new(QueryCheckAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/CheckAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DomainPrice(ctx context.Context, in *QueryDomainPriceRequest, opts ...grpc.CallOption) (*QueryDomainPriceResponse, error) {
+	out := new(QueryDomainPriceResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/DomainPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DomainRecords(ctx context.Context, in *QueryDomainRecordsRequest, opts ...grpc.CallOption) (*QueryDomainRecordsResponse, error) {
+	out := new(QueryDomainRecordsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/DomainRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExportZone(ctx context.Context, in *QueryExportZoneRequest, opts ...grpc.CallOption) (*QueryExportZoneResponse, error) {
+	out := new(QueryExportZoneResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ExportZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
+	out := new(QueryAuctionResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/Auction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAuctions(ctx context.Context, in *QueryListAuctionsRequest, opts ...grpc.CallOption) (*QueryListAuctionsResponse, error) {
+	out := new(QueryListAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListReleases(ctx context.Context, in *QueryListReleasesRequest, opts ...grpc.CallOption) (*QueryListReleasesResponse, error) {
+	out := new(QueryListReleasesResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListReleases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingTransferOffers(ctx context.Context, in *QueryPendingTransferOffersRequest, opts ...grpc.CallOption) (*QueryPendingTransferOffersResponse, error) {
+	out := new(QueryPendingTransferOffersResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/PendingTransferOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListListings(ctx context.Context, in *QueryListListingsRequest, opts ...grpc.CallOption) (*QueryListListingsResponse, error) {
+	out := new(QueryListListingsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/ListListings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketOffers(ctx context.Context, in *QueryMarketOffersRequest, opts ...grpc.CallOption) (*QueryMarketOffersResponse, error) {
+	out := new(QueryMarketOffersResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Query/MarketOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetDomain Queries a domain by its ID.
+	GetDomain(context.Context, *QueryGetDomainRequest) (*QueryGetDomainResponse, error)
+	// ListDomain defines the ListDomain RPC.
+	ListDomain(context.Context, *QueryAllDomainRequest) (*QueryAllDomainResponse, error)
+	// ListPermittedTLDs queries all permitted TLDs.
+	ListPermittedTLDs(context.Context, *QueryListPermittedTLDsRequest) (*QueryListPermittedTLDsResponse, error)
+	// GetDomainByName queries a domain by its FQDN, falling back to its longest registered suffix.
+	GetDomainByName(context.Context, *QueryGetDomainByNameRequest) (*QueryGetDomainByNameResponse, error)
+	// ListExpiringDomains queries live domains expiring up to a unix timestamp, soonest first.
+	ListExpiringDomains(context.Context, *QueryListExpiringDomainsRequest) (*QueryListExpiringDomainsResponse, error)
+	// ListDomainsByOwner queries the domains owned by an address.
+	ListDomainsByOwner(context.Context, *QueryListDomainsByOwnerRequest) (*QueryListDomainsByOwnerResponse, error)
+	// ListDomainsByTLD queries the domains registered under a TLD.
+	ListDomainsByTLD(context.Context, *QueryListDomainsByTLDRequest) (*QueryListDomainsByTLDResponse, error)
+	// TLDStats queries the statistics of a TLD.
+	TLDStats(context.Context, *QueryTLDStatsRequest) (*QueryTLDStatsResponse, error)
+	// CheckAvailability queries whether a name can be registered and the fee that would be charged.
+	CheckAvailability(context.Context, *QueryCheckAvailabilityRequest) (*QueryCheckAvailabilityResponse, error)
+	// DomainPrice queries the registration and renewal price of a candidate name.
+	DomainPrice(context.Context, *QueryDomainPriceRequest) (*QueryDomainPriceResponse, error)
+	// DomainRecords queries the on-chain resource records published for a name, together with the NS
+	// delegation and DS records of the registered domain that covers it.
+	DomainRecords(context.Context, *QueryDomainRecordsRequest) (*QueryDomainRecordsResponse, error)
+	// ExportZone renders the active domains of a TLD as an RFC 1035 master file, for secondary
+	// name servers to load. The SOA serial is the queried block height. The zone is paginated by
+	// domain: the first page starts with the SOA and apex NS records, and the pages read at its
+	// serial concatenate into the whole zone.
+	ExportZone(context.Context, *QueryExportZoneRequest) (*QueryExportZoneResponse, error)
+	// Auction queries the open auction of a name and its bids.
+	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
+	// ListAuctions queries the open auctions, settling soonest first.
+	ListAuctions(context.Context, *QueryListAuctionsRequest) (*QueryListAuctionsResponse, error)
+	// ListReleases queries the lapsed names in their release window and their live price, ending
+	// soonest first.
+	ListReleases(context.Context, *QueryListReleasesRequest) (*QueryListReleasesResponse, error)
+	// PendingTransferOffers queries the unexpired transfer offers made to an address.
+	PendingTransferOffers(context.Context, *QueryPendingTransferOffersRequest) (*QueryPendingTransferOffersResponse, error)
+	// ListListings queries the domains listed for sale.
+	ListListings(context.Context, *QueryListListingsRequest) (*QueryListListingsResponse, error)
+	// MarketOffers queries the escrowed offers to buy a domain.
+	MarketOffers(context.Context, *QueryMarketOffersRequest) (*QueryMarketOffersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetDomain(ctx context.Context, req *QueryGetDomainRequest) (*QueryGetDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDomain not implemented")
+}
+func (*UnimplementedQueryServer) ListDomain(ctx context.Context, req *QueryAllDomainRequest) (*QueryAllDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomain not implemented")
+}
+func (*UnimplementedQueryServer) ListPermittedTLDs(ctx context.Context, req *QueryListPermittedTLDsRequest) (*QueryListPermittedTLDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermittedTLDs not implemented")
+}
+func (*UnimplementedQueryServer) GetDomainByName(ctx context.Context, req *QueryGetDomainByNameRequest) (*QueryGetDomainByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDomainByName not implemented")
+}
+func (*UnimplementedQueryServer) ListExpiringDomains(ctx context.Context, req *QueryListExpiringDomainsRequest) (*QueryListExpiringDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringDomains not implemented")
+}
+func (*UnimplementedQueryServer) ListDomainsByOwner(ctx context.Context, req *QueryListDomainsByOwnerRequest) (*QueryListDomainsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomainsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) ListDomainsByTLD(ctx context.Context, req *QueryListDomainsByTLDRequest) (*QueryListDomainsByTLDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomainsByTLD not implemented")
+}
+func (*UnimplementedQueryServer) TLDStats(ctx context.Context, req *QueryTLDStatsRequest) (*QueryTLDStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TLDStats not implemented")
+}
+func (*UnimplementedQueryServer) CheckAvailability(ctx context.Context, req *QueryCheckAvailabilityRequest) (*QueryCheckAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAvailability not implemented")
+}
+func (*UnimplementedQueryServer) DomainPrice(ctx context.Context, req *QueryDomainPriceRequest) (*QueryDomainPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainPrice not implemented")
+}
+func (*UnimplementedQueryServer) DomainRecords(ctx context.Context, req *QueryDomainRecordsRequest) (*QueryDomainRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainRecords not implemented")
+}
+func (*UnimplementedQueryServer) ExportZone(ctx context.Context, req *QueryExportZoneRequest) (*QueryExportZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportZone not implemented")
+}
+func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryAuctionRequest) (*QueryAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (*UnimplementedQueryServer) ListAuctions(ctx context.Context, req *QueryListAuctionsRequest) (*QueryListAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuctions not implemented")
+}
+func (*UnimplementedQueryServer) ListReleases(ctx context.Context, req *QueryListReleasesRequest) (*QueryListReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleases not implemented")
+}
+func (*UnimplementedQueryServer) PendingTransferOffers(ctx context.Context, req *QueryPendingTransferOffersRequest) (*QueryPendingTransferOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTransferOffers not implemented")
+}
+func (*UnimplementedQueryServer) ListListings(ctx context.Context, req *QueryListListingsRequest) (*QueryListListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListListings not implemented")
+}
+func (*UnimplementedQueryServer) MarketOffers(ctx context.Context, req *QueryMarketOffersRequest) (*QueryMarketOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketOffers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/GetDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDomain(ctx, req.(*QueryGetDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDomain(ctx, req.(*QueryAllDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPermittedTLDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPermittedTLDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPermittedTLDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListPermittedTLDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPermittedTLDs(ctx, req.(*QueryListPermittedTLDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDomainByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDomainByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDomainByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/GetDomainByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDomainByName(ctx, req.(*QueryGetDomainByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListExpiringDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListExpiringDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListExpiringDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListExpiringDomains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListExpiringDomains(ctx, req.(*QueryListExpiringDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDomainsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListDomainsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDomainsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListDomainsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDomainsByOwner(ctx, req.(*QueryListDomainsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDomainsByTLD_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListDomainsByTLDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDomainsByTLD(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListDomainsByTLD",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDomainsByTLD(ctx, req.(*QueryListDomainsByTLDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TLDStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTLDStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TLDStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/TLDStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TLDStats(ctx, req.(*QueryTLDStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/CheckAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckAvailability(ctx, req.(*QueryCheckAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DomainPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DomainPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/DomainPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DomainPrice(ctx, req.(*QueryDomainPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DomainRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DomainRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/DomainRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DomainRecords(ctx, req.(*QueryDomainRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExportZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExportZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExportZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ExportZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExportZone(ctx, req.(*QueryExportZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/Auction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAuctions(ctx, req.(*QueryListAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListReleases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListReleases(ctx, req.(*QueryListReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingTransferOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTransferOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTransferOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/PendingTransferOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTransferOffers(ctx, req.(*QueryPendingTransferOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/ListListings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListListings(ctx, req.(*QueryListListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Query/MarketOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketOffers(ctx, req.(*QueryMarketOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dnsblockchain.dnsblockchain.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetDomain",
+			Handler:    _Query_GetDomain_Handler,
+		},
+		{
+			MethodName: "ListDomain",
+			Handler:    _Query_ListDomain_Handler,
+		},
+		{
+			MethodName: "ListPermittedTLDs",
+			Handler:    _Query_ListPermittedTLDs_Handler,
+		},
+		{
+			MethodName: "GetDomainByName",
+			Handler:    _Query_GetDomainByName_Handler,
+		},
+		{
+			MethodName: "ListExpiringDomains",
+			Handler:    _Query_ListExpiringDomains_Handler,
+		},
+		{
+			MethodName: "ListDomainsByOwner",
+			Handler:    _Query_ListDomainsByOwner_Handler,
+		},
+		{
+			MethodName: "ListDomainsByTLD",
+			Handler:    _Query_ListDomainsByTLD_Handler,
+		},
+		{
+			MethodName: "TLDStats",
+			Handler:    _Query_TLDStats_Handler,
+		},
+		{
+			MethodName: "CheckAvailability",
+			Handler:    _Query_CheckAvailability_Handler,
+		},
+		{
+			MethodName: "DomainPrice",
+			Handler:    _Query_DomainPrice_Handler,
+		},
+		{
+			MethodName: "DomainRecords",
+			Handler:    _Query_DomainRecords_Handler,
+		},
+		{
+			MethodName: "ExportZone",
+			Handler:    _Query_ExportZone_Handler,
+		},
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "ListAuctions",
+			Handler:    _Query_ListAuctions_Handler,
+		},
+		{
+			MethodName: "ListReleases",
+			Handler:    _Query_ListReleases_Handler,
+		},
+		{
+			MethodName: "PendingTransferOffers",
+			Handler:    _Query_PendingTransferOffers_Handler,
+		},
+		{
+			MethodName: "ListListings",
+			Handler:    _Query_ListListings_Handler,
+		},
+		{
+			MethodName: "MarketOffers",
+			Handler:    _Query_MarketOffers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dnsblockchain/dnsblockchain/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		for iNdEx := len(m.Domain) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPermittedTLDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPermittedTLDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPermittedTLDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPermittedTLDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPermittedTLDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPermittedTLDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tlds) > 0 {
+		for iNdEx := len(m.Tlds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tlds[iNdEx])
+			copy(dAtA[i:], m.Tlds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Tlds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDomainByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDomainByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDomainByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDomainByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDomainByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDomainByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exact {
+		i--
+		if m.Exact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListExpiringDomainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListExpiringDomainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListExpiringDomainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Before != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Before))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListExpiringDomainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListExpiringDomainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListExpiringDomainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		for iNdEx := len(m.Domain) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Years != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Premium {
+		i--
+		if m.Premium {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RenewalFee) > 0 {
+		for iNdEx := len(m.RenewalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDomainsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDomainsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDomainsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDomainsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDomainsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDomainsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		for iNdEx := len(m.Domain) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDomainsByTLDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDomainsByTLDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDomainsByTLDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tld) > 0 {
+		i -= len(m.Tld)
+		copy(dAtA[i:], m.Tld)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tld)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDomainsByTLDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDomainsByTLDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDomainsByTLDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		for iNdEx := len(m.Domain) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTLDStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTLDStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTLDStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tld) > 0 {
+		i -= len(m.Tld)
+		copy(dAtA[i:], m.Tld)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tld)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTLDStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTLDStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTLDStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExpiringSoon != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiringSoon))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DomainCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DomainCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tld) > 0 {
+		i -= len(m.Tld)
+		copy(dAtA[i:], m.Tld)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tld)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckAvailabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckAvailabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckAvailabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Years != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckAvailabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckAvailabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckAvailabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Available {
+		i--
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DsRecords) > 0 {
+		for iNdEx := len(m.DsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NsRecords) > 0 {
+		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExportZoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportZoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hostmaster) > 0 {
+		i -= len(m.Hostmaster)
+		copy(dAtA[i:], m.Hostmaster)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hostmaster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nameserver) > 0 {
+		i -= len(m.Nameserver)
+		copy(dAtA[i:], m.Nameserver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Nameserver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tld) > 0 {
+		i -= len(m.Tld)
+		copy(dAtA[i:], m.Tld)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tld)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExportZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DomainCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DomainCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Serial != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Serial))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListReleasesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListReleasesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListReleasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleasedName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleasedName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleasedName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Release.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListReleasesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListReleasesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListReleasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTransferOffersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTransferOffersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTransferOffersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTransferOffersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTransferOffersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTransferOffersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListListingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListListingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListListingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListListingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListListingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListListingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketOffersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketOffersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketOffersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketOffersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketOffersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketOffersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Domain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		for _, e := range m.Domain {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPermittedTLDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryListPermittedTLDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tlds) > 0 {
+		for _, s := range m.Tlds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetDomainByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDomainByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Domain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Found {
+		n += 2
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.Exact {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListExpiringDomainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Before != 0 {
+		n += 1 + sovQuery(uint64(m.Before))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListExpiringDomainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		for _, e := range m.Domain {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Years != 0 {
+		n += 1 + sovQuery(uint64(m.Years))
+	}
+	return n
+}
+
+func (m *QueryDomainPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RenewalFee) > 0 {
+		for _, e := range m.RenewalFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Premium {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListDomainsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDomainsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		for _, e := range m.Domain {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDomainsByTLDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tld)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDomainsByTLDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		for _, e := range m.Domain {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTLDStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tld)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTLDStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tld)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DomainCount != 0 {
+		n += 1 + sovQuery(uint64(m.DomainCount))
+	}
+	if m.ExpiringSoon != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiringSoon))
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCheckAvailabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Years != 0 {
+		n += 1 + sovQuery(uint64(m.Years))
+	}
+	return n
+}
+
+func (m *QueryCheckAvailabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Available {
+		n += 2
+	}
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDomainRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *QueryDomainRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NsRecords) > 0 {
+		for _, e := range m.NsRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if len(m.DsRecords) > 0 {
+		for _, e := range m.DsRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExportZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tld)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Nameserver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hostmaster)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExportZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Serial != 0 {
+		n += 1 + sovQuery(uint64(m.Serial))
+	}
+	if m.DomainCount != 0 {
+		n += 1 + sovQuery(uint64(m.DomainCount))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListReleasesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ReleasedName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Release.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListReleasesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTransferOffersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTransferOffersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListListingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListListingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketOffersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketOffersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = append(m.Domain, Domain{})
+			if err := m.Domain[len(m.Domain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPermittedTLDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPermittedTLDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPermittedTLDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPermittedTLDsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPermittedTLDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPermittedTLDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tlds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tlds = append(m.Tlds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDomainByNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDomainByNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDomainByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDomainByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDomainByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDomainByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exact = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListExpiringDomainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListExpiringDomainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListExpiringDomainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			m.Before = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Before |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListExpiringDomainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListExpiringDomainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListExpiringDomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = append(m.Domain, Domain{})
+			if err := m.Domain[len(m.Domain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalFee = append(m.RenewalFee, types.Coin{})
+			if err := m.RenewalFee[len(m.RenewalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Premium = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDomainsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDomainsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDomainsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDomainsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDomainsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDomainsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = append(m.Domain, Domain{})
+			if err := m.Domain[len(m.Domain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDomainsByTLDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDomainsByTLDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDomainsByTLDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDomainsByTLDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDomainsByTLDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDomainsByTLDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = append(m.Domain, Domain{})
+			if err := m.Domain[len(m.Domain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTLDStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTLDStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTLDStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTLDStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTLDStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTLDStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainCount", wireType)
+			}
+			m.DomainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringSoon", wireType)
+			}
+			m.ExpiringSoon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiringSoon |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFees = append(m.TotalFees, types.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckAvailabilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckAvailabilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckAvailabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckAvailabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckAvailabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckAvailabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Available = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= Availability(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RecordType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ResourceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsRecords = append(m.NsRecords, &NSRecordWithIP{})
+			if err := m.NsRecords[len(m.NsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DomainStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DsRecords = append(m.DsRecords, &DSRecord{})
+			if err := m.DsRecords[len(m.DsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExportZoneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExportZoneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExportZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nameserver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nameserver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostmaster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostmaster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExportZoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExportZoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExportZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Serial", wireType)
+			}
+			m.Serial = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Serial |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainCount", wireType)
+			}
+			m.DomainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, AuctionBid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryGetDomainByNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryGetDomainByNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Domain.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Found {
-		n += 2
-	}
-	if m.Expired {
-		n += 2
+func (m *QueryListAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1295,12 +8834,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1322,7 +8931,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListReleasesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1345,15 +8954,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListReleasesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListReleasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1380,7 +8989,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1405,7 +9017,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDomainRequest) Unmarshal(dAtA []byte) error {
+func (m *ReleasedName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1428,17 +9040,17 @@ func (m *QueryGetDomainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDomainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleasedName: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleasedName: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1448,11 +9060,59 @@ func (m *QueryGetDomainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Release.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1474,7 +9134,7 @@ func (m *QueryGetDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListReleasesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1497,15 +9157,15 @@ func (m *QueryGetDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListReleasesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListReleasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1532,7 +9192,44 @@ func (m *QueryGetDomainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Releases = append(m.Releases, ReleasedName{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1557,7 +9254,7 @@ func (m *QueryGetDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDomainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTransferOffersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1580,13 +9277,45 @@ func (m *QueryAllDomainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDomainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingTransferOffersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingTransferOffersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1643,7 +9372,7 @@ func (m *QueryAllDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTransferOffersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1666,15 +9395,15 @@ func (m *QueryAllDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingTransferOffersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingTransferOffersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1701,8 +9430,8 @@ func (m *QueryAllDomainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = append(m.Domain, Domain{})
-			if err := m.Domain[len(m.Domain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offers = append(m.Offers, TransferOffer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1763,7 +9492,7 @@ func (m *QueryAllDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPermittedTLDsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListListingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1786,12 +9515,48 @@ func (m *QueryListPermittedTLDsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPermittedTLDsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListListingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPermittedTLDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListListingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1813,7 +9578,7 @@ func (m *QueryListPermittedTLDsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPermittedTLDsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListListingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1836,17 +9601,17 @@ func (m *QueryListPermittedTLDsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPermittedTLDsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListListingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPermittedTLDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListListingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tlds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1856,23 +9621,61 @@ func (m *QueryListPermittedTLDsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tlds = append(m.Tlds, string(dAtA[iNdEx:postIndex]))
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1895,7 +9698,7 @@ func (m *QueryListPermittedTLDsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDomainByNameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketOffersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1918,17 +9721,36 @@ func (m *QueryGetDomainByNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDomainByNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketOffersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDomainByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketOffersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1938,23 +9760,27 @@ func (m *QueryGetDomainByNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1977,7 +9803,7 @@ func (m *QueryGetDomainByNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDomainByNameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketOffersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,15 +9826,15 @@ func (m *QueryGetDomainByNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDomainByNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketOffersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDomainByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketOffersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2035,15 +9861,16 @@ func (m *QueryGetDomainByNameResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offers = append(m.Offers, MarketOffer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2053,32 +9880,28 @@ func (m *QueryGetDomainByNameResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Found = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Expired = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
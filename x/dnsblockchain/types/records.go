@@ -0,0 +1,230 @@
+package types
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultMaxRecordsPerDomain caps the resource records a domain can publish on chain.
+	DefaultMaxRecordsPerDomain uint64 = 20
+	// DefaultRecordTTL is served for records that do not set their own TTL (1 hour).
+	DefaultRecordTTL uint32 = 3600
+	// MaxRecordTTL is the largest TTL allowed by RFC 2181.
+	MaxRecordTTL uint32 = 1<<31 - 1
+	// MaxTXTLength caps the value of a TXT record, in bytes.
+	MaxTXTLength = 2048
+	// ApexRecordName is the conventional relative name of the domain itself.
+	ApexRecordName = "@"
+)
+
+// caaTags are the CAA property tags defined by RFC 8659.
+var caaTags = map[string]bool{"issue": true, "issuewild": true, "iodef": true}
+
+// RecordsPerDomainLimit returns the maximum number of records per domain, falling back to the
+// default when unset.
+func (p Params) RecordsPerDomainLimit() uint64 {
+	if p.MaxRecordsPerDomain == 0 {
+		return DefaultMaxRecordsPerDomain
+	}
+	return p.MaxRecordsPerDomain
+}
+
+// EffectiveTTL returns the record's TTL, or DefaultRecordTTL when it is unset.
+func (r ResourceRecord) EffectiveTTL() uint32 {
+	if r.Ttl == 0 {
+		return DefaultRecordTTL
+	}
+	return r.Ttl
+}
+
+// NormalizeRecordName lowercases a relative record name and maps the apex to "".
+func NormalizeRecordName(name string) string {
+	name = strings.ToLower(strings.Trim(name, "."))
+	if name == ApexRecordName {
+		return ""
+	}
+	return name
+}
+
+// RecordFQDN returns the fully qualified owner name of a record published by domainName.
+func RecordFQDN(domainName string, recordName string) string {
+	if rel := NormalizeRecordName(recordName); rel != "" {
+		return rel + "." + domainName
+	}
+	return domainName
+}
+
+// RecordsAt returns the domain's records whose owner name is fqdn, optionally restricted to one
+// type. RECORD_TYPE_UNSPECIFIED matches every type.
+func (d Domain) RecordsAt(fqdn string, recordType RecordType) []ResourceRecord {
+	fqdn = strings.ToLower(strings.Trim(fqdn, "."))
+	var out []ResourceRecord
+	for _, r := range d.Records {
+		if r == nil || RecordFQDN(d.Name, r.Name) != fqdn {
+			continue
+		}
+		if recordType != RecordType_RECORD_TYPE_UNSPECIFIED && r.Type != recordType {
+			continue
+		}
+		out = append(out, *r)
+	}
+	return out
+}
+
+// ValidateResourceRecords checks every record of a set on its own and the rules that span
+// records: no duplicates, no CNAME at the apex, and no other record alongside a CNAME.
+func ValidateResourceRecords(records []*ResourceRecord) error {
+	seen := make(map[string]bool, len(records))
+	byName := make(map[string]map[RecordType]int)
+	for i, r := range records {
+		if r == nil {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "record %d cannot be nil", i)
+		}
+		if err := r.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "record %d", i)
+		}
+
+		name := NormalizeRecordName(r.Name)
+		key := fmt.Sprintf("%s|%d|%s|%d|%d|%d|%d|%s", name, r.Type, r.Value, r.Priority, r.Weight, r.Port, r.Flags, strings.ToLower(r.Tag))
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "record %d duplicates an earlier record", i)
+		}
+		seen[key] = true
+
+		if byName[name] == nil {
+			byName[name] = make(map[RecordType]int)
+		}
+		byName[name][r.Type]++
+	}
+
+	for name, counts := range byName {
+		cnames := counts[RecordType_RECORD_TYPE_CNAME]
+		if cnames == 0 {
+			continue
+		}
+		if name == "" {
+			return errorsmod.Wrap(ErrInvalidResourceRecord, "a CNAME record cannot be placed at the domain apex")
+		}
+		if cnames > 1 || len(counts) > 1 {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "a CNAME record at %q cannot coexist with other records", name)
+		}
+	}
+	return nil
+}
+
+// Validate checks a single record against the rules of its type.
+func (r ResourceRecord) Validate() error {
+	name := NormalizeRecordName(r.Name)
+	if name != "" {
+		if err := validateRecordOwnerName(name); err != nil {
+			return err
+		}
+	}
+	if r.Ttl > MaxRecordTTL {
+		return errorsmod.Wrapf(ErrInvalidResourceRecord, "ttl %d exceeds the maximum of %d", r.Ttl, MaxRecordTTL)
+	}
+
+	switch r.Type {
+	case RecordType_RECORD_TYPE_A:
+		ip := net.ParseIP(r.Value)
+		if ip == nil || ip.To4() == nil || strings.Contains(r.Value, ":") {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "invalid IPv4 address %q in A record", r.Value)
+		}
+	case RecordType_RECORD_TYPE_AAAA:
+		ip := net.ParseIP(r.Value)
+		if ip == nil || !strings.Contains(r.Value, ":") {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "invalid IPv6 address %q in AAAA record", r.Value)
+		}
+	case RecordType_RECORD_TYPE_TXT:
+		if len(r.Value) > MaxTXTLength {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "TXT record value exceeds %d bytes", MaxTXTLength)
+		}
+	case RecordType_RECORD_TYPE_MX:
+		if r.Priority > 0xFFFF {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "MX preference %d exceeds 65535", r.Priority)
+		}
+		if err := validateHostname(r.Value); err != nil {
+			return errorsmod.Wrap(err, "MX exchange")
+		}
+	case RecordType_RECORD_TYPE_CNAME:
+		if err := validateHostname(r.Value); err != nil {
+			return errorsmod.Wrap(err, "CNAME target")
+		}
+	case RecordType_RECORD_TYPE_SRV:
+		labels := strings.Split(name, ".")
+		if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "SRV record name %q must start with _service._proto", r.Name)
+		}
+		if r.Priority > 0xFFFF || r.Weight > 0xFFFF || r.Port > 0xFFFF {
+			return errorsmod.Wrap(ErrInvalidResourceRecord, "SRV priority, weight and port cannot exceed 65535")
+		}
+		// "." means the service is decidedly not available at this domain.
+		if r.Value != "." {
+			if err := validateHostname(r.Value); err != nil {
+				return errorsmod.Wrap(err, "SRV target")
+			}
+		}
+	case RecordType_RECORD_TYPE_CAA:
+		if r.Flags > 0xFF {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "CAA flags %d exceed 255", r.Flags)
+		}
+		if !caaTags[strings.ToLower(r.Tag)] {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "unsupported CAA tag %q", r.Tag)
+		}
+		if len(r.Value) > MaxTXTLength {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "CAA record value exceeds %d bytes", MaxTXTLength)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidResourceRecord, "unsupported record type %s", r.Type)
+	}
+	return nil
+}
+
+// validateRecordOwnerName checks a relative owner name. Labels may contain underscores for
+// service names, and the leftmost label may be the "*" wildcard.
+func validateRecordOwnerName(name string) error {
+	if len(name) > 253 {
+		return errorsmod.Wrapf(ErrInvalidResourceRecord, "record name %q is too long", name)
+	}
+	for i, label := range strings.Split(name, ".") {
+		if label == "*" && i == 0 {
+			continue
+		}
+		if !isHostLabel(label) {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "invalid label %q in record name %q", label, name)
+		}
+	}
+	return nil
+}
+
+// validateHostname checks a fully qualified target host name, with an optional trailing dot.
+func validateHostname(host string) error {
+	trimmed := strings.TrimSuffix(host, ".")
+	if trimmed == "" || len(trimmed) > 253 {
+		return errorsmod.Wrapf(ErrInvalidResourceRecord, "invalid host name %q", host)
+	}
+	for _, label := range strings.Split(trimmed, ".") {
+		if !isHostLabel(label) {
+			return errorsmod.Wrapf(ErrInvalidResourceRecord, "invalid host name %q", host)
+		}
+	}
+	return nil
+}
+
+func isHostLabel(label string) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, c := range label {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
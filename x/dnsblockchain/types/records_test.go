@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"dnsblockchain/x/dnsblockchain/types"
+)
+
+func TestValidateResourceRecords(t *testing.T) {
+	a := func(name, ip string) *types.ResourceRecord {
+		return &types.ResourceRecord{Name: name, Type: types.RecordType_RECORD_TYPE_A, Value: ip}
+	}
+
+	tests := []struct {
+		desc    string
+		records []*types.ResourceRecord
+		valid   bool
+	}{
+		{desc: "empty", valid: true},
+		{desc: "apex and host", records: []*types.ResourceRecord{
+			a("@", "192.0.2.1"),
+			a("www", "192.0.2.2"),
+			{Name: "*", Type: types.RecordType_RECORD_TYPE_AAAA, Value: "2001:db8::1", Ttl: 60},
+			{Type: types.RecordType_RECORD_TYPE_TXT, Value: "v=spf1 -all"},
+			{Type: types.RecordType_RECORD_TYPE_MX, Value: "mail.example.web3.", Priority: 10},
+			{Name: "blog", Type: types.RecordType_RECORD_TYPE_CNAME, Value: "host.example.org"},
+			{Name: "_sip._tcp", Type: types.RecordType_RECORD_TYPE_SRV, Value: "sip.example.web3", Priority: 1, Weight: 5, Port: 5060},
+			{Type: types.RecordType_RECORD_TYPE_CAA, Tag: "issue", Value: "letsencrypt.org"},
+		}, valid: true},
+		{desc: "unspecified type", records: []*types.ResourceRecord{{Value: "x"}}},
+		{desc: "ipv6 in A", records: []*types.ResourceRecord{a("", "2001:db8::1")}},
+		{desc: "ipv4 in AAAA", records: []*types.ResourceRecord{{Type: types.RecordType_RECORD_TYPE_AAAA, Value: "192.0.2.1"}}},
+		{desc: "bad label", records: []*types.ResourceRecord{a("bad name", "192.0.2.1")}},
+		{desc: "ttl too large", records: []*types.ResourceRecord{{Type: types.RecordType_RECORD_TYPE_A, Value: "192.0.2.1", Ttl: types.MaxRecordTTL + 1}}},
+		{desc: "duplicate", records: []*types.ResourceRecord{a("www", "192.0.2.1"), a("WWW.", "192.0.2.1")}},
+		{desc: "cname at apex", records: []*types.ResourceRecord{{Type: types.RecordType_RECORD_TYPE_CNAME, Value: "other.web3"}}},
+		{desc: "cname with other data", records: []*types.ResourceRecord{
+			{Name: "www", Type: types.RecordType_RECORD_TYPE_CNAME, Value: "other.web3"},
+			a("www", "192.0.2.1"),
+		}},
+		{desc: "mx without host", records: []*types.ResourceRecord{{Type: types.RecordType_RECORD_TYPE_MX, Priority: 10}}},
+		{desc: "srv without service labels", records: []*types.ResourceRecord{{Name: "sip", Type: types.RecordType_RECORD_TYPE_SRV, Value: "sip.example.web3"}}},
+		{desc: "srv port too large", records: []*types.ResourceRecord{{Name: "_sip._tcp", Type: types.RecordType_RECORD_TYPE_SRV, Value: ".", Port: 70000}}},
+		{desc: "unknown caa tag", records: []*types.ResourceRecord{{Type: types.RecordType_RECORD_TYPE_CAA, Tag: "policy", Value: "x"}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.ValidateResourceRecords(tc.records)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidResourceRecord)
+			}
+		})
+	}
+}
+
+func TestDomainRecordsAt(t *testing.T) {
+	domain := types.Domain{Name: "example.web3", Records: []*types.ResourceRecord{
+		{Name: "", Type: types.RecordType_RECORD_TYPE_A, Value: "192.0.2.1"},
+		{Name: "", Type: types.RecordType_RECORD_TYPE_TXT, Value: "hello"},
+		{Name: "www", Type: types.RecordType_RECORD_TYPE_A, Value: "192.0.2.2", Ttl: 60},
+	}}
+
+	require.Len(t, domain.RecordsAt("example.web3.", types.RecordType_RECORD_TYPE_UNSPECIFIED), 2)
+	apexA := domain.RecordsAt("example.web3", types.RecordType_RECORD_TYPE_A)
+	require.Len(t, apexA, 1)
+	require.Equal(t, types.DefaultRecordTTL, apexA[0].EffectiveTTL())
+
+	www := domain.RecordsAt("WWW.example.web3", types.RecordType_RECORD_TYPE_A)
+	require.Len(t, www, 1)
+	require.Equal(t, uint32(60), www[0].EffectiveTTL())
+	require.Empty(t, domain.RecordsAt("mail.example.web3", types.RecordType_RECORD_TYPE_UNSPECIFIED))
+}
@@ -0,0 +1,427 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dnsblockchain/dnsblockchain/v1/tld.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TLDStats holds the running statistics of a TLD, updated as domains are written and fees charged.
+type TLDStats struct {
+	Tld string `protobuf:"bytes,1,opt,name=tld,proto3" json:"tld,omitempty"`
+	// Number of domains registered under the TLD.
+	DomainCount uint64 `protobuf:"varint,2,opt,name=domain_count,json=domainCount,proto3" json:"domain_count,omitempty"`
+	// Sum of every domain fee charged for names under the TLD.
+	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
+}
+
+func (m *TLDStats) Reset()         { *m = TLDStats{} }
+func (m *TLDStats) String() string { return proto.CompactTextString(m) }
+func (*TLDStats) ProtoMessage()    {}
+func (*TLDStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1abccd3da2f9a1cd, []int{0}
+}
+func (m *TLDStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLDStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLDStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLDStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLDStats.Merge(m, src)
+}
+func (m *TLDStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLDStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLDStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLDStats proto.InternalMessageInfo
+
+func (m *TLDStats) GetTld() string {
+	if m != nil {
+		return m.Tld
+	}
+	return ""
+}
+
+func (m *TLDStats) GetDomainCount() uint64 {
+	if m != nil {
+		return m.DomainCount
+	}
+	return 0
+}
+
+func (m *TLDStats) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TLDStats)(nil), "dnsblockchain.dnsblockchain.v1.TLDStats")
+}
+
+func init() {
+	proto.RegisterFile("dnsblockchain/dnsblockchain/v1/tld.proto", fileDescriptor_1abccd3da2f9a1cd)
+}
+
+var fileDescriptor_1abccd3da2f9a1cd = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0x8a, 0x10, 0x75, 0x19, 0x50, 0xc4, 0x50, 0x3a, 0xb8, 0x01, 0x96, 0x2c, 0xd8,
+	0x04, 0x66, 0x96, 0x16, 0x31, 0x31, 0x05, 0x26, 0x96, 0xca, 0x71, 0x4c, 0x1a, 0x9a, 0xf8, 0x2a,
+	0x7c, 0x8d, 0xe0, 0x2d, 0x78, 0x0e, 0x78, 0x91, 0x8e, 0x1d, 0x99, 0x00, 0x25, 0x2f, 0x82, 0x92,
+	0x74, 0x09, 0x93, 0xff, 0xfb, 0xad, 0xfb, 0xf5, 0xdd, 0x4f, 0xfd, 0xd8, 0xd8, 0x28, 0x03, 0xb5,
+	0x50, 0x73, 0x99, 0x1a, 0xd1, 0x9d, 0x8a, 0x40, 0x60, 0x16, 0xf3, 0xe5, 0x0b, 0x20, 0xb8, 0xac,
+	0xf3, 0xc7, 0xbb, 0x53, 0x11, 0x8c, 0x98, 0x02, 0x9b, 0x83, 0x15, 0x91, 0xb4, 0x5a, 0x14, 0x41,
+	0xa4, 0x51, 0x06, 0x42, 0x41, 0x6a, 0xda, 0xfd, 0xd1, 0x51, 0x02, 0x09, 0x34, 0x52, 0xd4, 0xaa,
+	0x75, 0x4f, 0x3f, 0x09, 0xdd, 0x7f, 0xb8, 0xbb, 0xb9, 0x47, 0x89, 0xd6, 0x3d, 0xa4, 0x3d, 0xcc,
+	0xe2, 0x21, 0xf1, 0x88, 0xdf, 0x0f, 0x6b, 0xe9, 0x9e, 0xd0, 0x83, 0x18, 0x72, 0x99, 0x9a, 0x99,
+	0x82, 0x95, 0xc1, 0xe1, 0x8e, 0x47, 0xfc, 0xdd, 0x70, 0xd0, 0x7a, 0xd3, 0xda, 0x72, 0x9f, 0x29,
+	0x45, 0x40, 0x99, 0xcd, 0x9e, 0xb4, 0xb6, 0xc3, 0x9e, 0xd7, 0xf3, 0x07, 0x97, 0xc7, 0xbc, 0x85,
+	0xe1, 0x35, 0x0c, 0xdf, 0xc2, 0xf0, 0x29, 0xa4, 0x66, 0x72, 0xb1, 0xfe, 0x1e, 0x3b, 0x1f, 0x3f,
+	0x63, 0x3f, 0x49, 0x71, 0xbe, 0x8a, 0xb8, 0x82, 0x5c, 0x6c, 0xc9, 0xdb, 0xe7, 0xdc, 0xc6, 0x0b,
+	0x81, 0x6f, 0x4b, 0x6d, 0x9b, 0x05, 0x1b, 0xf6, 0x9b, 0xf8, 0x5b, 0xad, 0xed, 0xe4, 0x7a, 0x5d,
+	0x32, 0xb2, 0x29, 0x19, 0xf9, 0x2d, 0x19, 0x79, 0xaf, 0x98, 0xb3, 0xa9, 0x98, 0xf3, 0x55, 0x31,
+	0xe7, 0xf1, 0xac, 0xdb, 0xdc, 0xeb, 0xbf, 0x26, 0x9b, 0xbc, 0x68, 0xaf, 0xb9, 0xf9, 0xea, 0x2f,
+	0x00, 0x00, 0xff, 0xff, 0x32, 0x0a, 0x3d, 0x0f, 0x75, 0x01, 0x00, 0x00,
+}
+
+func (m *TLDStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLDStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLDStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTld(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DomainCount != 0 {
+		i = encodeVarintTld(dAtA, i, uint64(m.DomainCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tld) > 0 {
+		i -= len(m.Tld)
+		copy(dAtA[i:], m.Tld)
+		i = encodeVarintTld(dAtA, i, uint64(len(m.Tld)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTld(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTld(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TLDStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tld)
+	if l > 0 {
+		n += 1 + l + sovTld(uint64(l))
+	}
+	if m.DomainCount != 0 {
+		n += 1 + sovTld(uint64(m.DomainCount))
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovTld(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTld(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTld(x uint64) (n int) {
+	return sovTld(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TLDStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTld
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLDStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLDStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTld
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTld
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTld
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainCount", wireType)
+			}
+			m.DomainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTld
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTld
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTld
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTld
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFees = append(m.TotalFees, types.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTld(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTld
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTld(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTld
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTld
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTld
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTld
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTld
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTld
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTld        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTld          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTld = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,13 @@
+package types
+
+// DefaultTransferOfferPeriod is how long a transfer offer can be accepted (7 days).
+const DefaultTransferOfferPeriod uint64 = 7 * 24 * 60 * 60
+
+// TransferOfferWindow returns the seconds a transfer offer can be accepted for, falling back to
+// the default when unset.
+func (p Params) TransferOfferWindow() uint64 {
+	if p.TransferOfferPeriod == 0 {
+		return DefaultTransferOfferPeriod
+	}
+	return p.TransferOfferPeriod
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -125,12 +127,17 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 // MsgCreateDomain defines the MsgCreateDomain message.
+// Names with more than two labels create a subdomain, which only the owner of its active parent
+// can do. Subdomains are free and cannot outlive their parent.
 type MsgCreateDomain struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Owner   string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 	// string ns = 5; // Reemplazado o complementado por ns_records
 	NsRecords []*NSRecordWithIP `protobuf:"bytes,5,rep,name=ns_records,json=nsRecords,proto3" json:"ns_records,omitempty"`
+	// expiration se establece por el keeper, no en el mensaje
+	// Number of years to register the domain for; 0 registers it for one year.
+	Years uint64 `protobuf:"varint,6,opt,name=years,proto3" json:"years,omitempty"`
 }
 
 func (m *MsgCreateDomain) Reset()         { *m = MsgCreateDomain{} }
@@ -194,6 +201,13 @@ func (m *MsgCreateDomain) GetNsRecords() []*NSRecordWithIP {
 	return nil
 }
 
+func (m *MsgCreateDomain) GetYears() uint64 {
+	if m != nil {
+		return m.Years
+	}
+	return 0
+}
+
 // MsgCreateDomainResponse defines the MsgCreateDomainResponse message.
 type MsgCreateDomainResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -441,6 +455,9 @@ type MsgTransferDomain struct {
 	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// Must be set to move the domain at once, without the new owner accepting it. Meant for
+	// automated tooling; a mistyped new_owner loses the domain for good.
+	Direct bool `protobuf:"varint,4,opt,name=direct,proto3" json:"direct,omitempty"`
 }
 
 func (m *MsgTransferDomain) Reset()         { *m = MsgTransferDomain{} }
@@ -497,6 +514,13 @@ func (m *MsgTransferDomain) GetNewOwner() string {
 	return ""
 }
 
+func (m *MsgTransferDomain) GetDirect() bool {
+	if m != nil {
+		return m.Direct
+	}
+	return false
+}
+
 // MsgTransferDomainResponse defines the MsgTransferDomainResponse message.
 type MsgTransferDomainResponse struct {
 }
@@ -624,949 +648,8303 @@ func (m *MsgHeartbeatDomainResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgHeartbeatDomainResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "dnsblockchain.dnsblockchain.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgCreateDomain")
-	proto.RegisterType((*MsgCreateDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgCreateDomainResponse")
-	proto.RegisterType((*MsgUpdateDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgUpdateDomain")
-	proto.RegisterType((*MsgUpdateDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgUpdateDomainResponse")
-	proto.RegisterType((*MsgDeleteDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgDeleteDomain")
-	proto.RegisterType((*MsgDeleteDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgDeleteDomainResponse")
-	proto.RegisterType((*MsgTransferDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgTransferDomain")
-	proto.RegisterType((*MsgTransferDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgTransferDomainResponse")
-	proto.RegisterType((*MsgHeartbeatDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgHeartbeatDomain")
-	proto.RegisterType((*MsgHeartbeatDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgHeartbeatDomainResponse")
+// MsgRenewDomain defines the MsgRenewDomain message.
+type MsgRenewDomain struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Number of years to extend the current expiration by; 0 renews for one year.
+	Years uint64 `protobuf:"varint,3,opt,name=years,proto3" json:"years,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dnsblockchain/dnsblockchain/v1/tx.proto", fileDescriptor_a7ae1cda1295308e)
+func (m *MsgRenewDomain) Reset()         { *m = MsgRenewDomain{} }
+func (m *MsgRenewDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewDomain) ProtoMessage()    {}
+func (*MsgRenewDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{12}
 }
-
-var fileDescriptor_a7ae1cda1295308e = []byte{
-	// 646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0x8f, 0xd3, 0xa6, 0xe0, 0x6b, 0xd5, 0xaa, 0xa7, 0x4a, 0x4d, 0x0d, 0x32, 0x95, 0x91, 0xa0,
-	0x04, 0xd5, 0x56, 0x82, 0x00, 0x11, 0x09, 0x09, 0x42, 0x07, 0x3a, 0x04, 0x2a, 0x17, 0x84, 0xc4,
-	0x12, 0x39, 0xf6, 0xd5, 0xb1, 0xa8, 0xef, 0xa2, 0x3b, 0xd3, 0xa4, 0x0b, 0x02, 0x46, 0x26, 0x1e,
-	0x80, 0x07, 0x60, 0xcc, 0xc0, 0x43, 0x74, 0xac, 0x18, 0x10, 0x13, 0x42, 0x89, 0x44, 0x5e, 0x81,
-	0x11, 0xf9, 0x1c, 0xa7, 0xb6, 0x13, 0x35, 0x8e, 0x1a, 0x96, 0xca, 0x5f, 0xef, 0xfb, 0x7e, 0xff,
-	0x7c, 0x77, 0x0e, 0xb8, 0x69, 0x61, 0x56, 0x3f, 0x24, 0xe6, 0x1b, 0xb3, 0x61, 0x38, 0x58, 0x8b,
-	0x57, 0x47, 0x45, 0xcd, 0x6b, 0xab, 0x4d, 0x4a, 0x3c, 0x02, 0xe5, 0xd8, 0x92, 0x1a, 0xaf, 0x8e,
-	0x8a, 0xd2, 0xaa, 0xe1, 0x3a, 0x98, 0x68, 0xfc, 0x6f, 0x30, 0x22, 0xad, 0x9b, 0x84, 0xb9, 0x84,
-	0x69, 0x2e, 0xb3, 0x7d, 0x28, 0x97, 0xd9, 0x83, 0x85, 0x8d, 0x60, 0xa1, 0xc6, 0x2b, 0x2d, 0x28,
-	0x06, 0x4b, 0xb7, 0x27, 0xe8, 0x69, 0x1a, 0xd4, 0x70, 0xc3, 0xe6, 0x35, 0x9b, 0xd8, 0x24, 0x00,
-	0xf1, 0x9f, 0x52, 0x42, 0x58, 0xc4, 0xf5, 0x35, 0xf3, 0x66, 0xe5, 0x87, 0x00, 0x56, 0xaa, 0xcc,
-	0x7e, 0xd9, 0xb4, 0x0c, 0x0f, 0xed, 0x71, 0x70, 0x78, 0x0f, 0x88, 0xc6, 0x5b, 0xaf, 0x41, 0xa8,
-	0xe3, 0x1d, 0xe7, 0x85, 0x4d, 0x61, 0x4b, 0xac, 0xe4, 0xbf, 0x7f, 0xdb, 0x5e, 0x1b, 0x08, 0x7d,
-	0x6c, 0x59, 0x14, 0x31, 0xb6, 0xef, 0x51, 0x07, 0xdb, 0xfa, 0x59, 0x2b, 0xdc, 0x05, 0x0b, 0x81,
-	0xbc, 0x7c, 0x76, 0x53, 0xd8, 0x5a, 0x2c, 0xdd, 0x50, 0xcf, 0xcf, 0x4c, 0x0d, 0xf8, 0x2a, 0xe2,
-	0xc9, 0xaf, 0x6b, 0x99, 0xaf, 0xfd, 0x4e, 0x41, 0xd0, 0x07, 0x00, 0xe5, 0x47, 0x1f, 0xfb, 0x9d,
-	0xc2, 0x19, 0xf4, 0xa7, 0x7e, 0xa7, 0xb0, 0x1d, 0x37, 0xd2, 0x4e, 0x18, 0x4b, 0x98, 0x50, 0x36,
-	0xc0, 0x7a, 0xe2, 0x5f, 0x3a, 0x62, 0x4d, 0x82, 0x19, 0x52, 0xfe, 0x04, 0x9e, 0x9f, 0x50, 0x64,
-	0x78, 0x68, 0x87, 0xa7, 0x01, 0x4b, 0xe0, 0x92, 0xe9, 0xd7, 0x84, 0x4e, 0x74, 0x1c, 0x36, 0x42,
-	0x08, 0xe6, 0xb1, 0xe1, 0x22, 0xee, 0x56, 0xd4, 0xf9, 0x33, 0x54, 0x41, 0x8e, 0xb4, 0x30, 0xa2,
-	0xf9, 0xb9, 0x09, 0x28, 0x41, 0x1b, 0xac, 0x02, 0x80, 0x59, 0x8d, 0x22, 0x93, 0x50, 0x8b, 0xe5,
-	0x73, 0x9b, 0x73, 0x5b, 0x8b, 0x25, 0x75, 0x52, 0x6e, 0xcf, 0xf6, 0x75, 0x3e, 0xf0, 0xca, 0xf1,
-	0x1a, 0xbb, 0x7b, 0xba, 0x88, 0x59, 0x50, 0xb3, 0xf2, 0x92, 0x9f, 0x5b, 0x28, 0x50, 0xb9, 0xc5,
-	0x33, 0x88, 0xfa, 0x0c, 0x33, 0x80, 0xcb, 0x20, 0xeb, 0x58, 0xdc, 0xea, 0xbc, 0x9e, 0x75, 0x2c,
-	0xa5, 0x1b, 0xdd, 0x07, 0x17, 0xc8, 0x24, 0xc0, 0xcd, 0x86, 0xb8, 0x17, 0xcc, 0x63, 0x7e, 0xb6,
-	0x79, 0x44, 0xf7, 0x44, 0x3c, 0x0f, 0xc5, 0xe4, 0xf6, 0x77, 0xd0, 0x21, 0x9a, 0xa5, 0xfd, 0xb1,
-	0xfc, 0x51, 0x92, 0x21, 0xff, 0x17, 0x01, 0xac, 0x56, 0x99, 0xfd, 0x82, 0x1a, 0x98, 0x1d, 0x20,
-	0x3a, 0xc3, 0x37, 0x70, 0x17, 0x88, 0x18, 0xb5, 0x6a, 0xe9, 0xde, 0xc2, 0x65, 0x8c, 0x5a, 0xcf,
-	0xfd, 0xce, 0x84, 0xf2, 0x2b, 0x60, 0x63, 0x44, 0xdd, 0x50, 0xfb, 0x01, 0x80, 0x55, 0x66, 0x3f,
-	0x45, 0x06, 0xf5, 0xea, 0xc8, 0xf0, 0xfe, 0x5b, 0x7c, 0x57, 0x81, 0x34, 0xca, 0x13, 0xaa, 0x28,
-	0xfd, 0xcd, 0x81, 0xb9, 0x2a, 0xb3, 0x61, 0x1b, 0x2c, 0xc5, 0x6e, 0x33, 0x6d, 0xd2, 0xee, 0x49,
-	0x5c, 0x13, 0xd2, 0xfd, 0x29, 0x07, 0x86, 0x67, 0xaa, 0x0d, 0x96, 0x62, 0x77, 0x4a, 0x1a, 0xe6,
-	0xe8, 0x40, 0x2a, 0xe6, 0xb1, 0xa7, 0x79, 0xe8, 0x79, 0x0a, 0xe6, 0xe8, 0xc0, 0x14, 0x9e, 0x47,
-	0x99, 0x63, 0x87, 0x26, 0x0d, 0x73, 0x74, 0x20, 0x15, 0xf3, 0xb8, 0x13, 0x03, 0xdf, 0x81, 0xe5,
-	0xc4, 0x69, 0x29, 0xa6, 0x80, 0x8a, 0x8f, 0x48, 0x0f, 0xa6, 0x1e, 0x19, 0xf2, 0x7f, 0x10, 0xc0,
-	0xca, 0xc8, 0x9e, 0x4f, 0x01, 0x97, 0x98, 0x91, 0xca, 0xd3, 0xcf, 0x84, 0x1a, 0xa4, 0xdc, 0x7b,
-	0xff, 0xab, 0x59, 0x79, 0x78, 0xd2, 0x95, 0x85, 0xd3, 0xae, 0x2c, 0xfc, 0xee, 0xca, 0xc2, 0xe7,
-	0x9e, 0x9c, 0x39, 0xed, 0xc9, 0x99, 0x9f, 0x3d, 0x39, 0xf3, 0xfa, 0xfa, 0xf9, 0x1f, 0x4d, 0xef,
-	0xb8, 0x89, 0x58, 0x7d, 0x81, 0xff, 0x14, 0xb8, 0xf3, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x18, 0xcb,
-	0x5e, 0x45, 0x0c, 0x09, 0x00, 0x00,
+func (m *MsgRenewDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewDomain.Merge(m, src)
+}
+func (m *MsgRenewDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewDomain.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgRenewDomain proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgRenewDomain) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateDomain defines the CreateDomain RPC.
-	CreateDomain(ctx context.Context, in *MsgCreateDomain, opts ...grpc.CallOption) (*MsgCreateDomainResponse, error)
-	// UpdateDomain defines the UpdateDomain RPC.
-	UpdateDomain(ctx context.Context, in *MsgUpdateDomain, opts ...grpc.CallOption) (*MsgUpdateDomainResponse, error)
-	// DeleteDomain defines the DeleteDomain RPC.
-	DeleteDomain(ctx context.Context, in *MsgDeleteDomain, opts ...grpc.CallOption) (*MsgDeleteDomainResponse, error)
-	// TransferDomain defines the TransferDomain RPC.
-	TransferDomain(ctx context.Context, in *MsgTransferDomain, opts ...grpc.CallOption) (*MsgTransferDomainResponse, error)
-	// HeartbeatDomain defines the HeartbeatDomain RPC.
-	HeartbeatDomain(ctx context.Context, in *MsgHeartbeatDomain, opts ...grpc.CallOption) (*MsgHeartbeatDomainResponse, error)
+func (m *MsgRenewDomain) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRenewDomain) GetYears() uint64 {
+	if m != nil {
+		return m.Years
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgRenewDomainResponse defines the MsgRenewDomainResponse message.
+type MsgRenewDomainResponse struct {
+	Expiration uint64 `protobuf:"varint,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRenewDomainResponse) Reset()         { *m = MsgRenewDomainResponse{} }
+func (m *MsgRenewDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewDomainResponse) ProtoMessage()    {}
+func (*MsgRenewDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{13}
+}
+func (m *MsgRenewDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRenewDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewDomainResponse.Merge(m, src)
+}
+func (m *MsgRenewDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewDomainResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateDomain(ctx context.Context, in *MsgCreateDomain, opts ...grpc.CallOption) (*MsgCreateDomainResponse, error) {
-	out := new(MsgCreateDomainResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/CreateDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRenewDomainResponse proto.InternalMessageInfo
+
+func (m *MsgRenewDomainResponse) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateDomain(ctx context.Context, in *MsgUpdateDomain, opts ...grpc.CallOption) (*MsgUpdateDomainResponse, error) {
-	out := new(MsgUpdateDomainResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/UpdateDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgSetDomainRecords replaces every resource record of a domain. Only the owner can sign it.
+type MsgSetDomainRecords struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The new record set; empty removes every record.
+	Records []*ResourceRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	// Also remove the domain's NS delegation, and with it any DS records, so resolvers answer from
+	// the records instead.
+	ClearNsRecords bool `protobuf:"varint,4,opt,name=clear_ns_records,json=clearNsRecords,proto3" json:"clear_ns_records,omitempty"`
+}
+
+func (m *MsgSetDomainRecords) Reset()         { *m = MsgSetDomainRecords{} }
+func (m *MsgSetDomainRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDomainRecords) ProtoMessage()    {}
+func (*MsgSetDomainRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{14}
+}
+func (m *MsgSetDomainRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDomainRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDomainRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetDomainRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDomainRecords.Merge(m, src)
+}
+func (m *MsgSetDomainRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDomainRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDomainRecords.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteDomain(ctx context.Context, in *MsgDeleteDomain, opts ...grpc.CallOption) (*MsgDeleteDomainResponse, error) {
-	out := new(MsgDeleteDomainResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/DeleteDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetDomainRecords proto.InternalMessageInfo
+
+func (m *MsgSetDomainRecords) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) TransferDomain(ctx context.Context, in *MsgTransferDomain, opts ...grpc.CallOption) (*MsgTransferDomainResponse, error) {
-	out := new(MsgTransferDomainResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/TransferDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetDomainRecords) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) HeartbeatDomain(ctx context.Context, in *MsgHeartbeatDomain, opts ...grpc.CallOption) (*MsgHeartbeatDomainResponse, error) {
-	out := new(MsgHeartbeatDomainResponse)
-	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/HeartbeatDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetDomainRecords) GetRecords() []*ResourceRecord {
+	if m != nil {
+		return m.Records
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateDomain defines the CreateDomain RPC.
-	CreateDomain(context.Context, *MsgCreateDomain) (*MsgCreateDomainResponse, error)
-	// UpdateDomain defines the UpdateDomain RPC.
-	UpdateDomain(context.Context, *MsgUpdateDomain) (*MsgUpdateDomainResponse, error)
-	// DeleteDomain defines the DeleteDomain RPC.
-	DeleteDomain(context.Context, *MsgDeleteDomain) (*MsgDeleteDomainResponse, error)
-	// TransferDomain defines the TransferDomain RPC.
-	TransferDomain(context.Context, *MsgTransferDomain) (*MsgTransferDomainResponse, error)
-	// HeartbeatDomain defines the HeartbeatDomain RPC.
-	HeartbeatDomain(context.Context, *MsgHeartbeatDomain) (*MsgHeartbeatDomainResponse, error)
+func (m *MsgSetDomainRecords) GetClearNsRecords() bool {
+	if m != nil {
+		return m.ClearNsRecords
+	}
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSetDomainRecordsResponse defines the MsgSetDomainRecordsResponse message.
+type MsgSetDomainRecordsResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSetDomainRecordsResponse) Reset()         { *m = MsgSetDomainRecordsResponse{} }
+func (m *MsgSetDomainRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDomainRecordsResponse) ProtoMessage()    {}
+func (*MsgSetDomainRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{15}
 }
-func (*UnimplementedMsgServer) CreateDomain(ctx context.Context, req *MsgCreateDomain) (*MsgCreateDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDomain not implemented")
+func (m *MsgSetDomainRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateDomain(ctx context.Context, req *MsgUpdateDomain) (*MsgUpdateDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDomain not implemented")
+func (m *MsgSetDomainRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDomainRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteDomain(ctx context.Context, req *MsgDeleteDomain) (*MsgDeleteDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDomain not implemented")
+func (m *MsgSetDomainRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDomainRecordsResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) TransferDomain(ctx context.Context, req *MsgTransferDomain) (*MsgTransferDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferDomain not implemented")
+func (m *MsgSetDomainRecordsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) HeartbeatDomain(ctx context.Context, req *MsgHeartbeatDomain) (*MsgHeartbeatDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeartbeatDomain not implemented")
+func (m *MsgSetDomainRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDomainRecordsResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetDomainRecordsResponse proto.InternalMessageInfo
+
+// MsgSetDomainDSRecords replaces every DS record of a domain. Only the owner can sign it, and the
+// domain must have an NS delegation for DS records to be set.
+type MsgSetDomainDSRecords struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The new DS record set; empty removes every DS record and turns DNSSEC off for the delegation.
+	DsRecords []*DSRecord `protobuf:"bytes,3,rep,name=ds_records,json=dsRecords,proto3" json:"ds_records,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+func (m *MsgSetDomainDSRecords) Reset()         { *m = MsgSetDomainDSRecords{} }
+func (m *MsgSetDomainDSRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDomainDSRecords) ProtoMessage()    {}
+func (*MsgSetDomainDSRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{16}
+}
+func (m *MsgSetDomainDSRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDomainDSRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDomainDSRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/UpdateParams",
+}
+func (m *MsgSetDomainDSRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDomainDSRecords.Merge(m, src)
+}
+func (m *MsgSetDomainDSRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDomainDSRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDomainDSRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDomainDSRecords proto.InternalMessageInfo
+
+func (m *MsgSetDomainDSRecords) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgSetDomainDSRecords) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDomain)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetDomainDSRecords) GetDsRecords() []*DSRecord {
+	if m != nil {
+		return m.DsRecords
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/CreateDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDomain(ctx, req.(*MsgCreateDomain))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDomain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/UpdateDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDomain(ctx, req.(*MsgUpdateDomain))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetDomainDSRecordsResponse defines the MsgSetDomainDSRecordsResponse message.
+type MsgSetDomainDSRecordsResponse struct {
 }
 
-func _Msg_DeleteDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteDomain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/DeleteDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteDomain(ctx, req.(*MsgDeleteDomain))
+func (m *MsgSetDomainDSRecordsResponse) Reset()         { *m = MsgSetDomainDSRecordsResponse{} }
+func (m *MsgSetDomainDSRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDomainDSRecordsResponse) ProtoMessage()    {}
+func (*MsgSetDomainDSRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{17}
+}
+func (m *MsgSetDomainDSRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDomainDSRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDomainDSRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetDomainDSRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDomainDSRecordsResponse.Merge(m, src)
+}
+func (m *MsgSetDomainDSRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDomainDSRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDomainDSRecordsResponse.DiscardUnknown(m)
 }
 
-func _Msg_TransferDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferDomain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/TransferDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferDomain(ctx, req.(*MsgTransferDomain))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetDomainDSRecordsResponse proto.InternalMessageInfo
+
+// MsgCommitDomain commits to registering a name without revealing it. The hash is computed by
+// CommitmentHash from the name, the owner, the signer and a secret salt. Params.commit_deposit is
+// held until the commitment is revealed or pruned.
+type MsgCommitDomain struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Hash    []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func _Msg_HeartbeatDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgHeartbeatDomain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HeartbeatDomain(ctx, in)
+func (m *MsgCommitDomain) Reset()         { *m = MsgCommitDomain{} }
+func (m *MsgCommitDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitDomain) ProtoMessage()    {}
+func (*MsgCommitDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{18}
+}
+func (m *MsgCommitDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/HeartbeatDomain",
+}
+func (m *MsgCommitDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitDomain.Merge(m, src)
+}
+func (m *MsgCommitDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitDomain proto.InternalMessageInfo
+
+func (m *MsgCommitDomain) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HeartbeatDomain(ctx, req.(*MsgHeartbeatDomain))
+	return ""
+}
+
+func (m *MsgCommitDomain) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dnsblockchain.dnsblockchain.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateDomain",
-			Handler:    _Msg_CreateDomain_Handler,
-		},
-		{
-			MethodName: "UpdateDomain",
-			Handler:    _Msg_UpdateDomain_Handler,
-		},
-		{
-			MethodName: "DeleteDomain",
-			Handler:    _Msg_DeleteDomain_Handler,
-		},
-		{
-			MethodName: "TransferDomain",
-			Handler:    _Msg_TransferDomain_Handler,
-		},
-		{
-			MethodName: "HeartbeatDomain",
-			Handler:    _Msg_HeartbeatDomain_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dnsblockchain/dnsblockchain/v1/tx.proto",
+// MsgCommitDomainResponse defines the MsgCommitDomainResponse message.
+type MsgCommitDomainResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCommitDomainResponse) Reset()         { *m = MsgCommitDomainResponse{} }
+func (m *MsgCommitDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitDomainResponse) ProtoMessage()    {}
+func (*MsgCommitDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{19}
+}
+func (m *MsgCommitDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCommitDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitDomainResponse.Merge(m, src)
+}
+func (m *MsgCommitDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitDomainResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCommitDomainResponse proto.InternalMessageInfo
+
+// MsgRevealDomain registers a committed name as MsgCreateDomain would, between
+// Params.commit_min_blocks and Params.commit_max_blocks after the commitment, and refunds its
+// deposit. It must be signed by the committer. If the name was taken in the meantime, the
+// commitment is dropped and its deposit refunded instead.
+type MsgRevealDomain struct {
+	Creator   string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name      string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner     string            `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	NsRecords []*NSRecordWithIP `protobuf:"bytes,4,rep,name=ns_records,json=nsRecords,proto3" json:"ns_records,omitempty"`
+	// Number of years to register the domain for; 0 registers it for one year.
+	Years uint64 `protobuf:"varint,5,opt,name=years,proto3" json:"years,omitempty"`
+	// The salt the commitment was made with.
+	Salt string `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRevealDomain) Reset()         { *m = MsgRevealDomain{} }
+func (m *MsgRevealDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealDomain) ProtoMessage()    {}
+func (*MsgRevealDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{20}
+}
+func (m *MsgRevealDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRevealDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealDomain.Merge(m, src)
+}
+func (m *MsgRevealDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealDomain proto.InternalMessageInfo
+
+func (m *MsgRevealDomain) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealDomain) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevealDomain) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevealDomain) GetNsRecords() []*NSRecordWithIP {
+	if m != nil {
+		return m.NsRecords
+	}
+	return nil
 }
 
-func (m *MsgCreateDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealDomain) GetYears() uint64 {
+	if m != nil {
+		return m.Years
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevealDomain) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
 }
 
-func (m *MsgCreateDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NsRecords) > 0 {
-		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+// MsgRevealDomainResponse defines the MsgRevealDomainResponse message.
+type MsgRevealDomainResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Set when the name was taken before the reveal: no domain was registered and the deposit was
+	// refunded.
+	NameTaken bool `protobuf:"varint,2,opt,name=name_taken,json=nameTaken,proto3" json:"name_taken,omitempty"`
+}
+
+func (m *MsgRevealDomainResponse) Reset()         { *m = MsgRevealDomainResponse{} }
+func (m *MsgRevealDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealDomainResponse) ProtoMessage()    {}
+func (*MsgRevealDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{21}
+}
+func (m *MsgRevealDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRevealDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealDomainResponse.Merge(m, src)
+}
+func (m *MsgRevealDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealDomainResponse proto.InternalMessageInfo
+
+func (m *MsgRevealDomainResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealDomainResponse) GetNameTaken() bool {
+	if m != nil {
+		return m.NameTaken
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgCreateDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOpenAuction opens an auction for a name that is otherwise available and either lies directly
+// under a TLD in its launch phase or is a premium name. Direct registration of the name is blocked
+// until the auction is settled. The opener escrows the auction's minimum price, which is refunded
+// once a bid is revealed and forfeited if none is. The minimum price is the name's one-year
+// registration fee, which must be a single nonzero coin.
+type MsgOpenAuction struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *MsgCreateDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgOpenAuction) Reset()         { *m = MsgOpenAuction{} }
+func (m *MsgOpenAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenAuction) ProtoMessage()    {}
+func (*MsgOpenAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{22}
+}
+func (m *MsgOpenAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgOpenAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenAuction.Merge(m, src)
+}
+func (m *MsgOpenAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenAuction.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgOpenAuction proto.InternalMessageInfo
+
+func (m *MsgOpenAuction) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenAuction) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *MsgUpdateDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NsRecords) > 0 {
-		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+// MsgOpenAuctionResponse defines the MsgOpenAuctionResponse message.
+type MsgOpenAuctionResponse struct {
+	BidEnd    uint64 `protobuf:"varint,1,opt,name=bid_end,json=bidEnd,proto3" json:"bid_end,omitempty"`
+	RevealEnd uint64 `protobuf:"varint,2,opt,name=reveal_end,json=revealEnd,proto3" json:"reveal_end,omitempty"`
+}
+
+func (m *MsgOpenAuctionResponse) Reset()         { *m = MsgOpenAuctionResponse{} }
+func (m *MsgOpenAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenAuctionResponse) ProtoMessage()    {}
+func (*MsgOpenAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{23}
+}
+func (m *MsgOpenAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgOpenAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenAuctionResponse.Merge(m, src)
+}
+func (m *MsgOpenAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenAuctionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgOpenAuctionResponse proto.InternalMessageInfo
+
+func (m *MsgOpenAuctionResponse) GetBidEnd() uint64 {
+	if m != nil {
+		return m.BidEnd
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenAuctionResponse) GetRevealEnd() uint64 {
+	if m != nil {
+		return m.RevealEnd
+	}
+	return 0
 }
 
-func (m *MsgUpdateDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgPlaceBid places a sealed bid, the hash computed by BidHash, escrowing a deposit that must
+// cover the bid. Each address bids once per auction.
+type MsgPlaceBid struct {
+	Creator   string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name      string                                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SealedBid []byte                                   `protobuf:"bytes,3,opt,name=sealed_bid,json=sealedBid,proto3" json:"sealed_bid,omitempty"`
+	Deposit   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
-func (m *MsgDeleteDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceBid) Reset()         { *m = MsgPlaceBid{} }
+func (m *MsgPlaceBid) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBid) ProtoMessage()    {}
+func (*MsgPlaceBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{24}
+}
+func (m *MsgPlaceBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPlaceBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBid.Merge(m, src)
+}
+func (m *MsgPlaceBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBid.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPlaceBid proto.InternalMessageInfo
+
+func (m *MsgPlaceBid) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgDeleteDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgPlaceBid) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgPlaceBid) GetSealedBid() []byte {
+	if m != nil {
+		return m.SealedBid
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgDeleteDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceBid) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeleteDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgPlaceBidResponse defines the MsgPlaceBidResponse message.
+type MsgPlaceBidResponse struct {
 }
 
-func (m *MsgDeleteDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPlaceBidResponse) Reset()         { *m = MsgPlaceBidResponse{} }
+func (m *MsgPlaceBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBidResponse) ProtoMessage()    {}
+func (*MsgPlaceBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{25}
 }
-
-func (m *MsgTransferDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgTransferDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPlaceBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBidResponse.Merge(m, src)
+}
+func (m *MsgPlaceBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBidResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTransferDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgPlaceBidResponse proto.InternalMessageInfo
+
+// MsgRevealBid reveals the amount and salt of a sealed bid during the reveal period. Bids left
+// unrevealed forfeit Params.auction_forfeit_bps of their deposit at settlement.
+type MsgRevealBid struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name    string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Salt    string     `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	// Name servers to register the name with if the bid wins.
+	NsRecords []*NSRecordWithIP `protobuf:"bytes,5,rep,name=ns_records,json=nsRecords,proto3" json:"ns_records,omitempty"`
 }
 
-func (m *MsgTransferDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBid) Reset()         { *m = MsgRevealBid{} }
+func (m *MsgRevealBid) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBid) ProtoMessage()    {}
+func (*MsgRevealBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{26}
+}
+func (m *MsgRevealBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgTransferDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevealBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBid.Merge(m, src)
 }
-
-func (m *MsgTransferDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevealBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBid.DiscardUnknown(m)
 }
 
-func (m *MsgHeartbeatDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevealBid proto.InternalMessageInfo
+
+func (m *MsgRevealBid) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgHeartbeatDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevealBid) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *MsgHeartbeatDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRevealBid) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgHeartbeatDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBid) GetSalt() string {
+	if m != nil {
+		return m.Salt
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgHeartbeatDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevealBid) GetNsRecords() []*NSRecordWithIP {
+	if m != nil {
+		return m.NsRecords
+	}
+	return nil
 }
 
-func (m *MsgHeartbeatDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRevealBidResponse defines the MsgRevealBidResponse message.
+type MsgRevealBidResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgRevealBidResponse) Reset()         { *m = MsgRevealBidResponse{} }
+func (m *MsgRevealBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBidResponse) ProtoMessage()    {}
+func (*MsgRevealBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{27}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRevealBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgRevealBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBidResponse.Merge(m, src)
+}
+func (m *MsgRevealBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBidResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgRevealBidResponse proto.InternalMessageInfo
+
+// MsgOfferDomainTransfer offers a domain to new_owner until Params.TransferOfferPeriod from now.
+// It replaces any pending offer of the domain.
+type MsgOfferDomainTransfer struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func (m *MsgCreateDomain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.NsRecords) > 0 {
-		for _, e := range m.NsRecords {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgOfferDomainTransfer) Reset()         { *m = MsgOfferDomainTransfer{} }
+func (m *MsgOfferDomainTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgOfferDomainTransfer) ProtoMessage()    {}
+func (*MsgOfferDomainTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{28}
+}
+func (m *MsgOfferDomainTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOfferDomainTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOfferDomainTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgOfferDomainTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOfferDomainTransfer.Merge(m, src)
+}
+func (m *MsgOfferDomainTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOfferDomainTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOfferDomainTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgCreateDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+var xxx_messageInfo_MsgOfferDomainTransfer proto.InternalMessageInfo
+
+func (m *MsgOfferDomainTransfer) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateDomain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOfferDomainTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgOfferDomainTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return ""
+}
+
+// MsgOfferDomainTransferResponse defines the MsgOfferDomainTransferResponse message.
+type MsgOfferDomainTransferResponse struct {
+	Expires uint64 `protobuf:"varint,1,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (m *MsgOfferDomainTransferResponse) Reset()         { *m = MsgOfferDomainTransferResponse{} }
+func (m *MsgOfferDomainTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOfferDomainTransferResponse) ProtoMessage()    {}
+func (*MsgOfferDomainTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{29}
+}
+func (m *MsgOfferDomainTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOfferDomainTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOfferDomainTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgOfferDomainTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOfferDomainTransferResponse.Merge(m, src)
+}
+func (m *MsgOfferDomainTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOfferDomainTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOfferDomainTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOfferDomainTransferResponse proto.InternalMessageInfo
+
+func (m *MsgOfferDomainTransferResponse) GetExpires() uint64 {
+	if m != nil {
+		return m.Expires
 	}
-	if len(m.NsRecords) > 0 {
-		for _, e := range m.NsRecords {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgAcceptDomainTransfer accepts the pending transfer offer of a domain made to the signer, who
+// pays the transfer fee.
+type MsgAcceptDomainTransfer struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgAcceptDomainTransfer) Reset()         { *m = MsgAcceptDomainTransfer{} }
+func (m *MsgAcceptDomainTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDomainTransfer) ProtoMessage()    {}
+func (*MsgAcceptDomainTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{30}
+}
+func (m *MsgAcceptDomainTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDomainTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDomainTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgAcceptDomainTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDomainTransfer.Merge(m, src)
+}
+func (m *MsgAcceptDomainTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDomainTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDomainTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgAcceptDomainTransfer proto.InternalMessageInfo
+
+func (m *MsgAcceptDomainTransfer) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgDeleteDomain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptDomainTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgAcceptDomainTransferResponse defines the MsgAcceptDomainTransferResponse message.
+type MsgAcceptDomainTransferResponse struct {
+}
+
+func (m *MsgAcceptDomainTransferResponse) Reset()         { *m = MsgAcceptDomainTransferResponse{} }
+func (m *MsgAcceptDomainTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDomainTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptDomainTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{31}
+}
+func (m *MsgAcceptDomainTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDomainTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDomainTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+}
+func (m *MsgAcceptDomainTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDomainTransferResponse.Merge(m, src)
+}
+func (m *MsgAcceptDomainTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDomainTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDomainTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDomainTransferResponse proto.InternalMessageInfo
+
+// MsgCancelDomainTransfer removes the pending transfer offer of a domain. The offer's maker, the
+// domain's current creator and the recipient can cancel it.
+type MsgCancelDomainTransfer struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelDomainTransfer) Reset()         { *m = MsgCancelDomainTransfer{} }
+func (m *MsgCancelDomainTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDomainTransfer) ProtoMessage()    {}
+func (*MsgCancelDomainTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{32}
+}
+func (m *MsgCancelDomainTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDomainTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDomainTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgCancelDomainTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDomainTransfer.Merge(m, src)
+}
+func (m *MsgCancelDomainTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDomainTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDomainTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgCancelDomainTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelDomainTransfer) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgTransferDomain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelDomainTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgCancelDomainTransferResponse defines the MsgCancelDomainTransferResponse message.
+type MsgCancelDomainTransferResponse struct {
+}
+
+func (m *MsgCancelDomainTransferResponse) Reset()         { *m = MsgCancelDomainTransferResponse{} }
+func (m *MsgCancelDomainTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDomainTransferResponse) ProtoMessage()    {}
+func (*MsgCancelDomainTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{33}
+}
+func (m *MsgCancelDomainTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDomainTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDomainTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+}
+func (m *MsgCancelDomainTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDomainTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelDomainTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDomainTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDomainTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDomainTransferResponse proto.InternalMessageInfo
+
+// MsgListDomain lists a domain for sale at price, replacing any previous listing of it. Whoever
+// may transfer the domain may list it.
+type MsgListDomain struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Price   types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *MsgListDomain) Reset()         { *m = MsgListDomain{} }
+func (m *MsgListDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgListDomain) ProtoMessage()    {}
+func (*MsgListDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{34}
+}
+func (m *MsgListDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgListDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgListDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListDomain.Merge(m, src)
+}
+func (m *MsgListDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgListDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgListDomain proto.InternalMessageInfo
+
+func (m *MsgListDomain) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *MsgTransferDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgListDomain) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgHeartbeatDomain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgListDomain) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return types.Coin{}
+}
+
+// MsgListDomainResponse defines the MsgListDomainResponse message.
+type MsgListDomainResponse struct {
+}
+
+func (m *MsgListDomainResponse) Reset()         { *m = MsgListDomainResponse{} }
+func (m *MsgListDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgListDomainResponse) ProtoMessage()    {}
+func (*MsgListDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{35}
+}
+func (m *MsgListDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgListDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+}
+func (m *MsgListDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListDomainResponse.Merge(m, src)
+}
+func (m *MsgListDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgListDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgListDomainResponse proto.InternalMessageInfo
+
+// MsgUnlistDomain removes the listing of a domain.
+type MsgUnlistDomain struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgUnlistDomain) Reset()         { *m = MsgUnlistDomain{} }
+func (m *MsgUnlistDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlistDomain) ProtoMessage()    {}
+func (*MsgUnlistDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{36}
+}
+func (m *MsgUnlistDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlistDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlistDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUnlistDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlistDomain.Merge(m, src)
+}
+func (m *MsgUnlistDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlistDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlistDomain.DiscardUnknown(m)
 }
 
-func (m *MsgHeartbeatDomainResponse) Size() (n int) {
-	if m == nil {
+var xxx_messageInfo_MsgUnlistDomain proto.InternalMessageInfo
+
+func (m *MsgUnlistDomain) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUnlistDomain) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgUnlistDomainResponse defines the MsgUnlistDomainResponse message.
+type MsgUnlistDomainResponse struct {
+}
+
+func (m *MsgUnlistDomainResponse) Reset()         { *m = MsgUnlistDomainResponse{} }
+func (m *MsgUnlistDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlistDomainResponse) ProtoMessage()    {}
+func (*MsgUnlistDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{37}
+}
+func (m *MsgUnlistDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlistDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlistDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlistDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlistDomainResponse.Merge(m, src)
+}
+func (m *MsgUnlistDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlistDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlistDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlistDomainResponse proto.InternalMessageInfo
+
+// MsgBuyDomain buys a listed domain: the signer pays the listed price and the transfer fee and
+// becomes the domain's creator and owner. price must match the listing, so a listing repriced
+// while the transaction is in flight is not bought.
+type MsgBuyDomain struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Price   types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *MsgBuyDomain) Reset()         { *m = MsgBuyDomain{} }
+func (m *MsgBuyDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyDomain) ProtoMessage()    {}
+func (*MsgBuyDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{38}
+}
+func (m *MsgBuyDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyDomain.Merge(m, src)
+}
+func (m *MsgBuyDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyDomain proto.InternalMessageInfo
+
+func (m *MsgBuyDomain) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgBuyDomain) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgBuyDomain) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+// MsgBuyDomainResponse defines the MsgBuyDomainResponse message.
+type MsgBuyDomainResponse struct {
+}
+
+func (m *MsgBuyDomainResponse) Reset()         { *m = MsgBuyDomainResponse{} }
+func (m *MsgBuyDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyDomainResponse) ProtoMessage()    {}
+func (*MsgBuyDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{39}
+}
+func (m *MsgBuyDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyDomainResponse.Merge(m, src)
+}
+func (m *MsgBuyDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyDomainResponse proto.InternalMessageInfo
+
+// MsgPlaceMarketOffer offers amount for a domain and escrows it. It replaces and refunds any
+// previous offer of the signer for the domain. Offers lapse once the domain changes hands or is
+// deleted, and are then refunded when withdrawn.
+type MsgPlaceMarketOffer struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgPlaceMarketOffer) Reset()         { *m = MsgPlaceMarketOffer{} }
+func (m *MsgPlaceMarketOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceMarketOffer) ProtoMessage()    {}
+func (*MsgPlaceMarketOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{40}
+}
+func (m *MsgPlaceMarketOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceMarketOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceMarketOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceMarketOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceMarketOffer.Merge(m, src)
+}
+func (m *MsgPlaceMarketOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceMarketOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceMarketOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceMarketOffer proto.InternalMessageInfo
+
+func (m *MsgPlaceMarketOffer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgPlaceMarketOffer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgPlaceMarketOffer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgPlaceMarketOfferResponse defines the MsgPlaceMarketOfferResponse message.
+type MsgPlaceMarketOfferResponse struct {
+}
+
+func (m *MsgPlaceMarketOfferResponse) Reset()         { *m = MsgPlaceMarketOfferResponse{} }
+func (m *MsgPlaceMarketOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceMarketOfferResponse) ProtoMessage()    {}
+func (*MsgPlaceMarketOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{41}
+}
+func (m *MsgPlaceMarketOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceMarketOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceMarketOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceMarketOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceMarketOfferResponse.Merge(m, src)
+}
+func (m *MsgPlaceMarketOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceMarketOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceMarketOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceMarketOfferResponse proto.InternalMessageInfo
+
+// MsgWithdrawMarketOffer withdraws the signer's offer for a domain and refunds it.
+type MsgWithdrawMarketOffer struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgWithdrawMarketOffer) Reset()         { *m = MsgWithdrawMarketOffer{} }
+func (m *MsgWithdrawMarketOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawMarketOffer) ProtoMessage()    {}
+func (*MsgWithdrawMarketOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{42}
+}
+func (m *MsgWithdrawMarketOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawMarketOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawMarketOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawMarketOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawMarketOffer.Merge(m, src)
+}
+func (m *MsgWithdrawMarketOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawMarketOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawMarketOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawMarketOffer proto.InternalMessageInfo
+
+func (m *MsgWithdrawMarketOffer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgWithdrawMarketOffer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgWithdrawMarketOfferResponse defines the MsgWithdrawMarketOfferResponse message.
+type MsgWithdrawMarketOfferResponse struct {
+}
+
+func (m *MsgWithdrawMarketOfferResponse) Reset()         { *m = MsgWithdrawMarketOfferResponse{} }
+func (m *MsgWithdrawMarketOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawMarketOfferResponse) ProtoMessage()    {}
+func (*MsgWithdrawMarketOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{43}
+}
+func (m *MsgWithdrawMarketOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawMarketOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawMarketOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawMarketOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawMarketOfferResponse.Merge(m, src)
+}
+func (m *MsgWithdrawMarketOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawMarketOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawMarketOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawMarketOfferResponse proto.InternalMessageInfo
+
+// MsgAcceptMarketOffer sells a domain to buyer for the amount of their offer. The signer, who must
+// be allowed to transfer the domain, receives the amount and pays the transfer fee.
+type MsgAcceptMarketOffer struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Buyer   string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+}
+
+func (m *MsgAcceptMarketOffer) Reset()         { *m = MsgAcceptMarketOffer{} }
+func (m *MsgAcceptMarketOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptMarketOffer) ProtoMessage()    {}
+func (*MsgAcceptMarketOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{44}
+}
+func (m *MsgAcceptMarketOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptMarketOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptMarketOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptMarketOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptMarketOffer.Merge(m, src)
+}
+func (m *MsgAcceptMarketOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptMarketOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptMarketOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptMarketOffer proto.InternalMessageInfo
+
+func (m *MsgAcceptMarketOffer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAcceptMarketOffer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgAcceptMarketOffer) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+// MsgAcceptMarketOfferResponse defines the MsgAcceptMarketOfferResponse message.
+type MsgAcceptMarketOfferResponse struct {
+}
+
+func (m *MsgAcceptMarketOfferResponse) Reset()         { *m = MsgAcceptMarketOfferResponse{} }
+func (m *MsgAcceptMarketOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptMarketOfferResponse) ProtoMessage()    {}
+func (*MsgAcceptMarketOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7ae1cda1295308e, []int{45}
+}
+func (m *MsgAcceptMarketOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptMarketOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptMarketOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptMarketOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptMarketOfferResponse.Merge(m, src)
+}
+func (m *MsgAcceptMarketOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptMarketOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptMarketOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptMarketOfferResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "dnsblockchain.dnsblockchain.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgCreateDomain")
+	proto.RegisterType((*MsgCreateDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgCreateDomainResponse")
+	proto.RegisterType((*MsgUpdateDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgUpdateDomain")
+	proto.RegisterType((*MsgUpdateDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgUpdateDomainResponse")
+	proto.RegisterType((*MsgDeleteDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgDeleteDomain")
+	proto.RegisterType((*MsgDeleteDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgDeleteDomainResponse")
+	proto.RegisterType((*MsgTransferDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgTransferDomain")
+	proto.RegisterType((*MsgTransferDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgTransferDomainResponse")
+	proto.RegisterType((*MsgHeartbeatDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgHeartbeatDomain")
+	proto.RegisterType((*MsgHeartbeatDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgHeartbeatDomainResponse")
+	proto.RegisterType((*MsgRenewDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgRenewDomain")
+	proto.RegisterType((*MsgRenewDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgRenewDomainResponse")
+	proto.RegisterType((*MsgSetDomainRecords)(nil), "dnsblockchain.dnsblockchain.v1.MsgSetDomainRecords")
+	proto.RegisterType((*MsgSetDomainRecordsResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgSetDomainRecordsResponse")
+	proto.RegisterType((*MsgSetDomainDSRecords)(nil), "dnsblockchain.dnsblockchain.v1.MsgSetDomainDSRecords")
+	proto.RegisterType((*MsgSetDomainDSRecordsResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgSetDomainDSRecordsResponse")
+	proto.RegisterType((*MsgCommitDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgCommitDomain")
+	proto.RegisterType((*MsgCommitDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgCommitDomainResponse")
+	proto.RegisterType((*MsgRevealDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgRevealDomain")
+	proto.RegisterType((*MsgRevealDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgRevealDomainResponse")
+	proto.RegisterType((*MsgOpenAuction)(nil), "dnsblockchain.dnsblockchain.v1.MsgOpenAuction")
+	proto.RegisterType((*MsgOpenAuctionResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgOpenAuctionResponse")
+	proto.RegisterType((*MsgPlaceBid)(nil), "dnsblockchain.dnsblockchain.v1.MsgPlaceBid")
+	proto.RegisterType((*MsgPlaceBidResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgPlaceBidResponse")
+	proto.RegisterType((*MsgRevealBid)(nil), "dnsblockchain.dnsblockchain.v1.MsgRevealBid")
+	proto.RegisterType((*MsgRevealBidResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgRevealBidResponse")
+	proto.RegisterType((*MsgOfferDomainTransfer)(nil), "dnsblockchain.dnsblockchain.v1.MsgOfferDomainTransfer")
+	proto.RegisterType((*MsgOfferDomainTransferResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgOfferDomainTransferResponse")
+	proto.RegisterType((*MsgAcceptDomainTransfer)(nil), "dnsblockchain.dnsblockchain.v1.MsgAcceptDomainTransfer")
+	proto.RegisterType((*MsgAcceptDomainTransferResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgAcceptDomainTransferResponse")
+	proto.RegisterType((*MsgCancelDomainTransfer)(nil), "dnsblockchain.dnsblockchain.v1.MsgCancelDomainTransfer")
+	proto.RegisterType((*MsgCancelDomainTransferResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgCancelDomainTransferResponse")
+	proto.RegisterType((*MsgListDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgListDomain")
+	proto.RegisterType((*MsgListDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgListDomainResponse")
+	proto.RegisterType((*MsgUnlistDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgUnlistDomain")
+	proto.RegisterType((*MsgUnlistDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgUnlistDomainResponse")
+	proto.RegisterType((*MsgBuyDomain)(nil), "dnsblockchain.dnsblockchain.v1.MsgBuyDomain")
+	proto.RegisterType((*MsgBuyDomainResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgBuyDomainResponse")
+	proto.RegisterType((*MsgPlaceMarketOffer)(nil), "dnsblockchain.dnsblockchain.v1.MsgPlaceMarketOffer")
+	proto.RegisterType((*MsgPlaceMarketOfferResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgPlaceMarketOfferResponse")
+	proto.RegisterType((*MsgWithdrawMarketOffer)(nil), "dnsblockchain.dnsblockchain.v1.MsgWithdrawMarketOffer")
+	proto.RegisterType((*MsgWithdrawMarketOfferResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgWithdrawMarketOfferResponse")
+	proto.RegisterType((*MsgAcceptMarketOffer)(nil), "dnsblockchain.dnsblockchain.v1.MsgAcceptMarketOffer")
+	proto.RegisterType((*MsgAcceptMarketOfferResponse)(nil), "dnsblockchain.dnsblockchain.v1.MsgAcceptMarketOfferResponse")
+}
+
+func init() {
+	proto.RegisterFile("dnsblockchain/dnsblockchain/v1/tx.proto", fileDescriptor_a7ae1cda1295308e)
+}
+
+var fileDescriptor_a7ae1cda1295308e = []byte{
+	// 1597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x93, 0xdd, 0x24, 0xfb, 0x92, 0x6f, 0xda, 0xba, 0x69, 0xb3, 0x71, 0x9b, 0x4d, 0xbe,
+	0x8b, 0x04, 0x4b, 0x4a, 0x76, 0x49, 0xfa, 0x0b, 0x02, 0x05, 0x9a, 0xb6, 0xa2, 0x95, 0xd8, 0x36,
+	0x72, 0x5a, 0x21, 0x71, 0x59, 0xcd, 0xda, 0x13, 0xc7, 0x64, 0xd7, 0x5e, 0x79, 0xbc, 0xf9, 0x71,
+	0x41, 0xe5, 0x87, 0x84, 0x54, 0x09, 0xa9, 0x37, 0x38, 0xc2, 0x0d, 0x71, 0x8a, 0x50, 0xff, 0x88,
+	0x1e, 0x2b, 0x0e, 0x08, 0x09, 0x09, 0x50, 0x7a, 0x88, 0xf8, 0x1f, 0x38, 0x20, 0x8f, 0xed, 0xd9,
+	0xb1, 0xd7, 0x49, 0x66, 0x13, 0x03, 0xbd, 0xb4, 0x3b, 0xf6, 0x7b, 0xf3, 0xf9, 0xbc, 0xe7, 0xf9,
+	0xcc, 0xbc, 0x37, 0x81, 0x57, 0x74, 0x8b, 0xd4, 0x1b, 0xb6, 0xb6, 0xae, 0xad, 0x21, 0xd3, 0xaa,
+	0x44, 0x47, 0x1b, 0xf3, 0x15, 0x77, 0xab, 0xdc, 0x72, 0x6c, 0xd7, 0x96, 0x0b, 0x91, 0x57, 0xe5,
+	0xe8, 0x68, 0x63, 0x5e, 0x39, 0x85, 0x9a, 0xa6, 0x65, 0x57, 0xe8, 0xbf, 0xbe, 0x8b, 0x32, 0xa1,
+	0xd9, 0xa4, 0x69, 0x93, 0x4a, 0x93, 0x18, 0xde, 0x54, 0x4d, 0x62, 0x04, 0x2f, 0x26, 0xfd, 0x17,
+	0x35, 0x3a, 0xaa, 0xf8, 0x83, 0xe0, 0xd5, 0x85, 0x43, 0xf8, 0xb4, 0x90, 0x83, 0x9a, 0xa1, 0xf1,
+	0xb8, 0x61, 0x1b, 0xb6, 0x3f, 0x89, 0xf7, 0x2b, 0x78, 0x5a, 0x08, 0x60, 0xeb, 0x88, 0xe0, 0xca,
+	0xc6, 0x7c, 0x1d, 0xbb, 0x68, 0xbe, 0xa2, 0xd9, 0xa6, 0x25, 0x08, 0xa1, 0xdb, 0x4d, 0x14, 0x1a,
+	0x17, 0x7f, 0x96, 0xe0, 0x44, 0x95, 0x18, 0x0f, 0x5a, 0x3a, 0x72, 0xf1, 0x32, 0x05, 0x97, 0xaf,
+	0x40, 0x0e, 0xb5, 0xdd, 0x35, 0xdb, 0x31, 0xdd, 0xed, 0xbc, 0x34, 0x23, 0x95, 0x72, 0x4b, 0xf9,
+	0x9f, 0x9e, 0xcc, 0x8d, 0x07, 0x81, 0x5c, 0xd7, 0x75, 0x07, 0x13, 0xb2, 0xe2, 0x3a, 0xa6, 0x65,
+	0xa8, 0x1d, 0x53, 0xf9, 0x0e, 0x0c, 0xfa, 0xf4, 0xf3, 0xfd, 0x33, 0x52, 0x69, 0x64, 0xe1, 0xe5,
+	0xf2, 0xc1, 0x39, 0x2d, 0xfb, 0x78, 0x4b, 0xb9, 0xa7, 0xbf, 0x4d, 0xf7, 0x7d, 0xbf, 0xb7, 0x33,
+	0x2b, 0xa9, 0xc1, 0x04, 0x8b, 0xef, 0x7d, 0xb6, 0xb7, 0x33, 0xdb, 0x99, 0xfa, 0xd1, 0xde, 0xce,
+	0xec, 0x5c, 0x34, 0x90, 0xad, 0x58, 0x60, 0xb1, 0x20, 0x8a, 0x93, 0x30, 0x11, 0x7b, 0xa4, 0x62,
+	0xd2, 0xb2, 0x2d, 0x82, 0x8b, 0x7f, 0xf9, 0x31, 0xdf, 0x70, 0x30, 0x72, 0xf1, 0x4d, 0x9a, 0x0d,
+	0x79, 0x01, 0x86, 0x34, 0x6f, 0x6c, 0x3b, 0x87, 0x46, 0x1c, 0x1a, 0xca, 0x32, 0x64, 0x2c, 0xd4,
+	0xc4, 0x34, 0xda, 0x9c, 0x4a, 0x7f, 0xcb, 0x65, 0xc8, 0xda, 0x9b, 0x16, 0x76, 0xf2, 0x03, 0x87,
+	0xcc, 0xe2, 0x9b, 0xc9, 0x55, 0x00, 0x8b, 0xd4, 0x1c, 0xac, 0xd9, 0x8e, 0x4e, 0xf2, 0xd9, 0x99,
+	0x81, 0xd2, 0xc8, 0x42, 0xf9, 0xb0, 0xbc, 0xdd, 0x5d, 0x51, 0xa9, 0xc3, 0x87, 0xa6, 0xbb, 0x76,
+	0x67, 0x59, 0xcd, 0x59, 0xc4, 0x1f, 0x13, 0x79, 0x1c, 0xb2, 0xdb, 0x18, 0x39, 0x24, 0x3f, 0x38,
+	0x23, 0x95, 0x32, 0xaa, 0x3f, 0x58, 0x1c, 0xf5, 0xb2, 0x19, 0xd2, 0x2e, 0xbe, 0x4a, 0x33, 0xc3,
+	0x47, 0x1f, 0x66, 0x46, 0x1e, 0x83, 0x7e, 0x53, 0xa7, 0x09, 0xc8, 0xa8, 0xfd, 0xa6, 0x5e, 0xdc,
+	0xe5, 0x57, 0xc7, 0x31, 0x32, 0xe5, 0xcf, 0xdb, 0x1f, 0xce, 0x7b, 0xcc, 0x2c, 0x65, 0x8e, 0x99,
+	0xa5, 0x58, 0x3e, 0xf8, 0x95, 0x12, 0xcd, 0x47, 0x51, 0xa3, 0xe1, 0xdf, 0xc4, 0x0d, 0x9c, 0x66,
+	0xf8, 0x89, 0xf8, 0x3c, 0x08, 0xc3, 0x7f, 0x22, 0xc1, 0xa9, 0x2a, 0x31, 0xee, 0x3b, 0xc8, 0x22,
+	0xab, 0xd8, 0x49, 0xf1, 0x0b, 0x5c, 0x86, 0x9c, 0x85, 0x37, 0x6b, 0x62, 0x5f, 0x61, 0xd8, 0xc2,
+	0x9b, 0xf7, 0xe8, 0x87, 0x38, 0x0b, 0x83, 0xba, 0xe9, 0x60, 0xcd, 0xcd, 0x67, 0x66, 0xa4, 0xd2,
+	0xb0, 0x1a, 0x8c, 0x62, 0x11, 0x9d, 0x83, 0xc9, 0x2e, 0xd6, 0x2c, 0xa6, 0x55, 0x90, 0xab, 0xc4,
+	0xb8, 0x8d, 0x91, 0xe3, 0xd6, 0x31, 0x72, 0xff, 0xb1, 0xb4, 0x9e, 0x07, 0xa5, 0x1b, 0x87, 0xb1,
+	0xd8, 0x82, 0xb1, 0x2a, 0x31, 0x54, 0x6c, 0xe1, 0xcd, 0x14, 0xb3, 0xca, 0xe4, 0x37, 0xb0, 0xbf,
+	0xfc, 0xde, 0x80, 0xb3, 0x51, 0x64, 0xa6, 0xbe, 0x02, 0x00, 0xde, 0x6a, 0x99, 0x0e, 0x72, 0x4d,
+	0xdb, 0x0a, 0x54, 0xc8, 0x3d, 0x29, 0xfe, 0x2a, 0xc1, 0xe9, 0x2a, 0x31, 0x56, 0x30, 0x0b, 0xc6,
+	0x17, 0x7d, 0x1a, 0xcc, 0x6f, 0xc3, 0x50, 0x28, 0xaf, 0x01, 0x31, 0x79, 0xa9, 0x98, 0xd8, 0x6d,
+	0x47, 0xc3, 0x3e, 0x0b, 0x35, 0x74, 0x97, 0x4b, 0x70, 0x52, 0x6b, 0x60, 0xe4, 0xd4, 0x22, 0x8a,
+	0xf5, 0x16, 0xcb, 0x18, 0x7d, 0x7e, 0x77, 0x1f, 0x19, 0x4e, 0xc1, 0xb9, 0x84, 0xe0, 0xd8, 0x07,
+	0xfb, 0x51, 0x82, 0x33, 0xfc, 0xfb, 0x9b, 0x2b, 0x69, 0x86, 0xff, 0x3e, 0x80, 0xde, 0xa1, 0xeb,
+	0x67, 0xa0, 0x74, 0x58, 0x06, 0x42, 0x0a, 0x6a, 0x4e, 0xdf, 0x27, 0xa6, 0x69, 0x98, 0x4a, 0xe4,
+	0xcc, 0xa2, 0x32, 0xfc, 0x93, 0xc8, 0x6e, 0x36, 0x4d, 0xf7, 0x78, 0x27, 0xd1, 0x1a, 0x22, 0x6b,
+	0x34, 0xa0, 0x51, 0x95, 0xfe, 0x4e, 0xdc, 0x64, 0x78, 0x20, 0xc6, 0xe1, 0x71, 0x3f, 0x25, 0xa1,
+	0xe2, 0x0d, 0x8c, 0x1a, 0x2f, 0xd4, 0x71, 0x98, 0x49, 0xed, 0x38, 0xcc, 0x72, 0x7a, 0xf4, 0x88,
+	0x12, 0xd4, 0x70, 0xe9, 0x19, 0x99, 0x53, 0xe9, 0xef, 0x58, 0xb6, 0x6e, 0xd3, 0x6c, 0xf1, 0x19,
+	0xd9, 0xef, 0x88, 0x94, 0xa7, 0x00, 0xbc, 0x48, 0x6b, 0x2e, 0x5a, 0xc7, 0x16, 0x8d, 0x7d, 0x58,
+	0xcd, 0x79, 0x4f, 0xee, 0x7b, 0x0f, 0x8a, 0xab, 0x74, 0x9f, 0xb9, 0xd7, 0xc2, 0xd6, 0xf5, 0xb6,
+	0xe6, 0xa9, 0x38, 0xad, 0xd4, 0xc6, 0x18, 0x2f, 0xd3, 0x5d, 0x85, 0xc3, 0x61, 0x84, 0x27, 0x60,
+	0xa8, 0x6e, 0xea, 0x35, 0x6c, 0x85, 0xac, 0x07, 0xeb, 0xa6, 0x7e, 0xcb, 0xa2, 0xcc, 0x1d, 0x1a,
+	0x21, 0x7d, 0xe7, 0x6b, 0x21, 0xe7, 0x3f, 0xb9, 0x65, 0xe9, 0xc5, 0x3f, 0x25, 0x18, 0xa9, 0x12,
+	0x63, 0xb9, 0x81, 0x34, 0xbc, 0x64, 0xea, 0xa9, 0x2d, 0x89, 0x29, 0x00, 0x82, 0x51, 0x03, 0xeb,
+	0xb5, 0xba, 0xa9, 0xd3, 0x75, 0x31, 0xaa, 0xe6, 0xfc, 0x27, 0x1e, 0x0c, 0x86, 0x21, 0x1d, 0xb7,
+	0x6c, 0x62, 0xba, 0xc1, 0xe7, 0x9f, 0x2c, 0x07, 0x18, 0x5e, 0xbd, 0x5b, 0x0e, 0xea, 0xdd, 0xf2,
+	0x0d, 0xdb, 0xb4, 0x96, 0x5e, 0xf7, 0x0a, 0xc7, 0x1f, 0x7e, 0x9f, 0x2e, 0x19, 0xa6, 0xbb, 0xd6,
+	0xae, 0x97, 0x35, 0xbb, 0x19, 0x54, 0xdb, 0xc1, 0x7f, 0x73, 0x44, 0x5f, 0xaf, 0xb8, 0xdb, 0x2d,
+	0x4c, 0xa8, 0x03, 0x51, 0xc3, 0xb9, 0x63, 0xd9, 0x3b, 0x43, 0x37, 0xd6, 0x30, 0x54, 0xa6, 0x8c,
+	0x87, 0xfd, 0x30, 0xca, 0xd6, 0x41, 0x9a, 0x39, 0xb8, 0x0a, 0x83, 0xa8, 0x69, 0xb7, 0x2d, 0x97,
+	0xc6, 0x7f, 0x60, 0x8c, 0x19, 0x2f, 0x46, 0x35, 0x30, 0x67, 0x4b, 0x37, 0xd3, 0x59, 0xba, 0x29,
+	0x97, 0x90, 0xb1, 0xcc, 0x9c, 0x85, 0x71, 0x3e, 0x03, 0x2c, 0x35, 0xdf, 0x49, 0xfe, 0x82, 0x5b,
+	0x65, 0x07, 0x7c, 0x78, 0xdc, 0xff, 0x87, 0xe5, 0x49, 0x8c, 0xfb, 0x22, 0x14, 0x92, 0x29, 0x32,
+	0x6d, 0xe4, 0x61, 0x88, 0x9e, 0xaf, 0x98, 0x04, 0xda, 0x08, 0x87, 0xc5, 0x75, 0xba, 0x03, 0x5c,
+	0xd7, 0x34, 0xdc, 0x72, 0xd3, 0x8f, 0x2f, 0x46, 0xf4, 0xff, 0x30, 0xbd, 0x0f, 0x18, 0xcb, 0xb7,
+	0xcf, 0xe7, 0x06, 0xb2, 0x34, 0xdc, 0xf8, 0x97, 0xf8, 0x24, 0x81, 0x31, 0x3e, 0xdf, 0x48, 0xf0,
+	0xbf, 0x2a, 0x31, 0x3e, 0x30, 0x89, 0x9b, 0x6a, 0x55, 0x9a, 0x6d, 0x39, 0xa6, 0x86, 0x45, 0x65,
+	0xe1, 0x5b, 0xc7, 0xd8, 0x4f, 0xd0, 0x42, 0xa1, 0xc3, 0x2c, 0x56, 0xcd, 0x3f, 0xb0, 0x1a, 0xa9,
+	0x92, 0x4e, 0xee, 0x26, 0x38, 0x10, 0x86, 0xff, 0xb5, 0x44, 0xb7, 0x93, 0xa5, 0xf6, 0xf6, 0x8b,
+	0x96, 0x32, 0x5f, 0xe5, 0x8c, 0x18, 0x63, 0xfc, 0xad, 0xd4, 0xd9, 0x18, 0xab, 0xc8, 0x59, 0xc7,
+	0x2e, 0x55, 0x53, 0x2a, 0xc4, 0x8f, 0xba, 0x07, 0x26, 0x96, 0x8d, 0x71, 0x86, 0x2c, 0x82, 0x8f,
+	0xe9, 0x36, 0xe5, 0xed, 0x72, 0xba, 0x83, 0x36, 0x53, 0x8e, 0x21, 0x46, 0x65, 0x86, 0xee, 0x37,
+	0x09, 0x58, 0xbc, 0x6a, 0xc6, 0x99, 0xd2, 0xd3, 0x4e, 0x68, 0x19, 0xb2, 0xf5, 0xf6, 0xb6, 0x48,
+	0xad, 0x45, 0xcd, 0x62, 0xe4, 0x0b, 0x70, 0x3e, 0x89, 0x59, 0x48, 0x7d, 0xe1, 0xf3, 0x09, 0x18,
+	0xa8, 0x12, 0x43, 0xde, 0x82, 0xd1, 0xc8, 0x65, 0x51, 0xe5, 0xb0, 0x93, 0x26, 0x76, 0x0b, 0xa3,
+	0x5c, 0xed, 0xd1, 0x81, 0x6d, 0xd6, 0x5b, 0x30, 0x1a, 0xb9, 0xb2, 0x11, 0x41, 0xe6, 0x1d, 0x84,
+	0x90, 0x13, 0xaf, 0x45, 0x58, 0xcc, 0x3d, 0x20, 0xf3, 0x0e, 0x3d, 0xc4, 0xdc, 0x8d, 0x1c, 0xb9,
+	0x7d, 0x10, 0x41, 0xe6, 0x1d, 0x84, 0x90, 0x93, 0xae, 0x1e, 0xe4, 0x4f, 0x60, 0x2c, 0x76, 0xed,
+	0x30, 0x2f, 0x30, 0x55, 0xd4, 0x45, 0x79, 0xb3, 0x67, 0x17, 0x86, 0xff, 0xa9, 0x04, 0x27, 0xba,
+	0x2e, 0x09, 0x04, 0xa6, 0x8b, 0xf9, 0x28, 0x8b, 0xbd, 0xfb, 0x30, 0x0e, 0x6d, 0x18, 0xe1, 0x6f,
+	0x08, 0xca, 0x02, 0x53, 0x71, 0xf6, 0xca, 0x95, 0xde, 0xec, 0x19, 0xec, 0x17, 0x12, 0x9c, 0xec,
+	0x6a, 0xf2, 0x2f, 0x0a, 0x4c, 0x16, 0x77, 0x52, 0xde, 0x3a, 0x82, 0x13, 0xa3, 0xf1, 0x48, 0x02,
+	0x39, 0xa1, 0xdd, 0xbe, 0xdc, 0xcb, 0x9c, 0xcc, 0x4d, 0xb9, 0x76, 0x24, 0xb7, 0x88, 0xf8, 0xf9,
+	0x2e, 0x59, 0x48, 0xfc, 0x9c, 0x83, 0x98, 0xf8, 0x13, 0xda, 0x63, 0x0f, 0x39, 0xd2, 0x1a, 0x57,
+	0x84, 0xbe, 0x6a, 0xc7, 0x41, 0x08, 0x39, 0xb1, 0xd5, 0x6c, 0xc3, 0x08, 0xdf, 0x38, 0x8a, 0x2c,
+	0x3f, 0xce, 0x5e, 0x68, 0xf9, 0x25, 0x35, 0x8c, 0x0d, 0x18, 0x66, 0x4d, 0xdf, 0x05, 0x81, 0x39,
+	0x42, 0x63, 0xe5, 0x62, 0x0f, 0xc6, 0x0c, 0xcd, 0x86, 0x5c, 0xa7, 0xbf, 0x7a, 0x4d, 0x38, 0x55,
+	0x1e, 0xde, 0xa5, 0x5e, 0xac, 0x19, 0xe0, 0x57, 0x12, 0x9c, 0x4e, 0x6a, 0x5b, 0x84, 0xd2, 0xd5,
+	0xed, 0xa7, 0xbc, 0x73, 0x34, 0x3f, 0xc6, 0xe7, 0xb1, 0x04, 0xe3, 0x89, 0x7d, 0x86, 0xc8, 0xba,
+	0x49, 0x72, 0x54, 0xde, 0x3d, 0xa2, 0x63, 0x84, 0x52, 0x62, 0xab, 0x21, 0x24, 0xa2, 0x04, 0x47,
+	0x21, 0x4a, 0x07, 0xf5, 0x1b, 0xb2, 0x03, 0xc0, 0xf5, 0x1a, 0x73, 0x02, 0xd3, 0x75, 0xcc, 0x95,
+	0xcb, 0x3d, 0x99, 0x47, 0x8e, 0x7d, 0xbe, 0x59, 0x10, 0x3a, 0xf6, 0x39, 0x07, 0xb1, 0x63, 0x3f,
+	0xa1, 0x53, 0xf0, 0x44, 0xd1, 0xe9, 0x12, 0x44, 0x44, 0xc1, 0xac, 0x85, 0x44, 0xd1, 0x55, 0xe8,
+	0xd3, 0x23, 0xa7, 0xab, 0xca, 0x17, 0xd6, 0x33, 0xe7, 0x24, 0x74, 0xe4, 0xec, 0x57, 0xad, 0x53,
+	0x6d, 0x26, 0xd5, 0xea, 0x22, 0xda, 0x4c, 0xf0, 0x13, 0xd2, 0xe6, 0x01, 0xf5, 0xba, 0xfc, 0xa5,
+	0x04, 0xa7, 0xba, 0x8b, 0xf5, 0x4b, 0xc2, 0xfa, 0xe2, 0xb9, 0xbc, 0x7d, 0x14, 0xaf, 0x90, 0x89,
+	0x92, 0x7d, 0xb8, 0xb7, 0x33, 0x2b, 0x2d, 0x5d, 0x7b, 0xba, 0x5b, 0x90, 0x9e, 0xed, 0x16, 0xa4,
+	0x3f, 0x76, 0x0b, 0xd2, 0xe3, 0xe7, 0x85, 0xbe, 0x67, 0xcf, 0x0b, 0x7d, 0xbf, 0x3c, 0x2f, 0xf4,
+	0x7d, 0xf4, 0xd2, 0xc1, 0x7f, 0x1e, 0xa5, 0x97, 0x60, 0xf5, 0x41, 0xfa, 0x47, 0xdf, 0x8b, 0x7f,
+	0x07, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x8c, 0xe0, 0xec, 0x16, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateDomain defines the CreateDomain RPC.
+	CreateDomain(ctx context.Context, in *MsgCreateDomain, opts ...grpc.CallOption) (*MsgCreateDomainResponse, error)
+	// UpdateDomain defines the UpdateDomain RPC.
+	UpdateDomain(ctx context.Context, in *MsgUpdateDomain, opts ...grpc.CallOption) (*MsgUpdateDomainResponse, error)
+	// DeleteDomain defines the DeleteDomain RPC.
+	DeleteDomain(ctx context.Context, in *MsgDeleteDomain, opts ...grpc.CallOption) (*MsgDeleteDomainResponse, error)
+	// TransferDomain defines the TransferDomain RPC.
+	TransferDomain(ctx context.Context, in *MsgTransferDomain, opts ...grpc.CallOption) (*MsgTransferDomainResponse, error)
+	// HeartbeatDomain defines the HeartbeatDomain RPC.
+	HeartbeatDomain(ctx context.Context, in *MsgHeartbeatDomain, opts ...grpc.CallOption) (*MsgHeartbeatDomainResponse, error)
+	// RenewDomain extends a domain's registration by a number of years.
+	RenewDomain(ctx context.Context, in *MsgRenewDomain, opts ...grpc.CallOption) (*MsgRenewDomainResponse, error)
+	// SetDomainRecords replaces the resource records a domain publishes on chain.
+	SetDomainRecords(ctx context.Context, in *MsgSetDomainRecords, opts ...grpc.CallOption) (*MsgSetDomainRecordsResponse, error)
+	// SetDomainDSRecords replaces the DNSSEC delegation signer records of a delegated domain.
+	SetDomainDSRecords(ctx context.Context, in *MsgSetDomainDSRecords, opts ...grpc.CallOption) (*MsgSetDomainDSRecordsResponse, error)
+	// CommitDomain records a sealed commitment to register a name, with a deposit.
+	CommitDomain(ctx context.Context, in *MsgCommitDomain, opts ...grpc.CallOption) (*MsgCommitDomainResponse, error)
+	// RevealDomain registers the name of a commitment once it is old enough.
+	RevealDomain(ctx context.Context, in *MsgRevealDomain, opts ...grpc.CallOption) (*MsgRevealDomainResponse, error)
+	// OpenAuction starts a sealed-bid auction for a name under a launching TLD or a premium name.
+	OpenAuction(ctx context.Context, in *MsgOpenAuction, opts ...grpc.CallOption) (*MsgOpenAuctionResponse, error)
+	// PlaceBid places a sealed bid in an open auction.
+	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	// RevealBid reveals a sealed bid once bidding has closed.
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
+	// OfferDomainTransfer offers a domain to a new owner, who must accept it before it expires.
+	OfferDomainTransfer(ctx context.Context, in *MsgOfferDomainTransfer, opts ...grpc.CallOption) (*MsgOfferDomainTransferResponse, error)
+	// AcceptDomainTransfer accepts a pending transfer offer, moving the domain to the signer.
+	AcceptDomainTransfer(ctx context.Context, in *MsgAcceptDomainTransfer, opts ...grpc.CallOption) (*MsgAcceptDomainTransferResponse, error)
+	// CancelDomainTransfer withdraws or declines a pending transfer offer.
+	CancelDomainTransfer(ctx context.Context, in *MsgCancelDomainTransfer, opts ...grpc.CallOption) (*MsgCancelDomainTransferResponse, error)
+	// ListDomain puts a domain up for sale at a fixed price.
+	ListDomain(ctx context.Context, in *MsgListDomain, opts ...grpc.CallOption) (*MsgListDomainResponse, error)
+	// UnlistDomain withdraws a domain from sale.
+	UnlistDomain(ctx context.Context, in *MsgUnlistDomain, opts ...grpc.CallOption) (*MsgUnlistDomainResponse, error)
+	// BuyDomain buys a listed domain.
+	BuyDomain(ctx context.Context, in *MsgBuyDomain, opts ...grpc.CallOption) (*MsgBuyDomainResponse, error)
+	// PlaceMarketOffer escrows an offer to buy a domain.
+	PlaceMarketOffer(ctx context.Context, in *MsgPlaceMarketOffer, opts ...grpc.CallOption) (*MsgPlaceMarketOfferResponse, error)
+	// WithdrawMarketOffer withdraws an offer and refunds it.
+	WithdrawMarketOffer(ctx context.Context, in *MsgWithdrawMarketOffer, opts ...grpc.CallOption) (*MsgWithdrawMarketOfferResponse, error)
+	// AcceptMarketOffer sells a domain to the maker of an offer.
+	AcceptMarketOffer(ctx context.Context, in *MsgAcceptMarketOffer, opts ...grpc.CallOption) (*MsgAcceptMarketOfferResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDomain(ctx context.Context, in *MsgCreateDomain, opts ...grpc.CallOption) (*MsgCreateDomainResponse, error) {
+	out := new(MsgCreateDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/CreateDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDomain(ctx context.Context, in *MsgUpdateDomain, opts ...grpc.CallOption) (*MsgUpdateDomainResponse, error) {
+	out := new(MsgUpdateDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/UpdateDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteDomain(ctx context.Context, in *MsgDeleteDomain, opts ...grpc.CallOption) (*MsgDeleteDomainResponse, error) {
+	out := new(MsgDeleteDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/DeleteDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferDomain(ctx context.Context, in *MsgTransferDomain, opts ...grpc.CallOption) (*MsgTransferDomainResponse, error) {
+	out := new(MsgTransferDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/TransferDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HeartbeatDomain(ctx context.Context, in *MsgHeartbeatDomain, opts ...grpc.CallOption) (*MsgHeartbeatDomainResponse, error) {
+	out := new(MsgHeartbeatDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/HeartbeatDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewDomain(ctx context.Context, in *MsgRenewDomain, opts ...grpc.CallOption) (*MsgRenewDomainResponse, error) {
+	out := new(MsgRenewDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/RenewDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDomainRecords(ctx context.Context, in *MsgSetDomainRecords, opts ...grpc.CallOption) (*MsgSetDomainRecordsResponse, error) {
+	out := new(MsgSetDomainRecordsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/SetDomainRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDomainDSRecords(ctx context.Context, in *MsgSetDomainDSRecords, opts ...grpc.CallOption) (*MsgSetDomainDSRecordsResponse, error) {
+	out := new(MsgSetDomainDSRecordsResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/SetDomainDSRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitDomain(ctx context.Context, in *MsgCommitDomain, opts ...grpc.CallOption) (*MsgCommitDomainResponse, error) {
+	out := new(MsgCommitDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/CommitDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealDomain(ctx context.Context, in *MsgRevealDomain, opts ...grpc.CallOption) (*MsgRevealDomainResponse, error) {
+	out := new(MsgRevealDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/RevealDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenAuction(ctx context.Context, in *MsgOpenAuction, opts ...grpc.CallOption) (*MsgOpenAuctionResponse, error) {
+	out := new(MsgOpenAuctionResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/OpenAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
+	out := new(MsgPlaceBidResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/PlaceBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/RevealBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OfferDomainTransfer(ctx context.Context, in *MsgOfferDomainTransfer, opts ...grpc.CallOption) (*MsgOfferDomainTransferResponse, error) {
+	out := new(MsgOfferDomainTransferResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/OfferDomainTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptDomainTransfer(ctx context.Context, in *MsgAcceptDomainTransfer, opts ...grpc.CallOption) (*MsgAcceptDomainTransferResponse, error) {
+	out := new(MsgAcceptDomainTransferResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/AcceptDomainTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDomainTransfer(ctx context.Context, in *MsgCancelDomainTransfer, opts ...grpc.CallOption) (*MsgCancelDomainTransferResponse, error) {
+	out := new(MsgCancelDomainTransferResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/CancelDomainTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ListDomain(ctx context.Context, in *MsgListDomain, opts ...grpc.CallOption) (*MsgListDomainResponse, error) {
+	out := new(MsgListDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/ListDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlistDomain(ctx context.Context, in *MsgUnlistDomain, opts ...grpc.CallOption) (*MsgUnlistDomainResponse, error) {
+	out := new(MsgUnlistDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/UnlistDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BuyDomain(ctx context.Context, in *MsgBuyDomain, opts ...grpc.CallOption) (*MsgBuyDomainResponse, error) {
+	out := new(MsgBuyDomainResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/BuyDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceMarketOffer(ctx context.Context, in *MsgPlaceMarketOffer, opts ...grpc.CallOption) (*MsgPlaceMarketOfferResponse, error) {
+	out := new(MsgPlaceMarketOfferResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/PlaceMarketOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawMarketOffer(ctx context.Context, in *MsgWithdrawMarketOffer, opts ...grpc.CallOption) (*MsgWithdrawMarketOfferResponse, error) {
+	out := new(MsgWithdrawMarketOfferResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/WithdrawMarketOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptMarketOffer(ctx context.Context, in *MsgAcceptMarketOffer, opts ...grpc.CallOption) (*MsgAcceptMarketOfferResponse, error) {
+	out := new(MsgAcceptMarketOfferResponse)
+	err := c.cc.Invoke(ctx, "/dnsblockchain.dnsblockchain.v1.Msg/AcceptMarketOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateDomain defines the CreateDomain RPC.
+	CreateDomain(context.Context, *MsgCreateDomain) (*MsgCreateDomainResponse, error)
+	// UpdateDomain defines the UpdateDomain RPC.
+	UpdateDomain(context.Context, *MsgUpdateDomain) (*MsgUpdateDomainResponse, error)
+	// DeleteDomain defines the DeleteDomain RPC.
+	DeleteDomain(context.Context, *MsgDeleteDomain) (*MsgDeleteDomainResponse, error)
+	// TransferDomain defines the TransferDomain RPC.
+	TransferDomain(context.Context, *MsgTransferDomain) (*MsgTransferDomainResponse, error)
+	// HeartbeatDomain defines the HeartbeatDomain RPC.
+	HeartbeatDomain(context.Context, *MsgHeartbeatDomain) (*MsgHeartbeatDomainResponse, error)
+	// RenewDomain extends a domain's registration by a number of years.
+	RenewDomain(context.Context, *MsgRenewDomain) (*MsgRenewDomainResponse, error)
+	// SetDomainRecords replaces the resource records a domain publishes on chain.
+	SetDomainRecords(context.Context, *MsgSetDomainRecords) (*MsgSetDomainRecordsResponse, error)
+	// SetDomainDSRecords replaces the DNSSEC delegation signer records of a delegated domain.
+	SetDomainDSRecords(context.Context, *MsgSetDomainDSRecords) (*MsgSetDomainDSRecordsResponse, error)
+	// CommitDomain records a sealed commitment to register a name, with a deposit.
+	CommitDomain(context.Context, *MsgCommitDomain) (*MsgCommitDomainResponse, error)
+	// RevealDomain registers the name of a commitment once it is old enough.
+	RevealDomain(context.Context, *MsgRevealDomain) (*MsgRevealDomainResponse, error)
+	// OpenAuction starts a sealed-bid auction for a name under a launching TLD or a premium name.
+	OpenAuction(context.Context, *MsgOpenAuction) (*MsgOpenAuctionResponse, error)
+	// PlaceBid places a sealed bid in an open auction.
+	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	// RevealBid reveals a sealed bid once bidding has closed.
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
+	// OfferDomainTransfer offers a domain to a new owner, who must accept it before it expires.
+	OfferDomainTransfer(context.Context, *MsgOfferDomainTransfer) (*MsgOfferDomainTransferResponse, error)
+	// AcceptDomainTransfer accepts a pending transfer offer, moving the domain to the signer.
+	AcceptDomainTransfer(context.Context, *MsgAcceptDomainTransfer) (*MsgAcceptDomainTransferResponse, error)
+	// CancelDomainTransfer withdraws or declines a pending transfer offer.
+	CancelDomainTransfer(context.Context, *MsgCancelDomainTransfer) (*MsgCancelDomainTransferResponse, error)
+	// ListDomain puts a domain up for sale at a fixed price.
+	ListDomain(context.Context, *MsgListDomain) (*MsgListDomainResponse, error)
+	// UnlistDomain withdraws a domain from sale.
+	UnlistDomain(context.Context, *MsgUnlistDomain) (*MsgUnlistDomainResponse, error)
+	// BuyDomain buys a listed domain.
+	BuyDomain(context.Context, *MsgBuyDomain) (*MsgBuyDomainResponse, error)
+	// PlaceMarketOffer escrows an offer to buy a domain.
+	PlaceMarketOffer(context.Context, *MsgPlaceMarketOffer) (*MsgPlaceMarketOfferResponse, error)
+	// WithdrawMarketOffer withdraws an offer and refunds it.
+	WithdrawMarketOffer(context.Context, *MsgWithdrawMarketOffer) (*MsgWithdrawMarketOfferResponse, error)
+	// AcceptMarketOffer sells a domain to the maker of an offer.
+	AcceptMarketOffer(context.Context, *MsgAcceptMarketOffer) (*MsgAcceptMarketOfferResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateDomain(ctx context.Context, req *MsgCreateDomain) (*MsgCreateDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDomain not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDomain(ctx context.Context, req *MsgUpdateDomain) (*MsgUpdateDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDomain not implemented")
+}
+func (*UnimplementedMsgServer) DeleteDomain(ctx context.Context, req *MsgDeleteDomain) (*MsgDeleteDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDomain not implemented")
+}
+func (*UnimplementedMsgServer) TransferDomain(ctx context.Context, req *MsgTransferDomain) (*MsgTransferDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDomain not implemented")
+}
+func (*UnimplementedMsgServer) HeartbeatDomain(ctx context.Context, req *MsgHeartbeatDomain) (*MsgHeartbeatDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeartbeatDomain not implemented")
+}
+func (*UnimplementedMsgServer) RenewDomain(ctx context.Context, req *MsgRenewDomain) (*MsgRenewDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewDomain not implemented")
+}
+func (*UnimplementedMsgServer) SetDomainRecords(ctx context.Context, req *MsgSetDomainRecords) (*MsgSetDomainRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDomainRecords not implemented")
+}
+func (*UnimplementedMsgServer) SetDomainDSRecords(ctx context.Context, req *MsgSetDomainDSRecords) (*MsgSetDomainDSRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDomainDSRecords not implemented")
+}
+func (*UnimplementedMsgServer) CommitDomain(ctx context.Context, req *MsgCommitDomain) (*MsgCommitDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitDomain not implemented")
+}
+func (*UnimplementedMsgServer) RevealDomain(ctx context.Context, req *MsgRevealDomain) (*MsgRevealDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealDomain not implemented")
+}
+func (*UnimplementedMsgServer) OpenAuction(ctx context.Context, req *MsgOpenAuction) (*MsgOpenAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenAuction not implemented")
+}
+func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+}
+func (*UnimplementedMsgServer) RevealBid(ctx context.Context, req *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
+func (*UnimplementedMsgServer) OfferDomainTransfer(ctx context.Context, req *MsgOfferDomainTransfer) (*MsgOfferDomainTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferDomainTransfer not implemented")
+}
+func (*UnimplementedMsgServer) AcceptDomainTransfer(ctx context.Context, req *MsgAcceptDomainTransfer) (*MsgAcceptDomainTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDomainTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelDomainTransfer(ctx context.Context, req *MsgCancelDomainTransfer) (*MsgCancelDomainTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDomainTransfer not implemented")
+}
+func (*UnimplementedMsgServer) ListDomain(ctx context.Context, req *MsgListDomain) (*MsgListDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomain not implemented")
+}
+func (*UnimplementedMsgServer) UnlistDomain(ctx context.Context, req *MsgUnlistDomain) (*MsgUnlistDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlistDomain not implemented")
+}
+func (*UnimplementedMsgServer) BuyDomain(ctx context.Context, req *MsgBuyDomain) (*MsgBuyDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyDomain not implemented")
+}
+func (*UnimplementedMsgServer) PlaceMarketOffer(ctx context.Context, req *MsgPlaceMarketOffer) (*MsgPlaceMarketOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceMarketOffer not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawMarketOffer(ctx context.Context, req *MsgWithdrawMarketOffer) (*MsgWithdrawMarketOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMarketOffer not implemented")
+}
+func (*UnimplementedMsgServer) AcceptMarketOffer(ctx context.Context, req *MsgAcceptMarketOffer) (*MsgAcceptMarketOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptMarketOffer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/CreateDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDomain(ctx, req.(*MsgCreateDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/UpdateDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDomain(ctx, req.(*MsgUpdateDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/DeleteDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteDomain(ctx, req.(*MsgDeleteDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/TransferDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDomain(ctx, req.(*MsgTransferDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HeartbeatDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHeartbeatDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HeartbeatDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/HeartbeatDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HeartbeatDomain(ctx, req.(*MsgHeartbeatDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/RenewDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewDomain(ctx, req.(*MsgRenewDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDomainRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDomainRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDomainRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/SetDomainRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDomainRecords(ctx, req.(*MsgSetDomainRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDomainDSRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDomainDSRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDomainDSRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/SetDomainDSRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDomainDSRecords(ctx, req.(*MsgSetDomainDSRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/CommitDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitDomain(ctx, req.(*MsgCommitDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/RevealDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealDomain(ctx, req.(*MsgRevealDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/OpenAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenAuction(ctx, req.(*MsgOpenAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/PlaceBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/RevealBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OfferDomainTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOfferDomainTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OfferDomainTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/OfferDomainTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OfferDomainTransfer(ctx, req.(*MsgOfferDomainTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptDomainTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptDomainTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptDomainTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/AcceptDomainTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptDomainTransfer(ctx, req.(*MsgAcceptDomainTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDomainTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDomainTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDomainTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/CancelDomainTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDomainTransfer(ctx, req.(*MsgCancelDomainTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ListDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgListDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ListDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/ListDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ListDomain(ctx, req.(*MsgListDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlistDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlistDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlistDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/UnlistDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlistDomain(ctx, req.(*MsgUnlistDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/BuyDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyDomain(ctx, req.(*MsgBuyDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceMarketOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceMarketOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceMarketOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/PlaceMarketOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceMarketOffer(ctx, req.(*MsgPlaceMarketOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawMarketOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawMarketOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawMarketOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/WithdrawMarketOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawMarketOffer(ctx, req.(*MsgWithdrawMarketOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptMarketOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptMarketOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptMarketOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dnsblockchain.dnsblockchain.v1.Msg/AcceptMarketOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptMarketOffer(ctx, req.(*MsgAcceptMarketOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dnsblockchain.dnsblockchain.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateDomain",
+			Handler:    _Msg_CreateDomain_Handler,
+		},
+		{
+			MethodName: "UpdateDomain",
+			Handler:    _Msg_UpdateDomain_Handler,
+		},
+		{
+			MethodName: "DeleteDomain",
+			Handler:    _Msg_DeleteDomain_Handler,
+		},
+		{
+			MethodName: "TransferDomain",
+			Handler:    _Msg_TransferDomain_Handler,
+		},
+		{
+			MethodName: "HeartbeatDomain",
+			Handler:    _Msg_HeartbeatDomain_Handler,
+		},
+		{
+			MethodName: "RenewDomain",
+			Handler:    _Msg_RenewDomain_Handler,
+		},
+		{
+			MethodName: "SetDomainRecords",
+			Handler:    _Msg_SetDomainRecords_Handler,
+		},
+		{
+			MethodName: "SetDomainDSRecords",
+			Handler:    _Msg_SetDomainDSRecords_Handler,
+		},
+		{
+			MethodName: "CommitDomain",
+			Handler:    _Msg_CommitDomain_Handler,
+		},
+		{
+			MethodName: "RevealDomain",
+			Handler:    _Msg_RevealDomain_Handler,
+		},
+		{
+			MethodName: "OpenAuction",
+			Handler:    _Msg_OpenAuction_Handler,
+		},
+		{
+			MethodName: "PlaceBid",
+			Handler:    _Msg_PlaceBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
+		{
+			MethodName: "OfferDomainTransfer",
+			Handler:    _Msg_OfferDomainTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptDomainTransfer",
+			Handler:    _Msg_AcceptDomainTransfer_Handler,
+		},
+		{
+			MethodName: "CancelDomainTransfer",
+			Handler:    _Msg_CancelDomainTransfer_Handler,
+		},
+		{
+			MethodName: "ListDomain",
+			Handler:    _Msg_ListDomain_Handler,
+		},
+		{
+			MethodName: "UnlistDomain",
+			Handler:    _Msg_UnlistDomain_Handler,
+		},
+		{
+			MethodName: "BuyDomain",
+			Handler:    _Msg_BuyDomain_Handler,
+		},
+		{
+			MethodName: "PlaceMarketOffer",
+			Handler:    _Msg_PlaceMarketOffer_Handler,
+		},
+		{
+			MethodName: "WithdrawMarketOffer",
+			Handler:    _Msg_WithdrawMarketOffer_Handler,
+		},
+		{
+			MethodName: "AcceptMarketOffer",
+			Handler:    _Msg_AcceptMarketOffer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dnsblockchain/dnsblockchain/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Years != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NsRecords) > 0 {
+		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NsRecords) > 0 {
+		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direct {
+		i--
+		if m.Direct {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHeartbeatDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHeartbeatDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHeartbeatDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHeartbeatDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHeartbeatDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHeartbeatDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Years != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDomainRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDomainRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDomainRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClearNsRecords {
+		i--
+		if m.ClearNsRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDomainRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDomainRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDomainRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDomainDSRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDomainDSRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDomainDSRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DsRecords) > 0 {
+		for iNdEx := len(m.DsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDomainDSRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDomainDSRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDomainDSRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Years != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NsRecords) > 0 {
+		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NameTaken {
+		i--
+		if m.NameTaken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevealEnd != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevealEnd))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BidEnd != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidEnd))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SealedBid) > 0 {
+		i -= len(m.SealedBid)
+		copy(dAtA[i:], m.SealedBid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SealedBid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NsRecords) > 0 {
+		for iNdEx := len(m.NsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOfferDomainTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOfferDomainTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOfferDomainTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOfferDomainTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOfferDomainTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOfferDomainTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expires != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expires))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDomainTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDomainTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDomainTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDomainTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDomainTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDomainTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDomainTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDomainTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDomainTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDomainTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDomainTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDomainTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgListDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgListDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgListDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgListDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgListDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgListDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlistDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlistDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlistDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlistDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlistDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlistDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceMarketOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceMarketOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceMarketOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceMarketOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceMarketOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceMarketOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawMarketOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawMarketOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawMarketOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawMarketOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawMarketOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawMarketOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptMarketOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptMarketOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptMarketOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptMarketOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptMarketOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptMarketOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NsRecords) > 0 {
+		for _, e := range m.NsRecords {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Years != 0 {
+		n += 1 + sovTx(uint64(m.Years))
+	}
+	return n
+}
+
+func (m *MsgCreateDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NsRecords) > 0 {
+		for _, e := range m.NsRecords {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Direct {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTransferDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgHeartbeatDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgHeartbeatDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Years != 0 {
+		n += 1 + sovTx(uint64(m.Years))
+	}
+	return n
+}
+
+func (m *MsgRenewDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiration != 0 {
+		n += 1 + sovTx(uint64(m.Expiration))
+	}
+	return n
+}
+
+func (m *MsgSetDomainRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ClearNsRecords {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetDomainRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDomainDSRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.DsRecords) > 0 {
+		for _, e := range m.DsRecords {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDomainDSRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NsRecords) > 0 {
+		for _, e := range m.NsRecords {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Years != 0 {
+		n += 1 + sovTx(uint64(m.Years))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.NameTaken {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgOpenAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOpenAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BidEnd != 0 {
+		n += 1 + sovTx(uint64(m.BidEnd))
+	}
+	if m.RevealEnd != 0 {
+		n += 1 + sovTx(uint64(m.RevealEnd))
+	}
+	return n
+}
+
+func (m *MsgPlaceBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SealedBid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPlaceBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NsRecords) > 0 {
+		for _, e := range m.NsRecords {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevealBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOfferDomainTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOfferDomainTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expires != 0 {
+		n += 1 + sovTx(uint64(m.Expires))
+	}
+	return n
+}
+
+func (m *MsgAcceptDomainTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAcceptDomainTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDomainTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelDomainTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgListDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgListDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnlistDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUnlistDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBuyDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBuyDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceMarketOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceMarketOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawMarketOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgWithdrawMarketOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptMarketOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptMarketOfferResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsRecords = append(m.NsRecords, &NSRecordWithIP{})
+			if err := m.NsRecords[len(m.NsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsRecords = append(m.NsRecords, &NSRecordWithIP{})
+			if err := m.NsRecords[len(m.NsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Direct = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHeartbeatDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHeartbeatDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHeartbeatDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHeartbeatDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHeartbeatDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHeartbeatDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDomainRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDomainRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDomainRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &ResourceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearNsRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearNsRecords = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDomainRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDomainRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDomainRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDomainDSRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDomainDSRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDomainDSRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DsRecords = append(m.DsRecords, &DSRecord{})
+			if err := m.DsRecords[len(m.DsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDomainDSRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDomainDSRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDomainDSRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsRecords = append(m.NsRecords, &NSRecordWithIP{})
+			if err := m.NsRecords[len(m.NsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameTaken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NameTaken = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidEnd", wireType)
+			}
+			m.BidEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEnd", wireType)
+			}
+			m.RevealEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealedBid = append(m.SealedBid[:0], dAtA[iNdEx:postIndex]...)
+			if m.SealedBid == nil {
+				m.SealedBid = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsRecords = append(m.NsRecords, &NSRecordWithIP{})
+			if err := m.NsRecords[len(m.NsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOfferDomainTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOfferDomainTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOfferDomainTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOfferDomainTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOfferDomainTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOfferDomainTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptDomainTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1589,15 +8967,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptDomainTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptDomainTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1625,13 +9003,132 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptDomainTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDomainTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDomainTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDomainTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDomainTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDomainTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1641,25 +9138,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1681,7 +9196,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDomainTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,10 +9219,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDomainTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDomainTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1731,7 +9246,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgListDomain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1754,10 +9269,10 @@ func (m *MsgCreateDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgListDomain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgListDomain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1793,10 +9308,29 @@ func (m *MsgCreateDomain) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1806,27 +9340,128 @@ func (m *MsgCreateDomain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgListDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgListDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgListDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlistDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlistDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlistDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1854,13 +9489,13 @@ func (m *MsgCreateDomain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsRecords", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1870,26 +9505,11 @@ func (m *MsgCreateDomain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NsRecords = append(m.NsRecords, &NSRecordWithIP{})
-			if err := m.NsRecords[len(m.NsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1911,7 +9531,7 @@ func (m *MsgCreateDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlistDomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1934,31 +9554,12 @@ func (m *MsgCreateDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlistDomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlistDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1980,7 +9581,7 @@ func (m *MsgCreateDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyDomain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,10 +9604,10 @@ func (m *MsgUpdateDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyDomain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyDomain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2062,39 +9663,7 @@ func (m *MsgUpdateDomain) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2121,8 +9690,7 @@ func (m *MsgUpdateDomain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsRecords = append(m.NsRecords, &NSRecordWithIP{})
-			if err := m.NsRecords[len(m.NsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2147,7 +9715,7 @@ func (m *MsgUpdateDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyDomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2170,10 +9738,10 @@ func (m *MsgUpdateDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyDomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2197,7 +9765,7 @@ func (m *MsgUpdateDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceMarketOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2220,10 +9788,10 @@ func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceMarketOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceMarketOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2277,6 +9845,39 @@ func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2298,7 +9899,7 @@ func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceMarketOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2321,10 +9922,10 @@ func (m *MsgDeleteDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceMarketOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceMarketOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2348,7 +9949,7 @@ func (m *MsgDeleteDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawMarketOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2371,10 +9972,10 @@ func (m *MsgTransferDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawMarketOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawMarketOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2428,38 +10029,6 @@ func (m *MsgTransferDomain) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2481,7 +10050,7 @@ func (m *MsgTransferDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawMarketOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2504,10 +10073,10 @@ func (m *MsgTransferDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawMarketOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawMarketOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2531,7 +10100,7 @@ func (m *MsgTransferDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHeartbeatDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptMarketOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2554,10 +10123,10 @@ func (m *MsgHeartbeatDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHeartbeatDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptMarketOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHeartbeatDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptMarketOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2611,6 +10180,38 @@ func (m *MsgHeartbeatDomain) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2632,7 +10233,7 @@ func (m *MsgHeartbeatDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHeartbeatDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptMarketOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2655,10 +10256,10 @@ func (m *MsgHeartbeatDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHeartbeatDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptMarketOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHeartbeatDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptMarketOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -30,3 +30,13 @@ func ExtractTLD(domainName string) string {
 	// The TLD is the last part.
 	return strings.ToLower(parts[len(parts)-1])
 }
+
+// ParentName returns the name of the parent domain of a subdomain, e.g. "example.web3" for
+// "shop.example.web3". It returns an empty string for names registered directly under a TLD.
+func ParentName(domainName string) string {
+	parts := strings.Split(strings.Trim(domainName, "."), ".")
+	if len(parts) <= 2 {
+		return ""
+	}
+	return strings.Join(parts[1:], ".")
+}